@@ -83,7 +83,7 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 
 		// check whether the sender address is EOA
 		fromAddr := common.BytesToAddress(from)
-		acct := avd.evmKeeper.GetAccount(ctx, fromAddr)
+		acct := avd.evmKeeper.StateKeeper().GetAccount(ctx, fromAddr)
 
 		if acct == nil {
 			acc := avd.ak.NewAccountWithAddress(ctx, from)
@@ -95,7 +95,11 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 		}
 
 		if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(acct.Balance), txData); err != nil {
-			return ctx, errorsmod.Wrap(err, "failed to check sender balance")
+			// the fees can still be paid with a whitelisted fee denom, which is verified when
+			// they are deducted, as long as the balance covers the tx value
+			if len(avd.evmKeeper.GetParams(ctx).FeeDenoms) == 0 || acct.Balance.Cmp(txData.GetValue()) < 0 {
+				return ctx, errorsmod.Wrap(err, "failed to check sender balance")
+			}
 		}
 	}
 	return next(ctx, tx, simulate)
@@ -191,11 +195,23 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			return ctx, errorsmod.Wrapf(err, "failed to verify the fees")
 		}
 
-		err = egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.HexToAddress(msgEthTx.From))
+		from := common.HexToAddress(msgEthTx.From)
+
+		// pay the fees with a whitelisted fee denom if the sender cannot afford them in the evm denom
+		fees, err = egcd.evmKeeper.SelectTxFees(ctx, fees, from, txData.GetValue())
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "failed to select the fee denom")
+		}
+
+		err = egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, from)
 		if err != nil {
 			return ctx, errorsmod.Wrapf(err, "failed to deduct transaction costs from user balance")
 		}
 
+		if len(fees) > 0 && fees[0].Denom != evmDenom {
+			egcd.evmKeeper.SetTxFeeDenomTransient(ctx, common.HexToHash(msgEthTx.Hash), fees[0].Denom)
+		}
+
 		events = append(events,
 			sdk.NewEvent(
 				sdk.EventTypeTx,
@@ -260,7 +276,7 @@ func NewCanTransferDecorator(evmKeeper EVMKeeper) CanTransferDecorator {
 func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	params := ctd.evmKeeper.GetParams(ctx)
 	ethCfg := params.ChainConfig.EthereumConfig(ctd.evmKeeper.ChainID())
-	signer := ethtypes.MakeSigner(ethCfg, big.NewInt(ctx.BlockHeight()))
+	signer := ctd.evmKeeper.Signer(ethtypes.MakeSigner(ethCfg, big.NewInt(ctx.BlockHeight())))
 
 	for _, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
@@ -302,7 +318,7 @@ func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 			BaseFee:     baseFee,
 		}
 
-		stateDB := statedb.New(ctx, ctd.evmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes())))
+		stateDB := statedb.New(ctx, ctd.evmKeeper.StateKeeper(), statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes())))
 		evm := ctd.evmKeeper.NewEVM(ctx, coreMsg, cfg, evmtypes.NewNoOpTracer(), stateDB)
 
 		// check that caller has enough balance to cover asset transfer for **topmost** call
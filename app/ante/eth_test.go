@@ -4,15 +4,18 @@ import (
 	"math"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/evmos/ethermint/app/ante"
 	"github.com/evmos/ethermint/server/config"
 	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/testutil"
 	ethermint "github.com/evmos/ethermint/types"
 	"github.com/evmos/ethermint/x/evm/statedb"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -317,6 +320,43 @@ func (suite AnteTestSuite) TestEthGasConsumeDecorator() {
 	}
 }
 
+func (suite *AnteTestSuite) TestEthGasConsumeDecoratorFeeDenom() {
+	dec := ante.NewEthGasConsumeDecorator(suite.app.EvmKeeper, config.DefaultMaxTxGasWanted)
+
+	addr := tests.GenerateAddress()
+
+	ethCfg := suite.app.EvmKeeper.GetParams(suite.ctx).
+		ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+	baseFee := suite.app.EvmKeeper.GetBaseFee(suite.ctx, ethCfg)
+	gasPrice := new(big.Int).Add(baseFee, evmtypes.DefaultPriorityReduction.BigInt())
+
+	txGasLimit := uint64(1000000)
+	tx := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), 1, big.NewInt(10), txGasLimit, gasPrice, nil, nil, nil, &ethtypes.AccessList{{Address: addr, StorageKeys: nil}})
+	tx.From = addr.Hex()
+
+	fee := sdkmath.NewIntFromBigInt(new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(txGasLimit)))
+	usdcFee := fee.MulRaw(2)
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.FeeDenoms = []evmtypes.FeeDenom{{Denom: "uusdc", ConversionRate: sdk.NewDec(2)}}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	// the sender only holds the tx value in the evm denom
+	err := testutil.FundAccount(suite.app.BankKeeper, suite.ctx, addr.Bytes(), sdk.NewCoins(
+		sdk.NewCoin(params.EvmDenom, sdkmath.NewInt(10)),
+		sdk.NewCoin("uusdc", usdcFee),
+	))
+	suite.Require().NoError(err)
+	suite.ctx = suite.ctx.WithBlockGasMeter(sdk.NewGasMeter(10000000000000000000))
+
+	_, err = dec.AnteHandle(suite.ctx.WithIsCheckTx(true).WithGasMeter(sdk.NewInfiniteGasMeter()), tx, false, NextFn)
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, addr.Bytes(), "uusdc").IsZero())
+	suite.Require().Equal(sdkmath.NewInt(10), suite.app.BankKeeper.GetBalance(suite.ctx, addr.Bytes(), params.EvmDenom).Amount)
+	suite.Require().Equal("uusdc", suite.app.EvmKeeper.GetTxFeeDenomTransient(suite.ctx, common.HexToHash(tx.Hash)))
+}
+
 func (suite AnteTestSuite) TestCanTransferDecorator() {
 	dec := ante.NewCanTransferDecorator(suite.app.EvmKeeper)
 
@@ -211,6 +211,9 @@ type EthermintApp struct {
 
 	invCheckPeriod uint
 
+	// skipModuleBlockers disables the begin and end blockers of the modules
+	skipModuleBlockers bool
+
 	// keys to access the substores
 	keys    map[string]*storetypes.KVStoreKey
 	tkeys   map[string]*storetypes.TransientStoreKey
@@ -420,7 +423,7 @@ func NewEthermintApp(
 	evmSs := app.GetSubspace(evmtypes.ModuleName)
 	app.EvmKeeper = evmkeeper.NewKeeper(
 		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey], authtypes.NewModuleAddress(govtypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper,
+		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.DistrKeeper, app.FeeMarketKeeper,
 		nil, geth.NewEVM, tracer, evmSs,
 	)
 
@@ -694,14 +697,27 @@ func (app *EthermintApp) Name() string { return app.BaseApp.Name() }
 
 // BeginBlocker updates every begin block
 func (app *EthermintApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	if app.skipModuleBlockers {
+		return abci.ResponseBeginBlock{}
+	}
 	return app.mm.BeginBlock(ctx, req)
 }
 
 // EndBlocker updates every end block
 func (app *EthermintApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	if app.skipModuleBlockers {
+		return abci.ResponseEndBlock{}
+	}
 	return app.mm.EndBlock(ctx, req)
 }
 
+// SkipModuleBlockers disables the begin and end blockers of the modules until it is called again
+// with false. The dev node skips them in the empty blocks, so that the app hash doesn't change and
+// Tendermint waits for a transaction to seal the next block.
+func (app *EthermintApp) SkipModuleBlockers(skip bool) {
+	app.skipModuleBlockers = skip
+}
+
 // InitChainer updates at chain initialization
 func (app *EthermintApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	var genesisState simapp.GenesisState
@@ -780,6 +796,11 @@ func (app *EthermintApp) GetMemKey(storeKey string) *storetypes.MemoryStoreKey {
 	return app.memKeys[storeKey]
 }
 
+// GetEVMKeeper returns the EVM keeper, used by the dev node to override the state.
+func (app *EthermintApp) GetEVMKeeper() *evmkeeper.Keeper {
+	return app.EvmKeeper
+}
+
 // GetSubspace returns a param subspace for a given module name.
 //
 // NOTE: This is solely to be used for testing purposes.
@@ -43,6 +43,9 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(PubkeyCmd())
 	cmd.AddCommand(AddrCmd())
 	cmd.AddCommand(RawBytesCmd())
+	cmd.AddCommand(DecodeTxCmd())
+	cmd.AddCommand(DecodeReceiptCmd())
+	cmd.AddCommand(EIP712Cmd())
 
 	return cmd
 }
@@ -0,0 +1,221 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package debug
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/evmos/ethermint/ethereum/eip712"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+// receiptOutput holds the consensus fields of an Ethereum receipt, which are the only ones
+// present in its binary encoding.
+type receiptOutput struct {
+	Type              hexutil.Uint64 `json:"type"`
+	Root              hexutil.Bytes  `json:"root,omitempty"`
+	Status            hexutil.Uint64 `json:"status"`
+	CumulativeGasUsed hexutil.Uint64 `json:"cumulativeGasUsed"`
+	Bloom             ethtypes.Bloom `json:"logsBloom"`
+	Logs              []logOutput    `json:"logs"`
+}
+
+// logOutput holds the consensus fields of an Ethereum log.
+type logOutput struct {
+	Address common.Address `json:"address"`
+	Topics  []common.Hash  `json:"topics"`
+	Data    hexutil.Bytes  `json:"data"`
+}
+
+// DecodeTxCmd decodes a raw Ethereum transaction, or a Cosmos transaction wrapping
+// MsgEthereumTx messages, and prints it as JSON with the recovered sender.
+func DecodeTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "decode-tx [tx]",
+		Short: "Decode a raw Ethereum or Cosmos transaction to JSON",
+		Long: `Decode a raw Ethereum transaction (legacy, EIP-2930 or EIP-1559), or a Cosmos transaction containing
+MsgEthereumTx messages, and display it as JSON with the sender recovered from the signature.
+The transaction is encoded in hex or base64.`,
+		Example: fmt.Sprintf(
+			`$ %s debug decode-tx 0x02f87082232880843b9aca0085012a05f20082520894...
+$ %s debug decode-tx CpoBCpcBCh8vZXRoZXJtaW50LmV2bS52MS5Nc2dFdGhlcmV1bVR4...`,
+			version.AppName, version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			bz, err := decodeBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			// the typed transactions start with their type and the legacy ones with an RLP list,
+			// so a Cosmos transaction never decodes as an Ethereum one
+			tx := new(ethtypes.Transaction)
+			if err := tx.UnmarshalBinary(bz); err == nil {
+				rpcTx, err := newRPCTransaction(tx)
+				if err != nil {
+					return err
+				}
+				return printJSON(cmd, rpcTx)
+			}
+
+			if clientCtx.TxConfig == nil {
+				return fmt.Errorf("failed to decode the Ethereum transaction and no Cosmos tx decoder is configured")
+			}
+			msgs, err := rpctypes.RawTxToEthTx(clientCtx, bz)
+			if err != nil {
+				return fmt.Errorf("failed to decode the transaction as an Ethereum or Cosmos transaction: %w", err)
+			}
+
+			rpcTxs := make([]*rpctypes.RPCTransaction, len(msgs))
+			for i, msg := range msgs {
+				tx := msg.AsTransaction()
+				if tx == nil {
+					return fmt.Errorf("failed to unpack the data of message %d", i)
+				}
+				if rpcTxs[i], err = newRPCTransaction(tx); err != nil {
+					return err
+				}
+			}
+			return printJSON(cmd, rpcTxs)
+		},
+	}
+}
+
+// DecodeReceiptCmd decodes the binary encoding of an Ethereum receipt and prints it as JSON.
+func DecodeReceiptCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "decode-receipt [receipt]",
+		Short: "Decode a binary Ethereum receipt to JSON",
+		Long: `Decode the consensus encoding of an Ethereum receipt (legacy or typed), as found in the receipts trie,
+and display it as JSON. The receipt is encoded in hex or base64.`,
+		Example: fmt.Sprintf(`$ %s debug decode-receipt 0xf9010801825208b9010000000000...`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := decodeBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			receipt := new(ethtypes.Receipt)
+			if err := receipt.UnmarshalBinary(bz); err != nil {
+				return fmt.Errorf("failed to decode the receipt: %w", err)
+			}
+
+			output := receiptOutput{
+				Type:              hexutil.Uint64(receipt.Type),
+				Root:              receipt.PostState,
+				Status:            hexutil.Uint64(receipt.Status),
+				CumulativeGasUsed: hexutil.Uint64(receipt.CumulativeGasUsed),
+				Bloom:             receipt.Bloom,
+				Logs:              make([]logOutput, len(receipt.Logs)),
+			}
+			for i, log := range receipt.Logs {
+				output.Logs[i] = logOutput{Address: log.Address, Topics: log.Topics, Data: log.Data}
+			}
+			return printJSON(cmd, output)
+		},
+	}
+}
+
+// EIP712Cmd prints the EIP-712 typed data that is signed for a Cosmos sign doc.
+func EIP712Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "eip712 [signdoc]",
+		Short: "Display the EIP-712 typed data of a Cosmos sign doc",
+		Long: `Display the EIP-712 typed data that is signed by the Ethereum wallets for a Cosmos sign doc.
+The sign doc is either the legacy amino JSON sign doc or the hex or base64 encoding of a protobuf SignDoc,
+passed directly or as the path of a file containing it.`,
+		Example: fmt.Sprintf(
+			`$ %s debug eip712 '{"account_number":"0","chain_id":"ethermint_9000-1","fee":{...},"memo":"","msgs":[...],"sequence":"0"}'
+$ %s debug eip712 signdoc.json`,
+			version.AppName, version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			input := args[0]
+			if contents, err := os.ReadFile(input); err == nil {
+				input = string(contents)
+			}
+			input = strings.TrimSpace(input)
+
+			var signDoc []byte
+			if strings.HasPrefix(input, "{") {
+				signDoc = []byte(input)
+			} else {
+				var err error
+				if signDoc, err = decodeBytes(input); err != nil {
+					return err
+				}
+			}
+
+			typedData, err := eip712.GetEIP712TypedDataForMsg(signDoc)
+			if err != nil {
+				return err
+			}
+			return printJSON(cmd, typedData)
+		},
+	}
+}
+
+// newRPCTransaction returns the JSON-RPC representation of a transaction outside of a block.
+func newRPCTransaction(tx *ethtypes.Transaction) (*rpctypes.RPCTransaction, error) {
+	rpcTx, err := rpctypes.NewRPCTransaction(tx, common.Hash{}, 0, 0, nil, tx.ChainId())
+	if err != nil {
+		return nil, err
+	}
+	if rpcTx.From == (common.Address{}) {
+		return nil, fmt.Errorf("failed to recover the sender of transaction %s", tx.Hash())
+	}
+	return rpcTx, nil
+}
+
+// decodeBytes decodes a hex string, with or without the 0x prefix, or a base64 string.
+func decodeBytes(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	if bz, err := hex.DecodeString(strings.TrimPrefix(s, "0x")); err == nil {
+		return bz, nil
+	}
+	bz, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("expected a hex or base64 encoded string, got '%s'", s)
+	}
+	return bz, nil
+}
+
+func printJSON(cmd *cobra.Command, v interface{}) error {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+	return err
+}
@@ -0,0 +1,156 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/app"
+	"github.com/evmos/ethermint/encoding"
+	"github.com/evmos/ethermint/ethereum/eip712"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func executeCmd(cmd *cobra.Command, clientCtx client.Context, args ...string) ([]byte, error) {
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetArgs(args)
+	err := cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+	return out.Bytes(), err
+}
+
+func TestDecodeTxCmd(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	clientCtx := client.Context{}.WithTxConfig(encCfg.TxConfig).WithCodec(encCfg.Codec)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	chainID := big.NewInt(9000)
+
+	txs := []ethtypes.TxData{
+		&ethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(10), Gas: 21000, To: &to, Value: big.NewInt(5)},
+		&ethtypes.AccessListTx{
+			ChainID: chainID, Nonce: 2, GasPrice: big.NewInt(10), Gas: 30000, To: &to,
+			AccessList: ethtypes.AccessList{{Address: to, StorageKeys: []common.Hash{{1}}}},
+		},
+		&ethtypes.DynamicFeeTx{ChainID: chainID, Nonce: 3, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10), Gas: 21000, To: &to},
+	}
+	signers := []ethtypes.Signer{ethtypes.HomesteadSigner{}, ethtypes.LatestSignerForChainID(chainID), ethtypes.LatestSignerForChainID(chainID)}
+
+	for i, txData := range txs {
+		tx, err := ethtypes.SignNewTx(key, signers[i], txData)
+		require.NoError(t, err)
+		raw, err := tx.MarshalBinary()
+		require.NoError(t, err)
+
+		out, err := executeCmd(DecodeTxCmd(), clientCtx, hexutil.Encode(raw))
+		require.NoError(t, err)
+		var rpcTx rpctypes.RPCTransaction
+		require.NoError(t, json.Unmarshal(out, &rpcTx))
+		require.Equal(t, from, rpcTx.From)
+		require.Equal(t, tx.Hash(), rpcTx.Hash)
+		require.Equal(t, hexutil.Uint64(tx.Type()), rpcTx.Type)
+		require.Equal(t, hexutil.Uint64(tx.Nonce()), rpcTx.Nonce)
+
+		// the Cosmos transaction wrapping it is decoded as well
+		msg := &evmtypes.MsgEthereumTx{}
+		require.NoError(t, msg.FromEthereumTx(tx))
+		cosmosTx, err := msg.BuildTx(encCfg.TxConfig.NewTxBuilder(), "aphoton")
+		require.NoError(t, err)
+		cosmosRaw, err := encCfg.TxConfig.TxEncoder()(cosmosTx)
+		require.NoError(t, err)
+
+		out, err = executeCmd(DecodeTxCmd(), clientCtx, hexutil.Encode(cosmosRaw)[2:])
+		require.NoError(t, err)
+		var rpcTxs []rpctypes.RPCTransaction
+		require.NoError(t, json.Unmarshal(out, &rpcTxs))
+		require.Len(t, rpcTxs, 1)
+		require.Equal(t, from, rpcTxs[0].From)
+		require.Equal(t, tx.Hash(), rpcTxs[0].Hash)
+	}
+
+	// the unsigned transactions have no sender
+	raw, err := ethtypes.NewTx(txs[2]).MarshalBinary()
+	require.NoError(t, err)
+	_, err = executeCmd(DecodeTxCmd(), clientCtx, hexutil.Encode(raw))
+	require.ErrorContains(t, err, "failed to recover the sender")
+
+	_, err = executeCmd(DecodeTxCmd(), clientCtx, "0x0102")
+	require.Error(t, err)
+	_, err = executeCmd(DecodeTxCmd(), clientCtx, "not encoded")
+	require.ErrorContains(t, err, "expected a hex or base64 encoded string")
+}
+
+func TestDecodeReceiptCmd(t *testing.T) {
+	log := &ethtypes.Log{
+		Address: common.HexToAddress("0x2222222222222222222222222222222222222222"),
+		Topics:  []common.Hash{{1}, {2}},
+		Data:    []byte{3, 4},
+	}
+	for _, txType := range []uint8{ethtypes.LegacyTxType, ethtypes.DynamicFeeTxType} {
+		receipt := &ethtypes.Receipt{
+			Type:              txType,
+			Status:            ethtypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: 42000,
+			Logs:              []*ethtypes.Log{log},
+		}
+		receipt.Bloom = ethtypes.CreateBloom(ethtypes.Receipts{receipt})
+		raw, err := receipt.MarshalBinary()
+		require.NoError(t, err)
+
+		out, err := executeCmd(DecodeReceiptCmd(), client.Context{}, hexutil.Encode(raw))
+		require.NoError(t, err)
+		var output receiptOutput
+		require.NoError(t, json.Unmarshal(out, &output))
+		require.Equal(t, receiptOutput{
+			Type:              hexutil.Uint64(txType),
+			Status:            1,
+			CumulativeGasUsed: 42000,
+			Bloom:             receipt.Bloom,
+			Logs:              []logOutput{{Address: log.Address, Topics: log.Topics, Data: log.Data}},
+		}, output)
+	}
+
+	_, err := executeCmd(DecodeReceiptCmd(), client.Context{}, "0x0102")
+	require.ErrorContains(t, err, "failed to decode the receipt")
+}
+
+func TestEIP712Cmd(t *testing.T) {
+	eip712.SetEncodingConfig(encoding.MakeConfig(app.ModuleBasics))
+
+	from := sdk.AccAddress(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes())
+	to := sdk.AccAddress(common.HexToAddress("0x2222222222222222222222222222222222222222").Bytes())
+	signDoc := `{"account_number":"1","chain_id":"ethermint_9000-1","fee":{"amount":[{"amount":"20","denom":"aphoton"}],"gas":"200000"},` +
+		`"memo":"","msgs":[{"type":"cosmos-sdk/MsgSend","value":{"amount":[{"amount":"1","denom":"aphoton"}],` +
+		`"from_address":"` + from.String() + `","to_address":"` + to.String() + `"}}],"sequence":"0"}`
+
+	expected, err := eip712.GetEIP712TypedDataForMsg([]byte(signDoc))
+	require.NoError(t, err)
+
+	out, err := executeCmd(EIP712Cmd(), client.Context{}, signDoc)
+	require.NoError(t, err)
+	var typedData apitypes.TypedData
+	require.NoError(t, json.Unmarshal(out, &typedData))
+	require.Equal(t, expected.PrimaryType, typedData.PrimaryType)
+	require.Equal(t, expected.Types, typedData.Types)
+	require.Equal(t, (*big.Int)(expected.Domain.ChainId), (*big.Int)(typedData.Domain.ChainId))
+
+	_, err = executeCmd(EIP712Cmd(), client.Context{}, "0x0102")
+	require.Error(t, err)
+}
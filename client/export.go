@@ -17,6 +17,7 @@ package client
 
 import (
 	"bufio"
+	"crypto/ecdsa"
 	"fmt"
 	"strings"
 
@@ -64,28 +65,7 @@ func UnsafeExportEthKeyCommand() *cobra.Command {
 				return err
 			}
 
-			// Exports private key from keybase using password
-			armor, err := clientCtx.Keyring.ExportPrivKeyArmor(args[0], decryptPassword)
-			if err != nil {
-				return err
-			}
-
-			privKey, algo, err := crypto.UnarmorDecryptPrivKey(armor, decryptPassword)
-			if err != nil {
-				return err
-			}
-
-			if algo != ethsecp256k1.KeyType {
-				return fmt.Errorf("invalid key algorithm, got %s, expected %s", algo, ethsecp256k1.KeyType)
-			}
-
-			// Converts key to Ethermint secp256k1 implementation
-			ethPrivKey, ok := privKey.(*ethsecp256k1.PrivKey)
-			if !ok {
-				return fmt.Errorf("invalid private key type %T, expected %T", privKey, &ethsecp256k1.PrivKey{})
-			}
-
-			key, err := ethPrivKey.ToECDSA()
+			key, err := exportEthPrivKey(clientCtx.Keyring, args[0], decryptPassword)
 			if err != nil {
 				return err
 			}
@@ -100,3 +80,29 @@ func UnsafeExportEthKeyCommand() *cobra.Command {
 		},
 	}
 }
+
+// exportEthPrivKey returns the eth_secp256k1 private key of the keyring with the given name, armored
+// with the passphrase while exported.
+func exportEthPrivKey(kr keyring.Keyring, name, passphrase string) (*ecdsa.PrivateKey, error) {
+	armor, err := kr.ExportPrivKeyArmor(name, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, algo, err := crypto.UnarmorDecryptPrivKey(armor, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	if algo != ethsecp256k1.KeyType {
+		return nil, fmt.Errorf("invalid key algorithm, got %s, expected %s", algo, ethsecp256k1.KeyType)
+	}
+
+	// Converts key to Ethermint secp256k1 implementation
+	ethPrivKey, ok := privKey.(*ethsecp256k1.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("invalid private key type %T, expected %T", privKey, &ethsecp256k1.PrivKey{})
+	}
+
+	return ethPrivKey.ToECDSA()
+}
@@ -71,13 +71,14 @@ The pass backend requires GnuPG: https://gnupg.org/
 	}
 
 	addCmd.RunE = runAddCmd
+	addCmd.Flags().Uint32(clientkeys.FlagCount, 1, "Derive and store the given number of sequential accounts from the mnemonic, named <name>-<index>")
 
 	cmd.AddCommand(
 		keys.MnemonicKeyCommand(),
 		addCmd,
 		keys.ExportKeyCommand(),
 		keys.ImportKeyCommand(),
-		keys.ListKeysCmd(),
+		clientkeys.ListKeysCmd(),
 		keys.ShowKeysCmd(),
 		keys.DeleteKeyCommand(),
 		keys.RenameKeyCommand(),
@@ -86,6 +87,8 @@ The pass backend requires GnuPG: https://gnupg.org/
 		flags.LineBreak,
 		UnsafeExportEthKeyCommand(),
 		UnsafeImportKeyCommand(),
+		ImportKeystoreCommand(),
+		ExportKeystoreCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
@@ -24,6 +24,7 @@ import (
 
 	etherminthd "github.com/evmos/ethermint/crypto/hd"
 
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	bip39 "github.com/cosmos/go-bip39"
 	"github.com/spf13/cobra"
 
@@ -53,6 +54,9 @@ const (
 	mnemonicEntropySize = 256
 )
 
+// FlagCount is the flag of the number of sequential accounts derived from the mnemonic
+const FlagCount = "count"
+
 /*
 RunAddCmd
 input
@@ -72,7 +76,6 @@ func RunAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 
 	name := args[0]
 
-	interactive, _ := cmd.Flags().GetBool(flagInteractive)
 	noBackup, _ := cmd.Flags().GetBool(flagNoBackup)
 	useLedger, _ := cmd.Flags().GetBool(flags.FlagUseLedger)
 	algoStr, _ := cmd.Flags().GetString(flags.FlagKeyAlgorithm)
@@ -95,6 +98,14 @@ func RunAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		return err
 	}
 
+	count, _ := cmd.Flags().GetUint32(FlagCount)
+	if cmd.Flags().Changed(FlagCount) && count == 0 {
+		return errors.New("the number of accounts must be positive")
+	}
+	if count > 1 {
+		return runAddAccountsCmd(ctx, cmd, name, count, algo, inBuf)
+	}
+
 	if dryRun, _ := cmd.Flags().GetBool(flags.FlagDryRun); dryRun {
 		// use in memory keybase
 		kb = keyring.NewInMemory(ctx.Codec, etherminthd.EthSecp256k1Option())
@@ -193,27 +204,114 @@ func RunAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		return printCreate(cmd, k, false, "", outputFormat)
 	}
 
-	// Get bip39 mnemonic
-	var mnemonic, bip39Passphrase string
+	recoverKey, _ := cmd.Flags().GetBool(flagRecover)
+	mnemonic, bip39Passphrase, err := readMnemonic(cmd, inBuf)
+	if err != nil {
+		return err
+	}
+
+	k, err := kb.NewAccount(name, mnemonic, bip39Passphrase, hdPath, algo)
+	if err != nil {
+		return err
+	}
+
+	// Recover key from seed passphrase
+	if recoverKey {
+		// Hide mnemonic from output
+		showMnemonic = false
+		mnemonic = ""
+	}
+
+	return printCreate(cmd, k, showMnemonic, mnemonic, outputFormat)
+}
+
+// runAddAccountsCmd derives and stores the given number of sequential accounts from a mnemonic,
+// from the address index of the index flag. The keys are named after the name and their index.
+func runAddAccountsCmd(
+	ctx client.Context, cmd *cobra.Command, name string, count uint32, algo keyring.SignatureAlgo, inBuf *bufio.Reader,
+) error {
+	for _, flag := range []string{flags.FlagUseLedger, flagMultisig, keys.FlagPublicKey, flagHDPath} {
+		if cmd.Flags().Changed(flag) {
+			return fmt.Errorf("cannot derive several accounts with --%s", flag)
+		}
+	}
+
+	coinType, _ := cmd.Flags().GetUint32(flagCoinType)
+	account, _ := cmd.Flags().GetUint32(flagAccount)
+	index, _ := cmd.Flags().GetUint32(flagIndex)
+	if uint64(index)+uint64(count) > hdkeychain.HardenedKeyStart {
+		return fmt.Errorf("the address indexes of the accounts must be less than %d", uint32(hdkeychain.HardenedKeyStart))
+	}
+
+	kb := ctx.Keyring
+	if dryRun, _ := cmd.Flags().GetBool(flags.FlagDryRun); dryRun {
+		// use in memory keybase
+		kb = keyring.NewInMemory(ctx.Codec, etherminthd.EthSecp256k1Option())
+	}
+
+	names := make([]string, count)
+	for i := range names {
+		names[i] = fmt.Sprintf("%s-%d", name, index+uint32(i))
+		if _, err := kb.Key(names[i]); err != nil {
+			continue
+		}
+
+		// account exists, ask for user confirmation
+		response, err := input.GetConfirmation(fmt.Sprintf("override the existing name %s", names[i]), inBuf, cmd.ErrOrStderr())
+		if err != nil {
+			return err
+		}
+		if !response {
+			return errors.New("aborted")
+		}
+		if err := kb.Delete(names[i]); err != nil {
+			return err
+		}
+	}
+
+	recoverKey, _ := cmd.Flags().GetBool(flagRecover)
+	mnemonic, bip39Passphrase, err := readMnemonic(cmd, inBuf)
+	if err != nil {
+		return err
+	}
+
+	records := make([]*keyring.Record, count)
+	for i := range records {
+		hdPath := hd.CreateHDPath(coinType, account, index+uint32(i)).String()
+		if records[i], err = kb.NewAccount(names[i], mnemonic, bip39Passphrase, hdPath, algo); err != nil {
+			return err
+		}
+	}
+
+	// Hide the recovered mnemonic from the output
+	noBackup, _ := cmd.Flags().GetBool(flagNoBackup)
+	showMnemonic := !noBackup && !recoverKey
+	return printCreateAccounts(cmd, records, showMnemonic, mnemonic, ctx.OutputFormat)
+}
 
+// readMnemonic returns the bip39 mnemonic and passphrase of the new keys. The mnemonic is
+// generated unless it is entered by the user.
+func readMnemonic(cmd *cobra.Command, inBuf *bufio.Reader) (mnemonic, bip39Passphrase string, err error) {
 	recoverKey, _ := cmd.Flags().GetBool(flagRecover)
+	interactive, _ := cmd.Flags().GetBool(flagInteractive)
+
 	if recoverKey {
 		mnemonic, err = input.GetString("Enter your bip39 mnemonic", inBuf)
 		if err != nil {
-			return err
+			return "", "", err
 		}
 
 		if !bip39.IsMnemonicValid(mnemonic) {
-			return errors.New("invalid mnemonic")
+			return "", "", errors.New("invalid mnemonic")
 		}
 	} else if interactive {
 		mnemonic, err = input.GetString("Enter your bip39 mnemonic, or hit enter to generate one.", inBuf)
 		if err != nil {
-			return err
+			return "", "", err
 		}
 
 		if !bip39.IsMnemonicValid(mnemonic) && mnemonic != "" {
-			return errors.New("invalid mnemonic")
+			return "", "", errors.New("invalid mnemonic")
 		}
 	}
 
@@ -221,12 +319,12 @@ func RunAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		// read entropy seed straight from tmcrypto.Rand and convert to mnemonic
 		entropySeed, err := bip39.NewEntropy(mnemonicEntropySize)
 		if err != nil {
-			return err
+			return "", "", err
 		}
 
 		mnemonic, err = bip39.NewMnemonic(entropySeed)
 		if err != nil {
-			return err
+			return "", "", err
 		}
 	}
 
@@ -236,35 +334,23 @@ func RunAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 			"Enter your bip39 passphrase. This is combined with the mnemonic to derive the seed. "+
 				"Most users should just hit enter to use the default, \"\"", inBuf)
 		if err != nil {
-			return err
+			return "", "", err
 		}
 
 		// if they use one, make them re-enter it
 		if len(bip39Passphrase) != 0 {
 			p2, err := input.GetString("Repeat the passphrase:", inBuf)
 			if err != nil {
-				return err
+				return "", "", err
 			}
 
 			if bip39Passphrase != p2 {
-				return errors.New("passphrases don't match")
+				return "", "", errors.New("passphrases don't match")
 			}
 		}
 	}
 
-	k, err := kb.NewAccount(name, mnemonic, bip39Passphrase, hdPath, algo)
-	if err != nil {
-		return err
-	}
-
-	// Recover key from seed passphrase
-	if recoverKey {
-		// Hide mnemonic from output
-		showMnemonic = false
-		mnemonic = ""
-	}
-
-	return printCreate(cmd, k, showMnemonic, mnemonic, outputFormat)
+	return mnemonic, bip39Passphrase, nil
 }
 
 func printCreate(cmd *cobra.Command, k *keyring.Record, showMnemonic bool, mnemonic, outputFormat string) error {
@@ -307,6 +393,49 @@ func printCreate(cmd *cobra.Command, k *keyring.Record, showMnemonic bool, mnemo
 	return nil
 }
 
+// printCreateAccounts prints the accounts derived from the same mnemonic.
+func printCreateAccounts(cmd *cobra.Command, records []*keyring.Record, showMnemonic bool, mnemonic, outputFormat string) error {
+	kos, err := keyring.MkAccKeysOutput(records)
+	if err != nil {
+		return err
+	}
+
+	switch outputFormat {
+	case OutputFormatText:
+		cmd.PrintErrln()
+		if err := printTextRecords(cmd.OutOrStdout(), kos); err != nil {
+			return err
+		}
+
+		// print mnemonic unless requested not to.
+		if showMnemonic {
+			if _, err := fmt.Fprintf(cmd.ErrOrStderr(),
+				"\n**Important** write this mnemonic phrase in a safe place.\nIt is the only way to recover your accounts if you ever forget your password.\n\n%s\n\n", //nolint:lll
+				mnemonic); err != nil {
+				return fmt.Errorf("failed to print mnemonic: %v", err)
+			}
+		}
+	case OutputFormatJSON:
+		if showMnemonic {
+			for i := range kos {
+				kos[i].Mnemonic = mnemonic
+			}
+		}
+
+		jsonString, err := keys.KeysCdc.MarshalJSON(kos)
+		if err != nil {
+			return err
+		}
+
+		cmd.Println(string(jsonString))
+
+	default:
+		return fmt.Errorf("invalid output format %s", outputFormat)
+	}
+
+	return nil
+}
+
 func validateMultisigThreshold(k, nKeys int) error {
 	if k <= 0 {
 		return fmt.Errorf("threshold must be a positive integer")
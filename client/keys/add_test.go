@@ -0,0 +1,67 @@
+package keys
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/crypto/hd"
+	"github.com/evmos/ethermint/encoding"
+)
+
+func TestRunAddAccountsCmd(t *testing.T) {
+	encCfg := encoding.MakeConfig(nil)
+	kb := keyring.NewInMemory(encCfg.Codec, hd.EthSecp256k1Option())
+	clientCtx := client.Context{}.WithKeyring(kb).WithCodec(encCfg.Codec).WithOutputFormat(OutputFormatJSON)
+	mnemonic := "test test test test test test test test test test test junk"
+
+	newCmd := func(args ...string) *bytes.Buffer {
+		cmd := keys.AddKeyCommand()
+		cmd.Flags().Uint32(FlagCount, 1, "")
+		require.NoError(t, cmd.Flags().Set(flags.FlagKeyAlgorithm, string(hd.EthSecp256k1Type)))
+		require.NoError(t, cmd.Flags().Parse(args))
+
+		out := new(bytes.Buffer)
+		cmd.SetOut(out)
+		cmd.SetErr(out)
+		err := RunAddCmd(clientCtx, cmd, []string{"qa"}, bufio.NewReader(strings.NewReader(mnemonic+"\n")))
+		require.NoError(t, err)
+		return out
+	}
+
+	out := newCmd("--count", "3", "--index", "1", "--coin-type", "60", "--recover")
+	require.NotContains(t, out.String(), mnemonic)
+
+	// the accounts of the default Hardhat mnemonic at the indexes 1 to 3
+	expAddrs := []string{
+		"0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+		"0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC",
+		"0x90F79bf6EB2c4f870365E785982E1f101E93b906",
+	}
+	for i, name := range []string{"qa-1", "qa-2", "qa-3"} {
+		record, err := kb.Key(name)
+		require.NoError(t, err)
+		addr, err := record.GetAddress()
+		require.NoError(t, err)
+		require.Equal(t, expAddrs[i], common.BytesToAddress(addr).Hex())
+	}
+
+	// a single key keeps its name
+	newCmd("--recover")
+	_, err := kb.Key("qa")
+	require.NoError(t, err)
+
+	cmd := keys.AddKeyCommand()
+	cmd.Flags().Uint32(FlagCount, 1, "")
+	require.NoError(t, cmd.Flags().Parse([]string{"--count", "2", "--hd-path", "m/44'/60'/0'/0/0"}))
+	err = RunAddCmd(clientCtx, cmd, []string{"other"}, bufio.NewReader(strings.NewReader("")))
+	require.ErrorContains(t, err, "--hd-path")
+}
@@ -0,0 +1,124 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keys
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	cryptokeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+)
+
+const (
+	flagEth       = "eth"
+	flagListNames = "list-names"
+)
+
+// ethKeyOutput is the output of a key with the hex address of its Ethereum account.
+type ethKeyOutput struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Address string `json:"address"`
+	// EthAddress is empty for the keys which aren't eth_secp256k1 keys
+	EthAddress string `json:"eth_address,omitempty"`
+	PubKey     string `json:"pubkey"`
+}
+
+// ListKeysCmd lists all keys in the key store as the list command of the SDK, with the hex
+// addresses of the Ethereum accounts next to the bech32 addresses if requested.
+func ListKeysCmd() *cobra.Command {
+	cmd := keys.ListKeysCmd()
+	runList := cmd.RunE
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		eth, _ := cmd.Flags().GetBool(flagEth)
+		listNames, _ := cmd.Flags().GetBool(flagListNames)
+		if !eth || listNames {
+			return runList(cmd, args)
+		}
+
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		records, err := clientCtx.Keyring.List()
+		if err != nil {
+			return err
+		}
+
+		if len(records) == 0 {
+			cmd.Println("No records were found in keyring")
+			return nil
+		}
+
+		return printEthKeyringRecords(cmd, records, clientCtx.OutputFormat)
+	}
+
+	cmd.Flags().Bool(flagEth, false, "Show the hex addresses of the Ethereum accounts next to the bech32 addresses")
+	return cmd
+}
+
+func printEthKeyringRecords(cmd *cobra.Command, records []*cryptokeyring.Record, output string) error {
+	kos := make([]ethKeyOutput, len(records))
+	for i, record := range records {
+		ko, err := cryptokeyring.MkAccKeyOutput(record)
+		if err != nil {
+			return err
+		}
+
+		kos[i] = ethKeyOutput{
+			Name:    ko.Name,
+			Type:    ko.Type,
+			Address: ko.Address,
+			PubKey:  ko.PubKey,
+		}
+
+		pubKey, err := record.GetPubKey()
+		if err != nil {
+			return err
+		}
+		if _, ok := pubKey.(*ethsecp256k1.PubKey); ok {
+			kos[i].EthAddress = common.BytesToAddress(pubKey.Address()).Hex()
+		}
+	}
+
+	var (
+		out []byte
+		err error
+	)
+	switch output {
+	case OutputFormatText:
+		out, err = yaml.Marshal(kos)
+	case OutputFormatJSON:
+		out, err = json.Marshal(kos)
+	default:
+		return fmt.Errorf("invalid output format %s", output)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(out))
+	return err
+}
@@ -0,0 +1,163 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package client
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/crypto/hd"
+)
+
+// FlagLightKDF selects the light scrypt parameters to encrypt an exported keystore
+const FlagLightKDF = "light-kdf"
+
+// ImportKeystoreCommand imports the private key of an Ethereum keystore file.
+func ImportKeystoreCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-eth-keystore <name> <keyfile>",
+		Short: "Import an Ethereum keystore file into the local keybase",
+		Long: `Import the private key of an Ethereum keystore file (Web3 Secret Storage, scrypt or pbkdf2), as written by geth or MetaMask, into the local keybase.
+The password of the keystore is prompted, or read from the standard input.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			keyJSON, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			password, err := input.GetPassword("Enter the password of the keystore:", inBuf)
+			if err != nil {
+				return err
+			}
+
+			address, err := ImportKeystore(clientCtx.Keyring, args[0], keyJSON, password)
+			if err != nil {
+				return err
+			}
+
+			cmd.PrintErrf("imported key %s with address %s\n", args[0], address.Hex())
+			return nil
+		},
+	}
+}
+
+// ExportKeystoreCommand exports a key with the given name as an Ethereum keystore file.
+func ExportKeystoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-eth-keystore <name>",
+		Short: "Export an Ethereum private key as a keystore file",
+		Long: `Export an Ethereum private key as a keystore file (Web3 Secret Storage, scrypt), to import it in geth or MetaMask.
+The password encrypting the keystore is prompted, or read from the standard input. The keystore is written to the standard output.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			password, err := input.GetPassword("Enter the password to encrypt the keystore:", inBuf)
+			if err != nil {
+				return err
+			}
+			repeat, err := input.GetPassword("Repeat the password:", inBuf)
+			if err != nil {
+				return err
+			}
+			if password != repeat {
+				return errors.New("passwords don't match")
+			}
+
+			scryptN, scryptP := keystore.StandardScryptN, keystore.StandardScryptP
+			if lightKDF, _ := cmd.Flags().GetBool(FlagLightKDF); lightKDF {
+				scryptN, scryptP = keystore.LightScryptN, keystore.LightScryptP
+			}
+
+			keyJSON, err := ExportKeystore(clientCtx.Keyring, args[0], password, scryptN, scryptP)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(keyJSON))
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(FlagLightKDF, false, "Use the light scrypt parameters, faster but less secure")
+	return cmd
+}
+
+// ImportKeystore decrypts the Ethereum keystore JSON key with the password and stores the private
+// key in the keyring under the given name.
+func ImportKeystore(kr keyring.Keyring, name string, keyJSON []byte, password string) (common.Address, error) {
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to decrypt the keystore: %w", err)
+	}
+
+	privKey := &ethsecp256k1.PrivKey{Key: ethcrypto.FromECDSA(key.PrivateKey)}
+	armor := crypto.EncryptArmorPrivKey(privKey, password, ethsecp256k1.KeyType)
+
+	if err := kr.ImportPrivKey(name, armor, password); err != nil {
+		return common.Address{}, err
+	}
+
+	return key.Address, nil
+}
+
+// ExportKeystore returns the private key of the keyring with the given name as an Ethereum keystore
+// JSON key encrypted with the password.
+func ExportKeystore(kr keyring.Keyring, name, password string, scryptN, scryptP int) ([]byte, error) {
+	privKey, err := exportEthPrivKey(kr, name, password)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	key := &keystore.Key{
+		Id:         id,
+		Address:    ethcrypto.PubkeyToAddress(privKey.PublicKey),
+		PrivateKey: privKey,
+	}
+
+	return keystore.EncryptKey(key, password, scryptN, scryptP)
+}
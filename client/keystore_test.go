@@ -0,0 +1,54 @@
+package client
+
+import (
+	"testing"
+
+	cosmoshd "github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/crypto/hd"
+	"github.com/evmos/ethermint/encoding"
+)
+
+func TestKeystore(t *testing.T) {
+	encCfg := encoding.MakeConfig(nil)
+	kb := keyring.NewInMemory(encCfg.Codec, hd.EthSecp256k1Option())
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(privKey.PublicKey)
+
+	key := &keystore.Key{Id: uuid.New(), Address: address, PrivateKey: privKey}
+	keyJSON, err := keystore.EncryptKey(key, "password", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	_, err = ImportKeystore(kb, "imported", keyJSON, "wrong")
+	require.Error(t, err)
+
+	imported, err := ImportKeystore(kb, "imported", keyJSON, "password")
+	require.NoError(t, err)
+	require.Equal(t, address, imported)
+
+	record, err := kb.KeyByAddress(sdk.AccAddress(address.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, "imported", record.Name)
+
+	exported, err := ExportKeystore(kb, "imported", "other", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	key, err = keystore.DecryptKey(exported, "other")
+	require.NoError(t, err)
+	require.Equal(t, address, key.Address)
+	require.Equal(t, crypto.FromECDSA(privKey), crypto.FromECDSA(key.PrivateKey))
+
+	// the keys of the other algorithms can't be exported
+	_, _, err = kb.NewMnemonic("cosmos", keyring.English, sdk.FullFundraiserPath, "", cosmoshd.Secp256k1)
+	require.NoError(t, err)
+	_, err = ExportKeystore(kb, "cosmos", "other", keystore.LightScryptN, keystore.LightScryptP)
+	require.Error(t, err)
+}
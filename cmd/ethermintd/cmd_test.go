@@ -26,3 +26,15 @@ func TestInitCmd(t *testing.T) {
 	err := svrcmd.Execute(rootCmd, "", app.DefaultNodeHome)
 	require.NoError(t, err)
 }
+
+func TestRPCGatewayCmdUnreachableNode(t *testing.T) {
+	rootCmd, _ := ethermintd.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"rpc-gateway",
+		fmt.Sprintf("--%s=%s", flags.FlagHome, t.TempDir()),
+		fmt.Sprintf("--%s=%s", flags.FlagNode, "tcp://127.0.0.1:1"),
+	})
+
+	err := svrcmd.Execute(rootCmd, "", app.DefaultNodeHome)
+	require.ErrorContains(t, err, "failed to get the status of the node")
+}
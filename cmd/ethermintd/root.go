@@ -130,7 +130,9 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 	)
 
 	a := appCreator{encodingConfig}
-	server.AddCommands(rootCmd, server.NewDefaultStartOptions(a.newApp, app.DefaultNodeHome), a.appExport, addModuleInitFlags)
+	startOpts := server.NewDefaultStartOptions(a.newApp, app.DefaultNodeHome)
+	startOpts.ModuleBasics = app.ModuleBasics
+	server.AddCommands(rootCmd, startOpts, a.appExport, addModuleInitFlags)
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
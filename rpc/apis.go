@@ -25,6 +25,7 @@ import (
 
 	"github.com/evmos/ethermint/rpc/backend"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/debug"
+	"github.com/evmos/ethermint/rpc/namespaces/ethereum/dev"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/eth"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/miner"
@@ -32,6 +33,7 @@ import (
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/personal"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/txpool"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/web3"
+	"github.com/evmos/ethermint/rpc/signer"
 	ethermint "github.com/evmos/ethermint/types"
 
 	rpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
@@ -53,6 +55,11 @@ const (
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
 
+	// Dev node namespaces
+
+	EVMNamespace   = "evm"
+	AnvilNamespace = "anvil"
+
 	apiVersion = "1.0"
 )
 
@@ -68,6 +75,45 @@ type APICreator = func(
 // apiCreators defines the JSON-RPC API namespaces.
 var apiCreators map[string]APICreator
 
+// impersonator sends the transactions of the accounts impersonated on the dev node, nil on the
+// other nodes.
+var impersonator backend.Impersonator
+
+// unlockAccounts is true if the accounts of the node keyring are unlocked when the server starts,
+// as on the dev node.
+var unlockAccounts bool
+
+// txSigner is the signer shared by the backends of the namespaces, so that the accounts unlocked
+// in the personal namespace are unlocked in the eth namespace. It is created with the first
+// backend of the server.
+var txSigner signer.Signer
+
+// newBackend creates the backend of the namespaces, with the signer shared by the namespaces, which
+// impersonates the accounts on the dev node.
+func newBackend(
+	ctx *server.Context,
+	clientCtx client.Context,
+	allowUnprotectedTxs bool,
+	indexer ethermint.EVMTxIndexer,
+) *backend.Backend {
+	evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+	if txSigner == nil {
+		txSigner = evmBackend.Signer()
+		if locker, ok := txSigner.(*signer.LockingSigner); ok && unlockAccounts {
+			if err := locker.UnlockAccounts(); err != nil {
+				ctx.Logger.Error("failed to unlock the accounts of the node", "error", err.Error())
+			}
+		}
+	} else {
+		evmBackend.SetSigner(txSigner)
+	}
+
+	if impersonator != nil {
+		evmBackend.SetImpersonator(impersonator)
+	}
+	return evmBackend
+}
+
 func init() {
 	apiCreators = map[string]APICreator{
 		EthNamespace: func(ctx *server.Context,
@@ -76,7 +122,7 @@ func init() {
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := newBackend(ctx, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: EthNamespace,
@@ -118,7 +164,7 @@ func init() {
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := newBackend(ctx, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: PersonalNamespace,
@@ -144,7 +190,7 @@ func init() {
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := newBackend(ctx, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: DebugNamespace,
@@ -160,7 +206,7 @@ func init() {
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := newBackend(ctx, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: MinerNamespace,
@@ -173,6 +219,38 @@ func init() {
 	}
 }
 
+// RegisterDevAPIs registers the namespaces controlling the dev node, and makes the backends of the
+// other namespaces send the transactions of the accounts impersonated by the given impersonator.
+// The accounts of the node keyring are unlocked, as on a geth dev node.
+func RegisterDevAPIs(node dev.Node, devImpersonator backend.Impersonator) error {
+	impersonator = devImpersonator
+	unlockAccounts = true
+
+	if err := RegisterAPINamespace(EVMNamespace, func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ ethermint.EVMTxIndexer) []rpc.API {
+		return []rpc.API{
+			{
+				Namespace: EVMNamespace,
+				Version:   apiVersion,
+				Service:   dev.NewEVMAPI(ctx.Logger, node),
+				Public:    false,
+			},
+		}
+	}); err != nil {
+		return err
+	}
+
+	return RegisterAPINamespace(AnvilNamespace, func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ ethermint.EVMTxIndexer) []rpc.API {
+		return []rpc.API{
+			{
+				Namespace: AnvilNamespace,
+				Version:   apiVersion,
+				Service:   dev.NewAnvilAPI(ctx.Logger, node),
+				Public:    false,
+			},
+		}
+	})
+}
+
 // GetRPCAPIs returns the list of all APIs
 func GetRPCAPIs(ctx *server.Context,
 	clientCtx client.Context,
@@ -183,6 +261,8 @@ func GetRPCAPIs(ctx *server.Context,
 ) []rpc.API {
 	var apis []rpc.API
 
+	// the backends of the server share a new signer
+	txSigner = nil
 	for _, ns := range selectedAPIs {
 		if creator, ok := apiCreators[ns]; ok {
 			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer)...)
@@ -39,6 +39,14 @@ func (b *Backend) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNu
 		return nil, err
 	}
 
+	// only the code at a given height is immutable
+	cacheKey := fmt.Sprintf("%s:%d", address.Hex(), blockNum)
+	if blockNum > 0 {
+		if cached, ok := b.cache.get(cacheKindCode, cacheKey); ok {
+			return cached.(hexutil.Bytes), nil
+		}
+	}
+
 	req := &evmtypes.QueryCodeRequest{
 		Address: address.String(),
 	}
@@ -48,6 +56,10 @@ func (b *Backend) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNu
 		return nil, err
 	}
 
+	if blockNum > 0 {
+		b.cache.add(cacheKindCode, cacheKey, hexutil.Bytes(res.Code))
+	}
+
 	return res.Code, nil
 }
 
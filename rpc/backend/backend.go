@@ -30,12 +30,14 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/evmos/ethermint/rpc/signer"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
 	"github.com/evmos/ethermint/server/config"
 	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"go.opentelemetry.io/otel"
 )
 
 // BackendI implements the Cosmos and EVM backend.
@@ -63,7 +65,10 @@ type EVMBackend interface {
 	SetEtherbase(etherbase common.Address) bool
 	SetGasPrice(gasPrice hexutil.Big) bool
 	ImportRawKey(privkey, password string) (common.Address, error)
+	ImportKeystore(keyJSON, password string) (common.Address, error)
 	ListAccounts() ([]common.Address, error)
+	UnlockAccount(address common.Address, passphrase string, duration time.Duration) (bool, error)
+	LockAccount(address common.Address) bool
 	NewMnemonic(uid string, language keyring.Language, hdPath, bip39Passphrase string, algo keyring.SignatureAlgo) (*keyring.Record, error)
 	UnprotectedAllowed() bool
 	RPCGasCap() uint64            // global gas cap for eth_call over rpc: DoS protection
@@ -74,6 +79,10 @@ type EVMBackend interface {
 	// Sign Tx
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
+	SendTransactionWithPassphrase(args evmtypes.TransactionArgs, passphrase string) (common.Hash, error)
+	SignTransaction(args evmtypes.TransactionArgs) (*rpctypes.SignTransactionResult, error)
+	SignTransactionWithPassphrase(args evmtypes.TransactionArgs, passphrase string) (*rpctypes.SignTransactionResult, error)
+	SignWithPassphrase(address common.Address, data hexutil.Bytes, passphrase string) (hexutil.Bytes, error)
 	SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error)
 
 	// Blocks Info
@@ -126,8 +135,8 @@ type EVMBackend interface {
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
-	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
+	EstimateGas(ctx context.Context, args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
+	DoCall(ctx context.Context, args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
@@ -136,14 +145,23 @@ type EVMBackend interface {
 	BloomStatus() (uint64, uint64)
 
 	// Tracing
-	TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error)
-	TraceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	TraceTransaction(ctx context.Context, hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error)
+	TraceBlock(
+		ctx context.Context,
+		height rpctypes.BlockNumber,
+		config *evmtypes.TraceConfig,
+		block *tmrpctypes.ResultBlock,
+	) ([]*evmtypes.TxTraceResult, error)
 }
 
 var _ BackendI = (*Backend)(nil)
 
 var bAttributeKeyEthereumBloom = []byte(evmtypes.AttributeKeyEthereumBloom)
 
+// tracer records the spans of the backend methods that execute the EVM, which are children of the
+// JSON-RPC request spans.
+var tracer = otel.Tracer("github.com/evmos/ethermint/rpc/backend")
+
 // Backend implements the BackendI interface
 type Backend struct {
 	ctx                 context.Context
@@ -154,6 +172,30 @@ type Backend struct {
 	cfg                 config.Config
 	allowUnprotectedTxs bool
 	indexer             ethermint.EVMTxIndexer
+	cache               *responseCache
+	signer              signer.Signer
+	locker              *signer.LockingSigner // nil with an external signer
+	impersonator        Impersonator
+}
+
+// Impersonator sends and recovers the transactions of the accounts impersonated on the dev node,
+// which aren't signed.
+type Impersonator interface {
+	// TxSigner wraps the signer of the node to send the transactions of the impersonated accounts.
+	TxSigner(s signer.Signer) signer.Signer
+	// Signer wraps the Ethereum signer to recover the sender of the impersonated transactions.
+	Signer(s ethtypes.Signer) ethtypes.Signer
+}
+
+// passphraseVerifier returns the verifier of the passphrases of the keyring accounts. Only the file
+// backend encrypts the keys with a passphrase the node can verify. The other backends either don't
+// encrypt the keys (test, memory) or leave it to the OS (os, kwallet, pass), so their accounts are
+// unlocked without verifying the passphrase, but they are still locked by default.
+func passphraseVerifier(clientCtx client.Context) signer.PassphraseVerifier {
+	if clientCtx.Keyring == nil || clientCtx.Keyring.Backend() != keyring.BackendFile {
+		return nil
+	}
+	return signer.KeyringPassphraseVerifier(clientCtx.KeyringDir, clientCtx.Codec)
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -174,7 +216,16 @@ func NewBackend(
 		panic(err)
 	}
 
-	return &Backend{
+	// the locked keys of the node keyring sign unless an external signer is configured, which
+	// manages the approval of the signatures itself
+	var txSigner signer.Signer
+	if appConf.JSONRPC.Signer != "" {
+		txSigner = signer.NewExternalSigner(appConf.JSONRPC.Signer)
+	} else {
+		txSigner = signer.NewLockingSigner(signer.NewKeyringSigner(clientCtx.Keyring), passphraseVerifier(clientCtx))
+	}
+
+	b := &Backend{
 		ctx:                 context.Background(),
 		clientCtx:           clientCtx,
 		queryClient:         rpctypes.NewQueryClient(clientCtx),
@@ -183,5 +234,66 @@ func NewBackend(
 		cfg:                 appConf,
 		allowUnprotectedTxs: allowUnprotectedTxs,
 		indexer:             indexer,
+		cache:               newResponseCache(appConf.JSONRPC.ResponseCacheSize),
+	}
+	b.SetSigner(txSigner)
+	return b
+}
+
+// Signer returns the signer of the transactions sent by the backend.
+func (b *Backend) Signer() signer.Signer {
+	return b.signer
+}
+
+// SetSigner makes the backend sign with the given signer. The backends of the namespaces share
+// their signer, so that the accounts unlocked in the personal namespace are unlocked in the eth
+// namespace.
+func (b *Backend) SetSigner(txSigner signer.Signer) {
+	b.signer = txSigner
+	b.locker, _ = txSigner.(*signer.LockingSigner)
+}
+
+// SetImpersonator makes the backend send and recover the transactions of the accounts impersonated
+// on the dev node.
+func (b *Backend) SetImpersonator(impersonator Impersonator) {
+	b.impersonator = impersonator
+	b.signer = impersonator.TxSigner(b.signer)
+}
+
+// sender returns the sender of the transaction, which the impersonator recovers on the dev node.
+func (b *Backend) sender(msg *evmtypes.MsgEthereumTx, chainID *big.Int) (common.Address, error) {
+	if b.impersonator == nil {
+		return msg.GetSender(chainID)
+	}
+	return b.impersonator.Signer(ethtypes.LatestSignerForChainID(chainID)).Sender(msg.AsTransaction())
+}
+
+// newTransactionFromMsg returns the RPC transaction of the message, as rpctypes.NewTransactionFromMsg,
+// with the sender of the impersonated transactions.
+func (b *Backend) newTransactionFromMsg(
+	msg *evmtypes.MsgEthereumTx,
+	blockHash common.Hash,
+	blockNumber, index uint64,
+	baseFee *big.Int,
+	chainID *big.Int,
+) (*rpctypes.RPCTransaction, error) {
+	rpcTx, err := rpctypes.NewTransactionFromMsg(msg, blockHash, blockNumber, index, baseFee, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	b.setImpersonatedSender(rpcTx, msg.AsTransaction())
+	return rpcTx, nil
+}
+
+// setImpersonatedSender sets the sender of the RPC transaction sent by an account impersonated on
+// the dev node, which the RPC transaction can't recover.
+func (b *Backend) setImpersonatedSender(rpcTx *rpctypes.RPCTransaction, tx *ethtypes.Transaction) {
+	if b.impersonator == nil || !tx.Protected() {
+		return
+	}
+
+	if from, err := b.impersonator.Signer(ethtypes.LatestSignerForChainID(tx.ChainId())).Sender(tx); err == nil {
+		rpcTx.From = from
 	}
 }
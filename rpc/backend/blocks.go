@@ -64,6 +64,14 @@ func (b *Backend) BlockNumber() (hexutil.Uint64, error) {
 // block number. Depending on fullTx it either returns the full transaction
 // objects or if false only the hashes of the transactions.
 func (b *Backend) GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	// only the blocks requested by height are immutable
+	cacheKey := fmt.Sprintf("%d:%t", blockNum, fullTx)
+	if blockNum > 0 {
+		if cached, ok := b.cache.get(cacheKindBlock, cacheKey); ok {
+			return cached.(map[string]interface{}), nil
+		}
+	}
+
 	resBlock, err := b.TendermintBlockByNumber(blockNum)
 	if err != nil {
 		return nil, nil
@@ -86,12 +94,21 @@ func (b *Backend) GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (
 		return nil, err
 	}
 
+	if blockNum > 0 {
+		b.cache.add(cacheKindBlock, cacheKey, res)
+	}
+
 	return res, nil
 }
 
 // GetBlockByHash returns the JSON-RPC compatible Ethereum block identified by
 // hash.
 func (b *Backend) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error) {
+	cacheKey := fmt.Sprintf("%s:%t", hash.Hex(), fullTx)
+	if cached, ok := b.cache.get(cacheKindBlock, cacheKey); ok {
+		return cached.(map[string]interface{}), nil
+	}
+
 	resBlock, err := b.TendermintBlockByHash(hash)
 	if err != nil {
 		return nil, err
@@ -114,6 +131,8 @@ func (b *Backend) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]inte
 		return nil, err
 	}
 
+	b.cache.add(cacheKindBlock, cacheKey, res)
+
 	return res, nil
 }
 
@@ -311,6 +330,7 @@ func (b *Backend) HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Heade
 	}
 
 	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header, bloom, baseFee)
+	ethHeader.Time = rpctypes.BlockTimeFromEvents(resBlock.Block.Header, blockRes.BeginBlockEvents)
 	return ethHeader, nil
 }
 
@@ -341,6 +361,7 @@ func (b *Backend) HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
 	}
 
 	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header, bloom, baseFee)
+	ethHeader.Time = rpctypes.BlockTimeFromEvents(resBlock.Block.Header, blockRes.BeginBlockEvents)
 	return ethHeader, nil
 }
 
@@ -397,6 +418,7 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 			b.logger.Debug("NewTransactionFromData for receipt failed", "hash", tx.Hash().Hex(), "error", err.Error())
 			continue
 		}
+		b.setImpersonatedSender(rpcTx, tx)
 		ethRPCTxs = append(ethRPCTxs, rpcTx)
 	}
 
@@ -452,6 +474,7 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 		gasLimit, new(big.Int).SetUint64(gasUsed),
 		ethRPCTxs, bloom, validatorAddr, baseFee,
 	)
+	formattedBlock["timestamp"] = hexutil.Uint64(rpctypes.BlockTimeFromEvents(block.Header, blockRes.BeginBlockEvents))
 	return formattedBlock, nil
 }
 
@@ -494,6 +517,7 @@ func (b *Backend) EthBlockFromTendermintBlock(
 	}
 
 	ethHeader := rpctypes.EthHeaderFromTendermint(block.Header, bloom, baseFee)
+	ethHeader.Time = rpctypes.BlockTimeFromEvents(block.Header, blockRes.BeginBlockEvents)
 	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
 
 	txs := make([]*ethtypes.Transaction, len(msgs))
@@ -0,0 +1,78 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+
+	rpcmetrics "github.com/evmos/ethermint/rpc/metrics"
+)
+
+// kinds of the cached responses
+const (
+	cacheKindBlock   = "block"
+	cacheKindReceipt = "receipt"
+	cacheKindCode    = "code"
+)
+
+// responseCache is an in-memory LRU cache of the immutable responses, which are either pinned to a
+// committed height or keyed by hash. Tendermint blocks are final once committed, so the responses
+// don't change once they are found. A nil cache is disabled.
+//
+// NOTE: the cached values are shared between the callers and must not be modified.
+type responseCache struct {
+	lru *lru.Cache
+}
+
+// newResponseCache creates a cache holding up to size responses, it returns nil if the size is
+// zero.
+func newResponseCache(size int) *responseCache {
+	if size <= 0 {
+		return nil
+	}
+
+	cache, err := lru.New(size)
+	if err != nil {
+		// unreachable, the size is positive
+		panic(err)
+	}
+
+	return &responseCache{lru: cache}
+}
+
+// get returns the cached response of the given kind and key.
+func (c *responseCache) get(kind, key string) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	value, ok := c.lru.Get(kind + ":" + key)
+	if ok {
+		rpcmetrics.CacheHits.WithLabelValues(kind).Inc()
+	} else {
+		rpcmetrics.CacheMisses.WithLabelValues(kind).Inc()
+	}
+	return value, ok
+}
+
+// add caches the response of the given kind and key.
+func (c *responseCache) add(kind, key string, value interface{}) {
+	if c == nil {
+		return
+	}
+
+	c.lru.Add(kind+":"+key, value)
+}
@@ -0,0 +1,107 @@
+package backend
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/evmos/ethermint/rpc/backend/mocks"
+	rpcmetrics "github.com/evmos/ethermint/rpc/metrics"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	"github.com/evmos/ethermint/tests"
+)
+
+func (suite *BackendTestSuite) TestResponseCache() {
+	var disabled *responseCache
+	disabled.add(cacheKindCode, "key", hexutil.Bytes{1})
+	_, ok := disabled.get(cacheKindCode, "key")
+	suite.Require().False(ok)
+	suite.Require().Nil(newResponseCache(0))
+
+	cache := newResponseCache(1)
+	hits := testutil.ToFloat64(rpcmetrics.CacheHits.WithLabelValues(cacheKindCode))
+	misses := testutil.ToFloat64(rpcmetrics.CacheMisses.WithLabelValues(cacheKindCode))
+
+	_, ok = cache.get(cacheKindCode, "a")
+	suite.Require().False(ok)
+
+	cache.add(cacheKindCode, "a", hexutil.Bytes{1})
+	value, ok := cache.get(cacheKindCode, "a")
+	suite.Require().True(ok)
+	suite.Require().Equal(hexutil.Bytes{1}, value)
+
+	// the least recently used response is evicted
+	cache.add(cacheKindCode, "b", hexutil.Bytes{2})
+	_, ok = cache.get(cacheKindCode, "a")
+	suite.Require().False(ok)
+
+	suite.Require().Equal(hits+1, testutil.ToFloat64(rpcmetrics.CacheHits.WithLabelValues(cacheKindCode)))
+	suite.Require().Equal(misses+2, testutil.ToFloat64(rpcmetrics.CacheMisses.WithLabelValues(cacheKindCode)))
+}
+
+// resetMocks replaces the clients mocks, so that the queries not served from the cache fail.
+func (suite *BackendTestSuite) resetMocks() {
+	suite.backend.queryClient.QueryClient = mocks.NewEVMQueryClient(suite.T())
+	suite.backend.clientCtx.Client = mocks.NewClient(suite.T())
+}
+
+func (suite *BackendTestSuite) TestGetBlockByNumberCached() {
+	blockNum := rpctypes.BlockNumber(1)
+	height := blockNum.Int64()
+
+	suite.SetupTest()
+	suite.backend.cache = newResponseCache(10)
+
+	// the missing blocks aren't cached
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	_, err := RegisterBlockNotFound(client, height)
+	suite.Require().NoError(err)
+	block, err := suite.backend.GetBlockByNumber(blockNum, true)
+	suite.Require().NoError(err)
+	suite.Require().Nil(block)
+
+	suite.resetMocks()
+	client = suite.backend.clientCtx.Client.(*mocks.Client)
+	_, err = RegisterBlock(client, height, nil)
+	suite.Require().NoError(err)
+	_, err = RegisterBlockResults(client, height)
+	suite.Require().NoError(err)
+	RegisterConsensusParams(client, height)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterBaseFee(queryClient, sdk.NewInt(1))
+	RegisterValidatorAccount(queryClient, sdk.AccAddress(tests.GenerateAddress().Bytes()))
+
+	block, err = suite.backend.GetBlockByNumber(blockNum, true)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(block)
+
+	// served from the cache
+	suite.resetMocks()
+	cached, err := suite.backend.GetBlockByNumber(blockNum, true)
+	suite.Require().NoError(err)
+	suite.Require().Equal(block, cached)
+}
+
+func (suite *BackendTestSuite) TestGetCodeCached() {
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+	addr := tests.GenerateAddress()
+	code := []byte{1, 2, 3}
+
+	suite.SetupTest()
+	suite.backend.cache = newResponseCache(10)
+
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterCode(queryClient, addr, code)
+
+	res, err := suite.backend.GetCode(addr, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+	suite.Require().NoError(err)
+	suite.Require().Equal(hexutil.Bytes(code), res)
+
+	// served from the cache
+	suite.resetMocks()
+	res, err = suite.backend.GetCode(addr, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+	suite.Require().NoError(err)
+	suite.Require().Equal(hexutil.Bytes(code), res)
+}
@@ -30,6 +30,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
+	"github.com/evmos/ethermint/tracing"
 	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	"github.com/pkg/errors"
@@ -288,8 +289,10 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 			Nonce:                args.Nonce,
 		}
 
-		blockNr := rpctypes.NewBlockNumber(big.NewInt(0))
-		estimated, err := b.EstimateGas(callArgs, &blockNr)
+		// estimate against the latest state: block 0 is queried at height 1, where the accounts
+		// funded after genesis can't pay for the transaction
+		blockNr := rpctypes.EthLatestBlockNumber
+		estimated, err := b.EstimateGas(context.Background(), callArgs, &blockNr)
 		if err != nil {
 			return args, err
 		}
@@ -305,7 +308,14 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+func (b *Backend) EstimateGas(
+	ctx context.Context,
+	args evmtypes.TransactionArgs,
+	blockNrOptional *rpctypes.BlockNumber,
+) (hexutil.Uint64, error) {
+	ctx, span := tracing.StartSpan(ctx, tracer, "Backend.EstimateGas")
+	defer span.End()
+
 	blockNr := rpctypes.EthPendingBlockNumber
 	if blockNrOptional != nil {
 		blockNr = *blockNrOptional
@@ -332,7 +342,7 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
-	res, err := b.queryClient.EstimateGas(rpctypes.ContextWithHeight(blockNr.Int64()), &req)
+	res, err := b.queryClient.EstimateGas(rpctypes.ContextWithHeightFrom(ctx, blockNr.Int64()), &req)
 	if err != nil {
 		return 0, err
 	}
@@ -342,8 +352,11 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 // DoCall performs a simulated call operation through the evmtypes. It returns the
 // estimated gas used on the operation or an error if fails.
 func (b *Backend) DoCall(
-	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	ctx context.Context, args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, tracer, "Backend.DoCall")
+	defer span.End()
+
 	bz, err := json.Marshal(&args)
 	if err != nil {
 		return nil, err
@@ -364,7 +377,7 @@ func (b *Backend) DoCall(
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
-	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	ctx = rpctypes.ContextWithHeightFrom(ctx, blockNr.Int64())
 	timeout := b.RPCEVMTimeout()
 
 	// Setup context so it may be canceled the call has completed
@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -13,6 +14,7 @@ import (
 	rpctypes "github.com/evmos/ethermint/rpc/types"
 	"github.com/evmos/ethermint/tests"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -86,6 +88,7 @@ func (suite *BackendTestSuite) TestResend() {
 				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				RegisterParams(queryClient, &header, 1)
 				RegisterFeeMarketParams(feeMarketClient, 1)
+				RegisterFeeMarketFeeHistoryError(feeMarketClient, 1, &feemarkettypes.QueryFeeHistoryRequest{BlockCount: 20})
 				RegisterBlock(client, 1, nil)
 				RegisterBlockResults(client, 1)
 				RegisterBaseFee(queryClient, baseFee)
@@ -269,6 +272,42 @@ func (suite *BackendTestSuite) TestResend() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSetTxDefaultsEstimateGas() {
+	from := tests.GenerateAddress()
+	toAddr := tests.GenerateAddress()
+	txNonce := (hexutil.Uint64)(1)
+	gasPrice := (*hexutil.Big)(big.NewInt(1))
+	chainID := (*hexutil.Big)(suite.backend.chainID)
+	args := evmtypes.TransactionArgs{
+		From:                 &from,
+		To:                   &toAddr,
+		MaxFeePerGas:         gasPrice,
+		MaxPriorityFeePerGas: gasPrice,
+		Value:                gasPrice,
+		Nonce:                &txNonce,
+		ChainID:              chainID,
+	}
+
+	var header metadata.MD
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParams(queryClient, &header, 1)
+	RegisterBlock(client, 1, nil)
+	RegisterBlockResults(client, 1)
+	RegisterBaseFee(queryClient, sdk.NewInt(1))
+
+	// the gas is estimated against the latest state, without a height
+	callArgs := args
+	callArgs.Data = callArgs.Input
+	bz, _ := json.Marshal(callArgs)
+	queryClient.On("EstimateGas", rpctypes.ContextWithHeight(0), &evmtypes.EthCallRequest{Args: bz, ChainId: chainID.ToInt().Int64()}).
+		Return(&evmtypes.EstimateGasResponse{Gas: 21000}, nil)
+
+	res, err := suite.backend.SetTxDefaults(args)
+	suite.Require().NoError(err)
+	suite.Require().Equal(hexutil.Uint64(21000), *res.Gas)
+}
+
 func (suite *BackendTestSuite) TestSendRawTransaction() {
 	ethTx, bz := suite.buildEthereumTx()
 	rlpEncodedBz, _ := rlp.EncodeToBytes(ethTx.AsTransaction())
@@ -422,7 +461,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := suite.backend.DoCall(context.Background(), tc.callArgs, tc.blockNum)
 
 			if tc.expPass {
 				suite.Require().Equal(tc.expEthTx, msgEthTx)
@@ -450,6 +489,7 @@ func (suite *BackendTestSuite) TestGasPrice() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				RegisterFeeMarketParams(feeMarketClient, 1)
+				RegisterFeeMarketFeeHistoryError(feeMarketClient, 1, &feemarkettypes.QueryFeeHistoryRequest{BlockCount: 20})
 				RegisterParams(queryClient, &header, 1)
 				RegisterBlock(client, 1, nil)
 				RegisterBlockResults(client, 1)
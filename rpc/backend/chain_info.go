@@ -18,10 +18,12 @@ package backend
 import (
 	"fmt"
 	"math/big"
+	"sort"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/misc"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -32,6 +34,13 @@ import (
 	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
 )
 
+const (
+	// gasTipSampleBlocks is the number of recent blocks sampled to suggest a gas tip
+	gasTipSampleBlocks = 20
+	// gasTipPercentile is the percentile of the sampled gas tips that is suggested
+	gasTipPercentile = 60
+)
+
 // ChainID is the EIP-155 replay-protection chain id for the current ethereum chain config.
 func (b *Backend) ChainID() (*hexutil.Big, error) {
 	eip155ChainID, err := ethermint.ParseChainID(b.clientCtx.ChainID)
@@ -154,6 +163,8 @@ func (b *Backend) GetCoinbase() (sdk.AccAddress, error) {
 }
 
 // FeeHistory returns data relevant for fee estimation based on the specified range of blocks.
+// The data is served from the on-chain fee history of the feemarket module when it covers the
+// requested range, otherwise it is reconstructed from the Tendermint blocks.
 func (b *Backend) FeeHistory(
 	userBlockCount rpc.DecimalOrHex, // number blocks to fetch, maximum is 100
 	lastBlock rpc.BlockNumber, // the block to start search , to oldest
@@ -175,6 +186,10 @@ func (b *Backend) FeeHistory(
 		return nil, fmt.Errorf("FeeHistory user block count %d higher than %d", blocks, maxBlockCount)
 	}
 
+	if feeHistory, ok := b.feeHistoryFromState(blockEnd, blocks, rewardPercentiles); ok {
+		return feeHistory, nil
+	}
+
 	if blockEnd+1 < blocks {
 		blocks = blockEnd + 1
 	}
@@ -250,9 +265,83 @@ func (b *Backend) FeeHistory(
 	return &feeHistory, nil
 }
 
+// feeHistoryFromState returns the fee history of the given range of blocks from the on-chain
+// fee history. It returns false if the stored history doesn't cover the whole range.
+func (b *Backend) feeHistoryFromState(
+	blockEnd, blocks int64,
+	rewardPercentiles []float64,
+) (*rpctypes.FeeHistoryResult, bool) {
+	if blockEnd+1 < blocks {
+		blocks = blockEnd + 1
+	}
+	if blocks <= 0 {
+		return nil, false
+	}
+
+	// query one block after the newest one to get its next base fee
+	res, err := b.queryClient.FeeMarket.FeeHistory(b.ctx, &feemarkettypes.QueryFeeHistoryRequest{
+		BlockCount: uint64(blocks) + 1,
+		LastBlock:  blockEnd + 1,
+	})
+	if err != nil {
+		b.logger.Debug("failed to query the fee history", "error", err.Error())
+		return nil, false
+	}
+
+	blockStart := blockEnd + 1 - blocks
+	if int64(len(res.Entries)) < blocks {
+		return nil, false
+	}
+
+	rewardCount := len(rewardPercentiles)
+	reward := make([][]*hexutil.Big, blocks)
+	thisBaseFee := make([]*hexutil.Big, blocks+1)
+	thisGasUsedRatio := make([]float64, blocks)
+
+	for i := int64(0); i < blocks; i++ {
+		entry := res.Entries[i]
+		if entry.Height != blockStart+i {
+			return nil, false
+		}
+
+		thisBaseFee[i] = (*hexutil.Big)(entry.BaseFee.BigInt())
+		thisGasUsedRatio[i] = entry.GasUsedRatio()
+
+		reward[i] = make([]*hexutil.Big, rewardCount)
+		for j, r := range entry.RewardPercentiles(rewardPercentiles) {
+			reward[i][j] = (*hexutil.Big)(r)
+		}
+	}
+
+	if int64(len(res.Entries)) > blocks {
+		thisBaseFee[blocks] = (*hexutil.Big)(res.Entries[blocks].BaseFee.BigInt())
+	} else {
+		nextBaseFee := new(big.Int)
+		cfg := b.ChainConfig()
+		if cfg.IsLondon(big.NewInt(blockEnd + 1)) {
+			nextBaseFee = misc.CalcBaseFee(cfg, b.CurrentHeader())
+		}
+		thisBaseFee[blocks] = (*hexutil.Big)(nextBaseFee)
+	}
+
+	feeHistory := rpctypes.FeeHistoryResult{
+		OldestBlock:  (*hexutil.Big)(big.NewInt(blockStart)),
+		BaseFee:      thisBaseFee,
+		GasUsedRatio: thisGasUsedRatio,
+	}
+
+	// rewards should only be calculated if reward percentiles were included
+	if rewardCount != 0 {
+		feeHistory.Reward = reward
+	}
+
+	return &feeHistory, true
+}
+
 // SuggestGasTipCap returns the suggested tip cap
 // Although we don't support tx prioritization yet, but we return a positive value to help client to
-// mitigate the base fee changes.
+// mitigate the base fee changes. If the on-chain fee history shows that recent transactions paid
+// higher tips, the tip paid by those transactions is suggested instead.
 func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
 	if baseFee == nil {
 		// london hardfork not enabled or feemarket not enabled
@@ -278,5 +367,37 @@ func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
 		// impossible if the parameter validation passed.
 		maxDelta = 0
 	}
-	return big.NewInt(maxDelta), nil
+
+	tipCap := big.NewInt(maxDelta)
+	if historyTip := b.suggestGasTipFromHistory(); historyTip != nil && historyTip.Cmp(tipCap) > 0 {
+		tipCap = historyTip
+	}
+	return tipCap, nil
+}
+
+// suggestGasTipFromHistory returns the gas tip paid by the recent transactions, based on the
+// on-chain fee history. It returns nil if there's no recent transaction.
+func (b *Backend) suggestGasTipFromHistory() *big.Int {
+	res, err := b.queryClient.FeeMarket.FeeHistory(b.ctx, &feemarkettypes.QueryFeeHistoryRequest{
+		BlockCount: gasTipSampleBlocks,
+	})
+	if err != nil {
+		b.logger.Debug("failed to query the fee history", "error", err.Error())
+		return nil
+	}
+
+	var samples []*big.Int
+	for _, entry := range res.Entries {
+		if len(entry.Rewards) == 0 {
+			continue
+		}
+		samples = append(samples, entry.RewardPercentiles([]float64{gasTipPercentile})[0])
+	}
+
+	if len(samples) == 0 {
+		return nil
+	}
+
+	sort.Slice(samples, func(i, j int) bool { return samples[i].Cmp(samples[j]) < 0 })
+	return samples[(len(samples)-1)*gasTipPercentile/100]
 }
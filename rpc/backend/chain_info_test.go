@@ -266,6 +266,35 @@ func (suite *BackendTestSuite) TestSuggestGasTipCap() {
 			big.NewInt(0),
 			true,
 		},
+		{
+			"pass - Gets the max base fee delta without fee history",
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, 1)
+				RegisterFeeMarketFeeHistoryError(feeMarketClient, 1, &feemarkettypes.QueryFeeHistoryRequest{BlockCount: 20})
+			},
+			big.NewInt(8000),
+			big.NewInt(1000),
+			true,
+		},
+		{
+			"pass - Gets the gas tip paid by recent transactions from the fee history",
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, 1)
+				RegisterFeeMarketFeeHistory(
+					feeMarketClient, 1,
+					&feemarkettypes.QueryFeeHistoryRequest{BlockCount: 20},
+					[]feemarkettypes.FeeHistoryEntry{
+						{Height: 1, GasUsed: 100, Rewards: []feemarkettypes.TxReward{{Tip: sdk.NewInt(5000), GasUsed: 100}}},
+						{Height: 2},
+					},
+				)
+			},
+			big.NewInt(8000),
+			big.NewInt(5000),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -349,6 +378,7 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				suite.backend.cfg.JSONRPC.FeeHistoryCap = 0
 				RegisterParams(queryClient, &header, ethrpc.BlockNumber(1).Int64())
+				// the cap applies before the on-chain fee history is queried
 			},
 			1,
 			-1,
@@ -360,7 +390,9 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 			"fail - Tendermint block fetching error ",
 			func(validator sdk.AccAddress) {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistoryError(feeMarketClient, 1, &feemarkettypes.QueryFeeHistoryRequest{BlockCount: 2, LastBlock: 2})
 				RegisterBlockError(client, ethrpc.BlockNumber(1).Int64())
 			},
 			1,
@@ -373,7 +405,9 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 			"fail - Eth block fetching error",
 			func(validator sdk.AccAddress) {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistoryError(feeMarketClient, 1, &feemarkettypes.QueryFeeHistoryRequest{BlockCount: 2, LastBlock: 2})
 				RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
 				RegisterBlockResultsError(client, 1)
 			},
@@ -389,7 +423,9 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				// baseFee := sdk.NewInt(1)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistoryError(feeMarketClient, 1, &feemarkettypes.QueryFeeHistoryRequest{BlockCount: 2, LastBlock: 2})
 				RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
 				RegisterBlockResults(client, 1)
 				RegisterBaseFeeError(queryClient)
@@ -409,7 +445,9 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				baseFee := sdk.NewInt(1)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistoryError(feeMarketClient, 1, &feemarkettypes.QueryFeeHistoryRequest{BlockCount: 2, LastBlock: 2})
 				RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
 				RegisterBlockResults(client, 1)
 				RegisterBaseFee(queryClient, baseFee)
@@ -429,6 +467,44 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 			sdk.AccAddress(tests.GenerateAddress().Bytes()),
 			true,
 		},
+		{
+			"pass - FeeHistoryResults object from the on-chain fee history",
+			func(validator sdk.AccAddress) {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistory(
+					feeMarketClient, 1,
+					&feemarkettypes.QueryFeeHistoryRequest{BlockCount: 2, LastBlock: 2},
+					[]feemarkettypes.FeeHistoryEntry{
+						{
+							Height:   1,
+							BaseFee:  sdk.NewInt(10),
+							GasUsed:  100,
+							GasLimit: 200,
+							Rewards: []feemarkettypes.TxReward{
+								{Tip: sdk.NewInt(1), GasUsed: 50},
+								{Tip: sdk.NewInt(3), GasUsed: 50},
+							},
+						},
+						{
+							Height:   2,
+							BaseFee:  sdk.NewInt(9),
+							GasLimit: 200,
+						},
+					},
+				)
+			},
+			1,
+			1,
+			&rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(10)), (*hexutil.Big)(big.NewInt(9))},
+				GasUsedRatio: []float64{0.5},
+				Reward:       [][]*hexutil.Big{{(*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(3)), (*hexutil.Big)(big.NewInt(3))}},
+			},
+			nil,
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -152,7 +152,7 @@ func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.E
 // Estimate Gas
 func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.TransactionArgs) {
 	bz, _ := json.Marshal(args)
-	queryClient.On("EstimateGas", rpc.ContextWithHeight(1), &evmtypes.EthCallRequest{Args: bz, ChainId: args.ChainID.ToInt().Int64()}).
+	queryClient.On("EstimateGas", rpc.ContextWithHeight(0), &evmtypes.EthCallRequest{Args: bz, ChainId: args.ChainID.ToInt().Int64()}).
 		Return(&evmtypes.EstimateGasResponse{}, nil)
 }
 
@@ -19,3 +19,23 @@ func RegisterFeeMarketParamsError(feeMarketClient *mocks.FeeMarketQueryClient, h
 	feeMarketClient.On("Params", rpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
 		Return(nil, sdkerrors.ErrInvalidRequest)
 }
+
+// FeeHistory
+func RegisterFeeMarketFeeHistory(
+	feeMarketClient *mocks.FeeMarketQueryClient,
+	height int64,
+	req *feemarkettypes.QueryFeeHistoryRequest,
+	entries []feemarkettypes.FeeHistoryEntry,
+) {
+	feeMarketClient.On("FeeHistory", rpc.ContextWithHeight(height), req).
+		Return(&feemarkettypes.QueryFeeHistoryResponse{Entries: entries}, nil)
+}
+
+func RegisterFeeMarketFeeHistoryError(
+	feeMarketClient *mocks.FeeMarketQueryClient,
+	height int64,
+	req *feemarkettypes.QueryFeeHistoryRequest,
+) {
+	feeMarketClient.On("FeeHistory", rpc.ContextWithHeight(height), req).
+		Return(nil, sdkerrors.ErrInvalidRequest)
+}
@@ -16,6 +16,7 @@
 package backend
 
 import (
+	"crypto/ecdsa"
 	"fmt"
 	"math/big"
 	"time"
@@ -30,6 +31,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -43,23 +45,7 @@ import (
 
 // Accounts returns the list of accounts available to this node.
 func (b *Backend) Accounts() ([]common.Address, error) {
-	addresses := make([]common.Address, 0) // return [] instead of nil if empty
-
-	infos, err := b.clientCtx.Keyring.List()
-	if err != nil {
-		return addresses, err
-	}
-
-	for _, info := range infos {
-		pubKey, err := info.GetPubKey()
-		if err != nil {
-			return nil, err
-		}
-		addressBytes := pubKey.Address().Bytes()
-		addresses = append(addresses, common.BytesToAddress(addressBytes))
-	}
-
-	return addresses, nil
+	return b.signer.Accounts()
 }
 
 // Syncing returns false in case the node is currently not syncing with the network. It can be up to date or has not
@@ -202,6 +188,23 @@ func (b *Backend) ImportRawKey(privkey, password string) (common.Address, error)
 		return common.Address{}, err
 	}
 
+	return b.importPrivKey(priv, password)
+}
+
+// ImportKeystore decrypts a given Ethereum keystore JSON key (Web3 Secret Storage, scrypt or pbkdf2)
+// with the password and stores the private key into the key directory, like ImportRawKey.
+func (b *Backend) ImportKeystore(keyJSON, password string) (common.Address, error) {
+	key, err := keystore.DecryptKey([]byte(keyJSON), password)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return b.importPrivKey(key.PrivateKey, password)
+}
+
+// importPrivKey armors and encrypts the private key with the password and stores it into the key
+// directory, unless it has already been imported.
+func (b *Backend) importPrivKey(priv *ecdsa.PrivateKey, password string) (common.Address, error) {
 	privKey := &ethsecp256k1.PrivKey{Key: crypto.FromECDSA(priv)}
 
 	addr := sdk.AccAddress(privKey.PubKey().Address().Bytes())
@@ -229,22 +232,7 @@ func (b *Backend) ImportRawKey(privkey, password string) (common.Address, error)
 
 // ListAccounts will return a list of addresses for accounts this node manages.
 func (b *Backend) ListAccounts() ([]common.Address, error) {
-	addrs := []common.Address{}
-
-	list, err := b.clientCtx.Keyring.List()
-	if err != nil {
-		return nil, err
-	}
-
-	for _, info := range list {
-		pubKey, err := info.GetPubKey()
-		if err != nil {
-			return nil, err
-		}
-		addrs = append(addrs, common.BytesToAddress(pubKey.Address()))
-	}
-
-	return addrs, nil
+	return b.signer.Accounts()
 }
 
 // NewAccount will create a new account and returns the address for the new account.
@@ -315,7 +303,7 @@ func (b *Backend) RPCTxFeeCap() float64 {
 	return b.cfg.JSONRPC.TxFeeCap
 }
 
-// RPCFilterCap is the limit for total number of filters that can be created
+// RPCFilterCap is the limit for the number of filters that each client can create
 func (b *Backend) RPCFilterCap() int32 {
 	return b.cfg.JSONRPC.FilterCap
 }
@@ -6,11 +6,13 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 	"github.com/evmos/ethermint/rpc/backend/mocks"
 	ethermint "github.com/evmos/ethermint/types"
+	"github.com/google/uuid"
 	"github.com/spf13/viper"
 	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
 	"google.golang.org/grpc/metadata"
@@ -356,3 +358,60 @@ func (suite *BackendTestSuite) TestImportRawKey() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestImportKeystore() {
+	priv, _ := ethsecp256k1.GenerateKey()
+	key, _ := priv.ToECDSA()
+	pubAddr := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	keyJSON, _ := keystore.EncryptKey(
+		&keystore.Key{Id: uuid.New(), Address: pubAddr, PrivateKey: key},
+		"password", keystore.LightScryptN, keystore.LightScryptP,
+	)
+
+	testCases := []struct {
+		name     string
+		keyJSON  string
+		password string
+		expAddr  common.Address
+		expPass  bool
+	}{
+		{
+			"fail - not a keystore",
+			"{}",
+			"password",
+			common.Address{},
+			false,
+		},
+		{
+			"fail - wrong password",
+			string(keyJSON),
+			"wrong",
+			common.Address{},
+			false,
+		},
+		{
+			"pass - returning correct address",
+			string(keyJSON),
+			"password",
+			pubAddr,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+
+			output, err := suite.backend.ImportKeystore(tc.keyJSON, tc.password)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expAddr, output)
+
+				_, err = suite.backend.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(pubAddr.Bytes()))
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -19,55 +19,40 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 
+	"github.com/evmos/ethermint/rpc/signer"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
 // SendTransaction sends transaction based on received args using Node's key to sign it
 func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	_, err := b.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(args.GetFrom().Bytes()))
-	if err != nil {
-		b.logger.Error("failed to find key in keyring", "address", args.GetFrom(), "error", err.Error())
-		return common.Hash{}, fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
-	}
-
-	if args.ChainID != nil && (b.chainID).Cmp((*big.Int)(args.ChainID)) != 0 {
-		return common.Hash{}, fmt.Errorf("chainId does not match node's (have=%v, want=%v)", args.ChainID, (*hexutil.Big)(b.chainID))
-	}
+	return b.sendTransaction(args, b.signer)
+}
 
-	args, err = b.SetTxDefaults(args)
+// SendTransactionWithPassphrase sends the transaction signed with the key of the sender, which
+// the passphrase unlocks unless an external signer signs.
+func (b *Backend) SendTransactionWithPassphrase(args evmtypes.TransactionArgs, passphrase string) (common.Hash, error) {
+	txSigner, err := b.passphraseSigner(args.GetFrom(), passphrase)
 	if err != nil {
 		return common.Hash{}, err
 	}
+	return b.sendTransaction(args, txSigner)
+}
 
-	msg := args.ToTransaction()
-	if err := msg.ValidateBasic(); err != nil {
-		b.logger.Debug("tx failed basic validation", "error", err.Error())
-		return common.Hash{}, err
-	}
-
-	bn, err := b.BlockNumber()
+// sendTransaction fills the defaults of the transaction, signs it with the given signer and
+// broadcasts it.
+func (b *Backend) sendTransaction(args evmtypes.TransactionArgs, txSigner signer.Signer) (common.Hash, error) {
+	msg, err := b.signTransactionArgs(args, txSigner)
 	if err != nil {
-		b.logger.Debug("failed to fetch latest block number", "error", err.Error())
-		return common.Hash{}, err
-	}
-
-	signer := ethtypes.MakeSigner(b.ChainConfig(), new(big.Int).SetUint64(uint64(bn)))
-
-	// Sign transaction
-	if err := msg.Sign(signer, b.clientCtx.Keyring); err != nil {
-		b.logger.Debug("failed to sign tx", "error", err.Error())
 		return common.Hash{}, err
 	}
 
@@ -119,49 +104,153 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 	return txHash, nil
 }
 
-// Sign signs the provided data using the private key of address via Geth's signature standard.
-func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
-	from := sdk.AccAddress(address.Bytes())
+// SignTransaction fills the defaults of the transaction and signs it with the key of the sender,
+// without broadcasting it. It returns the RLP encoded signed transaction and the transaction.
+func (b *Backend) SignTransaction(args evmtypes.TransactionArgs) (*rpctypes.SignTransactionResult, error) {
+	return b.signTransaction(args, b.signer)
+}
 
-	_, err := b.clientCtx.Keyring.KeyByAddress(from)
+// SignTransactionWithPassphrase returns the transaction signed with the key of the sender, which
+// the passphrase unlocks unless an external signer signs.
+func (b *Backend) SignTransactionWithPassphrase(args evmtypes.TransactionArgs, passphrase string) (*rpctypes.SignTransactionResult, error) {
+	txSigner, err := b.passphraseSigner(args.GetFrom(), passphrase)
 	if err != nil {
-		b.logger.Error("failed to find key in keyring", "address", address.String())
-		return nil, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
+		return nil, err
 	}
+	return b.signTransaction(args, txSigner)
+}
 
-	// Sign the requested hash with the wallet
-	signature, _, err := b.clientCtx.Keyring.SignByAddress(from, data)
+// signTransaction fills the defaults of the transaction and signs it with the given signer.
+func (b *Backend) signTransaction(args evmtypes.TransactionArgs, txSigner signer.Signer) (*rpctypes.SignTransactionResult, error) {
+	msg, err := b.signTransactionArgs(args, txSigner)
 	if err != nil {
-		b.logger.Error("keyring.SignByAddress failed", "address", address.Hex())
 		return nil, err
 	}
 
-	signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
-	return signature, nil
+	tx := msg.AsTransaction()
+	data, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpctypes.SignTransactionResult{
+		Raw: data,
+		Tx:  tx,
+	}, nil
 }
 
-// SignTypedData signs EIP-712 conformant typed data
-func (b *Backend) SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
-	from := sdk.AccAddress(address.Bytes())
+// signTransactionArgs fills the defaults of the transaction arguments, and returns the transaction
+// signed by the sender with the given signer.
+func (b *Backend) signTransactionArgs(args evmtypes.TransactionArgs, txSigner signer.Signer) (*evmtypes.MsgEthereumTx, error) {
+	// Look up the wallet containing the requested signer
+	err := txSigner.CheckAccount(args.GetFrom())
+	if err != nil {
+		b.logger.Error("failed to check the account of the signer", "address", args.GetFrom(), "error", err.Error())
+		return nil, err
+	}
 
-	_, err := b.clientCtx.Keyring.KeyByAddress(from)
+	if args.ChainID != nil && (b.chainID).Cmp((*big.Int)(args.ChainID)) != 0 {
+		return nil, fmt.Errorf("chainId does not match node's (have=%v, want=%v)", args.ChainID, (*hexutil.Big)(b.chainID))
+	}
+
+	args, err = b.SetTxDefaults(args)
 	if err != nil {
-		b.logger.Error("failed to find key in keyring", "address", address.String())
-		return nil, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
+		return nil, err
 	}
 
-	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	msg := args.ToTransaction()
+	if err := msg.ValidateBasic(); err != nil {
+		b.logger.Debug("tx failed basic validation", "error", err.Error())
+		return nil, err
+	}
+
+	bn, err := b.BlockNumber()
 	if err != nil {
+		b.logger.Debug("failed to fetch latest block number", "error", err.Error())
 		return nil, err
 	}
 
-	// Sign the requested hash with the wallet
-	signature, _, err := b.clientCtx.Keyring.SignByAddress(from, sigHash)
+	ethSigner := ethtypes.MakeSigner(b.ChainConfig(), new(big.Int).SetUint64(uint64(bn)))
+
+	// Sign transaction
+	tx, err := txSigner.SignTx(common.HexToAddress(msg.From), msg.AsTransaction(), ethSigner)
+	if err == nil {
+		err = msg.FromEthereumTx(tx)
+	}
 	if err != nil {
-		b.logger.Error("keyring.SignByAddress failed", "address", address.Hex())
+		b.logger.Debug("failed to sign tx", "error", err.Error())
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// Sign signs the provided data using the private key of address via Geth's signature standard.
+func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	return b.sign(address, data, b.signer)
+}
+
+// SignWithPassphrase signs the data with the key of the address, which the passphrase unlocks
+// unless an external signer signs.
+func (b *Backend) SignWithPassphrase(address common.Address, data hexutil.Bytes, passphrase string) (hexutil.Bytes, error) {
+	txSigner, err := b.passphraseSigner(address, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return b.sign(address, data, txSigner)
+}
+
+// sign signs the data with the given signer.
+func (b *Backend) sign(address common.Address, data hexutil.Bytes, txSigner signer.Signer) (hexutil.Bytes, error) {
+	signature, err := txSigner.SignData(address, data)
+	if err != nil {
+		b.logger.Error("failed to sign data", "address", address.Hex(), "error", err.Error())
+		return nil, err
+	}
+
+	return signature, nil
+}
+
+// SignTypedData signs EIP-712 conformant typed data
+func (b *Backend) SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	signature, err := b.signer.SignTypedData(address, typedData)
+	if err != nil {
+		b.logger.Error("failed to sign typed data", "address", address.Hex(), "error", err.Error())
 		return nil, err
 	}
 
-	signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
 	return signature, nil
 }
+
+// UnlockAccount unlocks the account for the given duration, or indefinitely if the duration is
+// zero, if the passphrase is valid. The passphrase is only verified with the file keyring backend,
+// which encrypts the keys. The accounts of an external signer can't be unlocked by the node.
+func (b *Backend) UnlockAccount(address common.Address, passphrase string, duration time.Duration) (bool, error) {
+	if b.locker == nil {
+		return false, errors.New("the accounts of the external signer can't be unlocked by the node")
+	}
+
+	if err := b.locker.Unlock(address, passphrase, duration); err != nil {
+		b.logger.Error("failed account unlock attempt", "address", address.Hex(), "error", err.Error())
+		return false, err
+	}
+	return true, nil
+}
+
+// LockAccount locks the account, and returns false if it wasn't unlocked or if the accounts can't
+// be locked.
+func (b *Backend) LockAccount(address common.Address) bool {
+	if b.locker == nil {
+		return false
+	}
+	return b.locker.Lock(address)
+}
+
+// passphraseSigner returns the signer of the account unlocked by the passphrase, or the external
+// signer, which approves the signatures itself.
+func (b *Backend) passphraseSigner(address common.Address, passphrase string) (signer.Signer, error) {
+	if b.locker == nil {
+		return b.signer, nil
+	}
+	return b.locker.WithPassphrase(address, passphrase)
+}
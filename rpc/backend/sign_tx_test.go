@@ -1,17 +1,25 @@
 package backend
 
 import (
+	"errors"
 	"fmt"
+	"math/big"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	goethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/crypto/hd"
 	"github.com/evmos/ethermint/rpc/backend/mocks"
+	"github.com/evmos/ethermint/rpc/signer"
 	"github.com/evmos/ethermint/tests"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	"google.golang.org/grpc/metadata"
@@ -58,6 +66,7 @@ func (suite *BackendTestSuite) TestSendTransaction() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
 				suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				suite.backend.UnlockAccount(from, "", 0)
 				RegisterParams(queryClient, &header, 1)
 				RegisterBlockError(client, 1)
 			},
@@ -73,6 +82,7 @@ func (suite *BackendTestSuite) TestSendTransaction() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
 				suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				suite.backend.UnlockAccount(from, "", 0)
 				RegisterParams(queryClient, &header, 1)
 				RegisterBlock(client, 1, nil)
 				RegisterBlockResults(client, 1)
@@ -96,6 +106,7 @@ func (suite *BackendTestSuite) TestSendTransaction() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
 				suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				suite.backend.UnlockAccount(from, "", 0)
 				RegisterParams(queryClient, &header, 1)
 				RegisterBlock(client, 1, nil)
 				RegisterBlockResults(client, 1)
@@ -121,6 +132,7 @@ func (suite *BackendTestSuite) TestSendTransaction() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
 				suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				suite.backend.UnlockAccount(from, "", 0)
 				RegisterParams(queryClient, &header, 1)
 				RegisterBlock(client, 1, nil)
 				RegisterBlockResults(client, 1)
@@ -165,6 +177,93 @@ func (suite *BackendTestSuite) TestSendTransaction() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSignTransaction() {
+	gasPrice := new(hexutil.Big)
+	gas := hexutil.Uint64(21000)
+	toAddr := tests.GenerateAddress()
+	priv, _ := ethsecp256k1.GenerateKey()
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	nonce := hexutil.Uint64(1)
+	baseFee := sdk.NewInt(1)
+	callArgsDefault := evmtypes.TransactionArgs{
+		From:     &from,
+		To:       &toAddr,
+		GasPrice: gasPrice,
+		Gas:      &gas,
+		Nonce:    &nonce,
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		args         evmtypes.TransactionArgs
+		expPass      bool
+	}{
+		{
+			"fail - Can't find account in Keyring",
+			func() {},
+			callArgsDefault,
+			false,
+		},
+		{
+			"fail - chain id mismatch",
+			func() {
+				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+				suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				suite.backend.UnlockAccount(from, "", 0)
+			},
+			evmtypes.TransactionArgs{
+				From:    &from,
+				To:      &toAddr,
+				ChainID: (*hexutil.Big)(big.NewInt(1)),
+			},
+			false,
+		},
+		{
+			"pass - Return the signed transaction without broadcasting it",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+				suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				suite.backend.UnlockAccount(from, "", 0)
+				RegisterParams(queryClient, &header, 1)
+				RegisterBlock(client, 1, nil)
+				RegisterBlockResults(client, 1)
+				RegisterBaseFee(queryClient, baseFee)
+				RegisterParamsWithoutHeader(queryClient, 1)
+			},
+			callArgsDefault,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.SignTransaction(tc.args)
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				tx := new(ethtypes.Transaction)
+				suite.Require().NoError(tx.UnmarshalBinary(res.Raw))
+				suite.Require().Equal(res.Tx.Hash(), tx.Hash())
+				suite.Require().Equal(uint64(nonce), tx.Nonce())
+				suite.Require().Equal(toAddr, *tx.To())
+
+				sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+				suite.Require().NoError(err)
+				suite.Require().Equal(from, sender)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestSign() {
 	from, priv := tests.NewAddrKey()
 	testCases := []struct {
@@ -186,6 +285,7 @@ func (suite *BackendTestSuite) TestSign() {
 			func() {
 				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
 				suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				suite.backend.UnlockAccount(from, "", 0)
 			},
 			from,
 			nil,
@@ -232,6 +332,7 @@ func (suite *BackendTestSuite) TestSignTypedData() {
 			func() {
 				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
 				suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				suite.backend.UnlockAccount(from, "", 0)
 			},
 			from,
 			apitypes.TypedData{},
@@ -259,3 +360,107 @@ func (suite *BackendTestSuite) TestSignTypedData() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestAccountLocking() {
+	from, priv := tests.NewAddrKey()
+	armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+	suite.Require().NoError(suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, ""))
+
+	// the accounts are locked by default
+	_, err := suite.backend.Sign(from, hexutil.Bytes("hello"))
+	suite.Require().ErrorIs(err, keystore.ErrLocked)
+	_, err = suite.backend.SendTransaction(evmtypes.TransactionArgs{From: &from})
+	suite.Require().ErrorIs(err, keystore.ErrLocked)
+	suite.Require().False(suite.backend.LockAccount(from))
+
+	// the passphrase of the keyring without passphrase isn't verified
+	unlocked, err := suite.backend.UnlockAccount(from, "any", 0)
+	suite.Require().NoError(err)
+	suite.Require().True(unlocked)
+	_, err = suite.backend.UnlockAccount(tests.GenerateAddress(), "", 0)
+	suite.Require().Error(err)
+	_, err = suite.backend.Sign(from, hexutil.Bytes("hello"))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.backend.LockAccount(from))
+	_, err = suite.backend.Sign(from, hexutil.Bytes("hello"))
+	suite.Require().ErrorIs(err, keystore.ErrLocked)
+	_, err = suite.backend.SignWithPassphrase(from, hexutil.Bytes("hello"), "any")
+	suite.Require().NoError(err)
+
+	// the backends sharing the signer share the unlocked accounts
+	other := *suite.backend
+	other.SetSigner(suite.backend.Signer())
+	_, err = suite.backend.UnlockAccount(from, "", time.Minute)
+	suite.Require().NoError(err)
+	_, err = other.Sign(from, hexutil.Bytes("hello"))
+	suite.Require().NoError(err)
+
+	// the accounts of an external signer can't be unlocked by the node
+	other.SetSigner(signer.NewExternalSigner("http://127.0.0.1:0"))
+	unlocked, err = other.UnlockAccount(from, "", 0)
+	suite.Require().Error(err)
+	suite.Require().False(unlocked)
+	suite.Require().False(other.LockAccount(from))
+
+	verify := func(_ common.Address, passphrase string) error {
+		if passphrase != "password" {
+			return errors.New("could not decrypt key with given password")
+		}
+		return nil
+	}
+	suite.backend.SetSigner(signer.NewLockingSigner(signer.NewKeyringSigner(suite.backend.clientCtx.Keyring), verify))
+
+	data := hexutil.Bytes("hello")
+	_, err = suite.backend.Sign(from, data)
+	suite.Require().ErrorIs(err, keystore.ErrLocked)
+	_, err = suite.backend.SendTransaction(evmtypes.TransactionArgs{From: &from})
+	suite.Require().ErrorIs(err, keystore.ErrLocked)
+
+	// the passphrase signs without unlocking the account
+	_, err = suite.backend.SignWithPassphrase(from, data, "wrong")
+	suite.Require().Error(err)
+	_, err = suite.backend.SignWithPassphrase(from, data, "password")
+	suite.Require().NoError(err)
+	_, err = suite.backend.Sign(from, data)
+	suite.Require().ErrorIs(err, keystore.ErrLocked)
+
+	unlocked, err = suite.backend.UnlockAccount(from, "wrong", 0)
+	suite.Require().Error(err)
+	suite.Require().False(unlocked)
+	unlocked, err = suite.backend.UnlockAccount(from, "password", time.Minute)
+	suite.Require().NoError(err)
+	suite.Require().True(unlocked)
+	_, err = suite.backend.Sign(from, data)
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.backend.LockAccount(from))
+	_, err = suite.backend.Sign(from, data)
+	suite.Require().ErrorIs(err, keystore.ErrLocked)
+}
+
+func (suite *BackendTestSuite) TestPassphraseVerifier() {
+	newKeyring := func(backend string) keyring.Keyring {
+		kr, err := keyring.New(
+			sdk.KeyringServiceName(), backend, suite.T().TempDir(), strings.NewReader(""), suite.backend.clientCtx.Codec, hd.EthSecp256k1Option(),
+		)
+		suite.Require().NoError(err)
+		return kr
+	}
+
+	testCases := []struct {
+		name      string
+		keyring   keyring.Keyring
+		expVerify bool
+	}{
+		{"no keyring", nil, false},
+		{"test backend, keys not encrypted", newKeyring(keyring.BackendTest), false},
+		{"memory backend, keys not encrypted", newKeyring(keyring.BackendMemory), false},
+		{"file backend, keys encrypted with a passphrase", newKeyring(keyring.BackendFile), true},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			verify := passphraseVerifier(suite.backend.clientCtx.WithKeyring(tc.keyring))
+			suite.Require().Equal(tc.expVerify, verify != nil)
+		})
+	}
+}
@@ -16,6 +16,7 @@
 package backend
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
+	"github.com/evmos/ethermint/tracing"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	"github.com/pkg/errors"
 	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
@@ -30,7 +32,10 @@ import (
 
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
-func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
+func (b *Backend) TraceTransaction(ctx context.Context, hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
+	ctx, span := tracing.StartSpan(ctx, tracer, "Backend.TraceTransaction")
+	defer span.End()
+
 	// Get transaction by hash
 	transaction, err := b.GetTxByEthHash(hash)
 	if err != nil {
@@ -113,7 +118,7 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 		// 0 is a special value in `ContextWithHeight`
 		contextHeight = 1
 	}
-	traceResult, err := b.queryClient.TraceTx(rpctypes.ContextWithHeight(contextHeight), &traceTxRequest)
+	traceResult, err := b.queryClient.TraceTx(rpctypes.ContextWithHeightFrom(ctx, contextHeight), &traceTxRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -132,10 +137,15 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 // TraceBlock configures a new tracer according to the provided configuration, and
 // executes all the transactions contained within. The return value will be one item
 // per transaction, dependent on the requested tracer.
-func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
+func (b *Backend) TraceBlock(
+	ctx context.Context,
+	height rpctypes.BlockNumber,
 	config *evmtypes.TraceConfig,
 	block *tmrpctypes.ResultBlock,
 ) ([]*evmtypes.TxTraceResult, error) {
+	ctx, span := tracing.StartSpan(ctx, tracer, "Backend.TraceBlock")
+	defer span.End()
+
 	txs := block.Block.Txs
 	txsLength := len(txs)
 
@@ -178,7 +188,7 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		// 0 is a special value for `ContextWithHeight`.
 		contextHeight = 1
 	}
-	ctxWithHeight := rpctypes.ContextWithHeight(int64(contextHeight))
+	ctxWithHeight := rpctypes.ContextWithHeightFrom(ctx, int64(contextHeight))
 
 	traceBlockRequest := &evmtypes.QueryTraceBlockRequest{
 		Txs:             txsMessages,
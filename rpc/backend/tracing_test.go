@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/crypto"
@@ -182,7 +183,7 @@ func (suite *BackendTestSuite) TestTraceTransaction() {
 
 			err := suite.backend.indexer.IndexBlock(tc.block, tc.responseBlock)
 			suite.Require().NoError(err)
-			txResult, err := suite.backend.TraceTransaction(txHash, nil)
+			txResult, err := suite.backend.TraceTransaction(context.Background(), txHash, nil)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
@@ -239,7 +240,7 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			traceResults, err := suite.backend.TraceBlock(1, tc.config, tc.resBlock)
+			traceResults, err := suite.backend.TraceBlock(context.Background(), 1, tc.config, tc.resBlock)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
@@ -84,7 +84,7 @@ func (b *Backend) GetTransactionByHash(txHash common.Hash) (*rpctypes.RPCTransac
 		b.logger.Error("failed to fetch Base Fee from prunned block. Check node prunning configuration", "height", blockRes.Height, "error", err)
 	}
 
-	return rpctypes.NewTransactionFromMsg(
+	return b.newTransactionFromMsg(
 		msg,
 		common.BytesToHash(block.BlockID.Hash.Bytes()),
 		uint64(res.Height),
@@ -113,7 +113,7 @@ func (b *Backend) getTransactionByHashPending(txHash common.Hash) (*rpctypes.RPC
 
 		if msg.Hash == hexTx {
 			// use zero block values since it's not included in a block yet
-			rpctx, err := rpctypes.NewTransactionFromMsg(
+			rpctx, err := b.newTransactionFromMsg(
 				msg,
 				common.Hash{},
 				uint64(0),
@@ -148,6 +148,11 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	hexTx := hash.Hex()
 	b.logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
 
+	// the receipts of the mined transactions are immutable
+	if cached, ok := b.cache.get(cacheKindReceipt, hexTx); ok {
+		return cached.(map[string]interface{}), nil
+	}
+
 	res, err := b.GetTxByEthHash(hash)
 	if err != nil {
 		b.logger.Debug("tx not found", "hash", hexTx, "error", err.Error())
@@ -193,7 +198,7 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		return nil, err
 	}
 
-	from, err := ethMsg.GetSender(chainID.ToInt())
+	from, err := b.sender(ethMsg, chainID.ToInt())
 	if err != nil {
 		return nil, err
 	}
@@ -256,13 +261,15 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
 		baseFee, err := b.BaseFee(blockRes)
 		if err != nil {
-			// tolerate the error for pruned node.
+			// tolerate the error for pruned node, without caching the incomplete receipt.
 			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
-		} else {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
+			return receipt, nil
 		}
+		receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
 	}
 
+	b.cache.add(cacheKindReceipt, hexTx, receipt)
+
 	return receipt, nil
 }
 
@@ -408,7 +415,7 @@ func (b *Backend) GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, i
 		b.logger.Error("failed to fetch Base Fee from prunned block. Check node prunning configuration", "height", block.Block.Height, "error", err)
 	}
 
-	return rpctypes.NewTransactionFromMsg(
+	return b.newTransactionFromMsg(
 		msg,
 		common.BytesToHash(block.Block.Hash()),
 		uint64(block.Block.Height),
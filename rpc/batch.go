@@ -0,0 +1,140 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/evmos/ethermint/server/config"
+)
+
+// go-ethereum compatible batch errors
+const (
+	errCodeInvalidRequest   = -32600
+	errCodeResponseTooLarge = -32003
+
+	errMsgBatchTooLarge    = "batch too large"
+	errMsgResponseTooLarge = "response too large"
+)
+
+// BatchLimits bounds the number of calls of the batch requests and the size of their responses.
+type BatchLimits struct {
+	// RequestLimit is the maximum number of calls in a batch (0 = unlimited)
+	RequestLimit int
+	// ResponseMaxSize is the maximum size in bytes of a batch response (0 = unlimited)
+	ResponseMaxSize int
+}
+
+// NewBatchLimits returns the batch limits of the JSON-RPC configuration.
+func NewBatchLimits(cfg config.JSONRPCConfig) BatchLimits {
+	return BatchLimits{
+		RequestLimit:    cfg.BatchRequestLimit,
+		ResponseMaxSize: cfg.BatchResponseMaxSize,
+	}
+}
+
+// tooLarge returns the error response of a batch that exceeds the request limit.
+func (b BatchLimits) tooLarge(size int) []byte {
+	if b.RequestLimit == 0 || size <= b.RequestLimit {
+		return nil
+	}
+
+	return newErrorResponse(nil, &jsonrpcError{Code: errCodeInvalidRequest, Message: errMsgBatchTooLarge})
+}
+
+// Handler wraps the JSON-RPC HTTP handler with the batch limits. The calls of a batch are split
+// and served one by one, as go-ethereum does, and the calls remaining once the response size
+// exceeds the limit are answered with an error instead of being served.
+func (b BatchLimits) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(body) > maxRequestContentLength {
+			http.Error(w, "content length too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		var elems []json.RawMessage
+		if !isBatch(body) || json.Unmarshal(body, &elems) != nil || len(elems) == 0 {
+			// not a batch, or an invalid one which is answered by the server
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if res := b.tooLarge(len(elems)); res != nil {
+			_, _ = w.Write(res)
+			return
+		}
+
+		responses := make([]json.RawMessage, 0, len(elems))
+		size := 0
+		for _, elem := range elems {
+			if b.ResponseMaxSize > 0 && size > b.ResponseMaxSize {
+				var req jsonrpcRequest
+				if json.Unmarshal(elem, &req) == nil && len(req.ID) == 0 {
+					// no response to notifications
+					continue
+				}
+				res := newErrorResponse(req.ID, &jsonrpcError{Code: errCodeResponseTooLarge, Message: errMsgResponseTooLarge})
+				responses = append(responses, res)
+				continue
+			}
+
+			sub := r.Clone(r.Context())
+			sub.Body = io.NopCloser(bytes.NewReader(elem))
+			sub.ContentLength = int64(len(elem))
+			rec := newBufferedResponseWriter()
+			next.ServeHTTP(rec, sub)
+
+			if rec.status != http.StatusOK {
+				// the request itself is rejected (e.g. unauthenticated), forward the response
+				for key, values := range rec.header {
+					w.Header()[key] = values
+				}
+				w.WriteHeader(rec.status)
+				_, _ = w.Write(rec.body.Bytes())
+				return
+			}
+
+			res := bytes.TrimSpace(rec.body.Bytes())
+			if len(res) == 0 {
+				// notification
+				continue
+			}
+			responses = append(responses, res)
+			size += len(res)
+		}
+
+		if len(responses) == 0 {
+			return
+		}
+
+		res, err := json.Marshal(responses)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write(res)
+	})
+}
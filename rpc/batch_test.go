@@ -0,0 +1,78 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newBatch(methods ...string) string {
+	elems := make([]string, len(methods))
+	for i, method := range methods {
+		elems[i] = fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":"%s"}`, i+1, method)
+	}
+	return "[" + strings.Join(elems, ",") + "]"
+}
+
+func TestBatchLimitsRequestLimit(t *testing.T) {
+	handler := BatchLimits{RequestLimit: 2}.Handler(echoHandler)
+
+	_, body := serve(handler, "1.2.3.4:1234", newBatch("eth_chainId", "eth_blockNumber"))
+	var res []map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(body), &res))
+	require.Len(t, res, 2)
+	require.Equal(t, "eth_chainId", res[0]["result"])
+	require.Equal(t, "eth_blockNumber", res[1]["result"])
+
+	_, body = serve(handler, "1.2.3.4:1234", newBatch("eth_chainId", "eth_blockNumber", "eth_gasPrice"))
+	var errRes map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(body), &errRes))
+	require.Nil(t, errRes["id"])
+	require.Equal(t, float64(errCodeInvalidRequest), errRes["error"].(map[string]interface{})["code"])
+	require.Equal(t, errMsgBatchTooLarge, errRes["error"].(map[string]interface{})["message"])
+
+	// single requests aren't limited
+	_, body = serve(handler, "1.2.3.4:1234", `{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`)
+	require.Contains(t, body, `"result":"eth_chainId"`)
+}
+
+func TestBatchLimitsResponseMaxSize(t *testing.T) {
+	single, err := json.Marshal(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": "eth_chainId"})
+	require.NoError(t, err)
+
+	// the second response exceeds the limit, the remaining calls are not served
+	handler := BatchLimits{ResponseMaxSize: len(single) + 1}.Handler(echoHandler)
+
+	batch := `[
+		{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},
+		{"jsonrpc":"2.0","id":2,"method":"eth_chainId"},
+		{"jsonrpc":"2.0","method":"eth_chainId"},
+		{"jsonrpc":"2.0","id":3,"method":"eth_chainId"}
+	]`
+	code, body := serve(handler, "1.2.3.4:1234", batch)
+	require.Equal(t, http.StatusOK, code)
+
+	var res []map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(body), &res))
+	require.Len(t, res, 3)
+	require.Equal(t, "eth_chainId", res[0]["result"])
+	require.Equal(t, "eth_chainId", res[1]["result"])
+	require.Equal(t, float64(3), res[2]["id"])
+	require.Equal(t, float64(errCodeResponseTooLarge), res[2]["error"].(map[string]interface{})["code"])
+	require.Equal(t, errMsgResponseTooLarge, res[2]["error"].(map[string]interface{})["message"])
+}
+
+func TestBatchLimitsRejectedRequest(t *testing.T) {
+	forbidden := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "missing token", http.StatusForbidden)
+	})
+	handler := BatchLimits{RequestLimit: 10}.Handler(forbidden)
+
+	code, body := serve(handler, "1.2.3.4:1234", newBatch("eth_chainId", "eth_blockNumber"))
+	require.Equal(t, http.StatusForbidden, code)
+	require.Contains(t, body, "missing token")
+}
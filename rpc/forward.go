@@ -0,0 +1,73 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"net"
+	"net/http"
+)
+
+const (
+	// forwardTokenHeader carries the token of the requests forwarded by the WebSocket server.
+	forwardTokenHeader = "X-Ethermint-Forward-Token"
+	// forwardClientIPHeader carries the IP of the WebSocket client of the forwarded requests.
+	forwardClientIPHeader = "X-Ethermint-Client-IP"
+)
+
+// ClientForwarder identifies the WebSocket clients of the requests that the WebSocket server
+// forwards to the JSON-RPC server, so that they're served as the requests of the client instead of
+// the local connection, e.g. for the filter cap of each client.
+type ClientForwarder struct {
+	token string
+}
+
+// NewClientForwarder creates a forwarder with a random token, which authenticates the forwarded
+// client IPs.
+func NewClientForwarder() (*ClientForwarder, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+
+	return &ClientForwarder{token: hex.EncodeToString(token)}, nil
+}
+
+// Handler wraps the JSON-RPC HTTP handler, setting the remote address of the forwarded requests to
+// the address of their WebSocket client. The forwarding headers of the other requests are removed,
+// as they can be set by the client.
+func (f *ClientForwarder) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get(forwardTokenHeader)
+		clientIP := r.Header.Get(forwardClientIPHeader)
+		r.Header.Del(forwardTokenHeader)
+		r.Header.Del(forwardClientIPHeader)
+
+		if token != "" && clientIP != "" && subtle.ConstantTimeCompare([]byte(token), []byte(f.token)) == 1 {
+			r.RemoteAddr = net.JoinHostPort(clientIP, "0")
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// forwardHeader sets the header of the requests forwarded by the WebSocket server for the client
+// with the given IP.
+func (f *ClientForwarder) forwardHeader(h http.Header, clientIP string) {
+	h.Set(forwardTokenHeader, f.token)
+	h.Set(forwardClientIPHeader, clientIP)
+}
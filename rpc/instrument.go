@@ -0,0 +1,141 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+
+	rpcmetrics "github.com/evmos/ethermint/rpc/metrics"
+	"github.com/evmos/ethermint/tracing"
+)
+
+const (
+	errCodeMethodNotFound = -32601
+	// maxErrorResponseSize is the size of the response prefix kept to decode the error code, the
+	// error responses are small while the larger responses are results.
+	maxErrorResponseSize = 4096
+)
+
+var tracer = otel.Tracer("github.com/evmos/ethermint/rpc")
+
+// InstrumentHandler wraps the JSON-RPC HTTP handler with the per-method metrics and trace spans of
+// the single requests. The batch requests must be split beforehand, which the BatchLimits handler
+// does. The methods out of the given namespaces, or that don't exist, are labelled as unknown to
+// bound the metrics cardinality. The spans are children of the trace context of the headers.
+func InstrumentHandler(namespaces []string, next http.Handler) http.Handler {
+	enabled := make(map[string]bool, len(namespaces))
+	for _, namespace := range namespaces {
+		enabled[namespace] = true
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		var req jsonrpcRequest
+		if isBatch(body) || json.Unmarshal(body, &req) != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		method, namespace := rpcmetrics.UnknownLabel, rpcmetrics.UnknownLabel
+		if ns, _, found := strings.Cut(req.Method, "_"); found && enabled[ns] {
+			method, namespace = req.Method, ns
+		}
+
+		inFlight := rpcmetrics.InFlight.WithLabelValues(namespace)
+		inFlight.Inc()
+		defer inFlight.Dec()
+
+		ctx, span := tracer.Start(tracing.ExtractHTTP(r), method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(semconv.RPCSystemKey.String("jsonrpc"), semconv.RPCMethodKey.String(method)),
+		)
+		defer span.End()
+
+		start := time.Now()
+		cw := &captureWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(cw, r.WithContext(ctx))
+
+		code := cw.errorCode()
+		if code == strconv.Itoa(errCodeMethodNotFound) {
+			method = rpcmetrics.UnknownLabel
+			span.SetName(method)
+		}
+		if code != "" {
+			span.SetStatus(codes.Error, code)
+		}
+		rpcmetrics.ObserveRequest(method, code, time.Since(start).Seconds())
+	})
+}
+
+// captureWriter keeps the status and the prefix of the response written.
+type captureWriter struct {
+	http.ResponseWriter
+	status int
+	head   []byte
+}
+
+// WriteHeader implements http.ResponseWriter
+func (w *captureWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Write implements http.ResponseWriter
+func (w *captureWriter) Write(b []byte) (int, error) {
+	if remaining := maxErrorResponseSize - len(w.head); remaining > 0 {
+		if len(b) < remaining {
+			remaining = len(b)
+		}
+		w.head = append(w.head, b[:remaining]...)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// errorCode returns the JSON-RPC error code of the response, the HTTP status if the request
+// failed, or an empty string if the call succeeded.
+func (w *captureWriter) errorCode() string {
+	if w.status != http.StatusOK {
+		return "http_" + strconv.Itoa(w.status)
+	}
+
+	var res struct {
+		Error *struct {
+			Code int `json:"code"`
+		} `json:"error"`
+	}
+	// the larger responses are truncated and fail to decode, which are results
+	if err := json.Unmarshal(w.head, &res); err != nil || res.Error == nil {
+		return ""
+	}
+	return strconv.Itoa(res.Error.Code)
+}
@@ -0,0 +1,69 @@
+package rpc
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	rpcmetrics "github.com/evmos/ethermint/rpc/metrics"
+)
+
+func TestInstrumentHandler(t *testing.T) {
+	failing := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"the method does not exist"}}`))
+	})
+
+	testCases := []struct {
+		name      string
+		handler   http.Handler
+		method    string
+		expMethod string
+		expCode   string
+	}{
+		{"success", echoHandler, "eth_chainId", "eth_chainId", ""},
+		{"namespace not enabled", echoHandler, "debug_traceTransaction", rpcmetrics.UnknownLabel, ""},
+		{"method not found", failing, "eth_foo", rpcmetrics.UnknownLabel, "-32601"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := InstrumentHandler([]string{"eth", "net"}, tc.handler)
+
+			requests := testutil.ToFloat64(rpcmetrics.Requests.WithLabelValues(tc.expMethod))
+			errs := testutil.ToFloat64(rpcmetrics.Errors.WithLabelValues(tc.expMethod, tc.expCode))
+
+			_, _ = serve(handler, "1.2.3.4:1234", `{"jsonrpc":"2.0","id":1,"method":"`+tc.method+`"}`)
+
+			require.Equal(t, requests+1, testutil.ToFloat64(rpcmetrics.Requests.WithLabelValues(tc.expMethod)))
+			if tc.expCode != "" {
+				require.Equal(t, errs+1, testutil.ToFloat64(rpcmetrics.Errors.WithLabelValues(tc.expMethod, tc.expCode)))
+			}
+		})
+	}
+}
+
+func TestCaptureWriterErrorCode(t *testing.T) {
+	testCases := []struct {
+		name    string
+		status  int
+		body    string
+		expCode string
+	}{
+		{"result", http.StatusOK, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`, ""},
+		{"error", http.StatusOK, `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"failed"}}`, "-32000"},
+		{"http error", http.StatusForbidden, "missing token", "http_403"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := &captureWriter{ResponseWriter: newBufferedResponseWriter(), status: http.StatusOK}
+			if tc.status != http.StatusOK {
+				w.WriteHeader(tc.status)
+			}
+			_, _ = w.Write([]byte(tc.body))
+			require.Equal(t, tc.expCode, w.errorCode())
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	// jwtSecretLength is the length in bytes of the JWT secret.
+	jwtSecretLength = 32
+	// jwtExpiryTimeout is the maximum allowed drift between the issued-at claim of a token and the
+	// local time.
+	jwtExpiryTimeout = 60 * time.Second
+)
+
+// ObtainJWTSecret loads the hex encoded JWT secret from the given file. A new random secret is
+// generated and stored if the file doesn't exist, following the go-ethereum authenticated RPC
+// scheme.
+func ObtainJWTSecret(path string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	switch {
+	case err == nil:
+		secret := common.FromHex(strings.TrimSpace(string(data)))
+		if len(secret) != jwtSecretLength {
+			return nil, fmt.Errorf("invalid JWT secret length in %s, expected %d bytes, got %d", path, jwtSecretLength, len(secret))
+		}
+		return secret, nil
+	case !os.IsNotExist(err):
+		return nil, err
+	}
+
+	secret := make([]byte, jwtSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(path, []byte(hexutil.Encode(secret)), 0o600); err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
+// NewJWTToken returns a HS256 JWT token issued now and signed with the given secret.
+func NewJWTToken(secret []byte) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		IssuedAt: jwt.NewNumericDate(time.Now()),
+	})
+	return token.SignedString(secret)
+}
+
+// authenticate verifies the bearer JWT token of the request. It returns false with no error if
+// the request doesn't carry any token.
+func authenticate(secret []byte, r *http.Request) (bool, error) {
+	auth := r.Header.Get("Authorization")
+	if auth == "" {
+		return false, nil
+	}
+
+	strToken := strings.TrimPrefix(auth, "Bearer ")
+	if strToken == auth || strToken == "" {
+		return false, errors.New("missing token")
+	}
+
+	var claims jwt.RegisteredClaims
+	// only HS256 is allowed and the claims validation is disabled, as the issued-at claim is
+	// checked below with an allowed drift
+	token, err := jwt.ParseWithClaims(strToken, &claims, func(*jwt.Token) (interface{}, error) {
+		return secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithoutClaimsValidation())
+
+	switch {
+	case err != nil:
+		return false, err
+	case !token.Valid:
+		return false, errors.New("invalid token")
+	case !claims.VerifyExpiresAt(time.Now(), false):
+		return false, errors.New("token is expired")
+	case claims.IssuedAt == nil:
+		return false, errors.New("missing issued-at")
+	case time.Since(claims.IssuedAt.Time) > jwtExpiryTimeout:
+		return false, errors.New("stale token")
+	case time.Until(claims.IssuedAt.Time) > jwtExpiryTimeout:
+		return false, errors.New("future token")
+	}
+
+	return true, nil
+}
+
+// jwtHandler serves the authenticated requests with the private handler and the requests
+// without token with the public one.
+type jwtHandler struct {
+	secret  []byte
+	public  http.Handler
+	private http.Handler
+}
+
+// NewJWTHandler creates a http.Handler with JWT authentication support. The requests that don't
+// carry a token are served by the public handler, or rejected if it is nil.
+func NewJWTHandler(secret []byte, public, private http.Handler) http.Handler {
+	return &jwtHandler{
+		secret:  secret,
+		public:  public,
+		private: private,
+	}
+}
+
+// ServeHTTP implements http.Handler
+func (h *jwtHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	authenticated, err := authenticate(h.secret, r)
+	switch {
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case authenticated:
+		h.private.ServeHTTP(w, r)
+	case h.public == nil:
+		http.Error(w, "missing token", http.StatusForbidden)
+	default:
+		h.public.ServeHTTP(w, r)
+	}
+}
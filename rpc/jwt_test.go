@@ -0,0 +1,88 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObtainJWTSecret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config", "jwt.hex")
+
+	secret, err := ObtainJWTSecret(path)
+	require.NoError(t, err)
+	require.Len(t, secret, jwtSecretLength)
+
+	// the generated secret is loaded on the next call
+	loaded, err := ObtainJWTSecret(path)
+	require.NoError(t, err)
+	require.Equal(t, secret, loaded)
+
+	require.NoError(t, os.WriteFile(path, []byte("0x1234"), 0o600))
+	_, err = ObtainJWTSecret(path)
+	require.Error(t, err)
+}
+
+func TestJWTHandler(t *testing.T) {
+	secret := make([]byte, jwtSecretLength)
+	secret[0] = 1
+	otherSecret := make([]byte, jwtSecretLength)
+
+	newHandler := func(name string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(name))
+		})
+	}
+
+	signToken := func(secret []byte, method jwt.SigningMethod, iat time.Time) string {
+		token, err := jwt.NewWithClaims(method, jwt.RegisteredClaims{
+			IssuedAt: jwt.NewNumericDate(iat),
+		}).SignedString(secret)
+		require.NoError(t, err)
+		return "Bearer " + token
+	}
+
+	validToken, err := NewJWTToken(secret)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		auth    string
+		public  http.Handler
+		expCode int
+		expBody string
+	}{
+		{"no token, public handler", "", newHandler("public"), http.StatusOK, "public"},
+		{"no token, no public handler", "", nil, http.StatusForbidden, ""},
+		{"valid token", "Bearer " + validToken, newHandler("public"), http.StatusOK, "private"},
+		{"missing bearer", validToken, newHandler("public"), http.StatusForbidden, ""},
+		{"wrong secret", signToken(otherSecret, jwt.SigningMethodHS256, time.Now()), newHandler("public"), http.StatusForbidden, ""},
+		{"wrong method", signToken(secret, jwt.SigningMethodHS512, time.Now()), newHandler("public"), http.StatusForbidden, ""},
+		{"stale token", signToken(secret, jwt.SigningMethodHS256, time.Now().Add(-2*jwtExpiryTimeout)), newHandler("public"), http.StatusForbidden, ""},
+		{"future token", signToken(secret, jwt.SigningMethodHS256, time.Now().Add(2*jwtExpiryTimeout)), newHandler("public"), http.StatusForbidden, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewJWTHandler(secret, tc.public, newHandler("private"))
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tc.auth != "" {
+				req.Header.Set("Authorization", tc.auth)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expCode, rec.Code)
+			if tc.expBody != "" {
+				require.Equal(t, tc.expBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,393 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/evmos/ethermint/server/config"
+)
+
+const (
+	// maxRequestContentLength is the maximum size of a JSON-RPC request body, same as go-ethereum.
+	maxRequestContentLength = 1024 * 1024 * 5
+	// limiterForwardHeader carries the token of the requests forwarded by the WebSocket server,
+	// which are already checked by the limiter.
+	limiterForwardHeader = "X-Ethermint-Limiter-Token"
+	// clientIdleTimeout is the time after which the rate limiters of an idle client are released.
+	clientIdleTimeout = 10 * time.Minute
+
+	errCodeMethodNotAllowed = errCodeMethodNotFound
+	errCodeLimitExceeded    = -32005
+)
+
+// jsonrpcRequest is the subset of the JSON-RPC request fields checked by the limiter.
+type jsonrpcRequest struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+}
+
+// jsonrpcErrorResponse is the JSON-RPC response of a rejected request.
+type jsonrpcErrorResponse struct {
+	Jsonrpc string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   jsonrpcError    `json:"error"`
+}
+
+type jsonrpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// clientLimiters holds the rate limiters of a client IP.
+type clientLimiters struct {
+	limiter  *rate.Limiter
+	methods  map[string]*rate.Limiter
+	lastSeen time.Time
+}
+
+// RequestLimiter rejects the JSON-RPC requests, including each element of the batch requests,
+// whose method isn't allowed or that exceed the token-bucket rate limits of the client IP.
+type RequestLimiter struct {
+	allowMethods map[string]bool
+	denyMethods  map[string]bool
+	rateLimit    rate.Limit
+	burst        int
+	methodLimits map[string]config.MethodRateLimit
+	// forwardToken identifies the requests forwarded by the WebSocket server
+	forwardToken string
+
+	mu        sync.Mutex
+	clients   map[string]*clientLimiters
+	lastSweep time.Time
+}
+
+// NewRequestLimiter creates a RequestLimiter from the JSON-RPC configuration. It returns nil if
+// no method list nor rate limit is configured.
+func NewRequestLimiter(cfg config.JSONRPCConfig) (*RequestLimiter, error) {
+	methodLimits, err := cfg.GetMethodRateLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cfg.AllowMethods) == 0 && len(cfg.DenyMethods) == 0 && cfg.RateLimit == 0 && len(methodLimits) == 0 {
+		return nil, nil
+	}
+
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+
+	burst := cfg.RateLimitBurst
+	if burst == 0 {
+		burst = int(math.Ceil(cfg.RateLimit))
+	}
+
+	l := &RequestLimiter{
+		allowMethods: make(map[string]bool, len(cfg.AllowMethods)),
+		denyMethods:  make(map[string]bool, len(cfg.DenyMethods)),
+		rateLimit:    rate.Limit(cfg.RateLimit),
+		burst:        burst,
+		methodLimits: methodLimits,
+		forwardToken: hex.EncodeToString(token),
+		clients:      make(map[string]*clientLimiters),
+		lastSweep:    time.Now(),
+	}
+
+	for _, method := range cfg.AllowMethods {
+		l.allowMethods[method] = true
+	}
+	for _, method := range cfg.DenyMethods {
+		l.denyMethods[method] = true
+	}
+
+	return l, nil
+}
+
+// check returns an error if the client cannot call the method.
+func (l *RequestLimiter) check(clientIP, method string) *jsonrpcError {
+	if l.denyMethods[method] || (len(l.allowMethods) > 0 && !l.allowMethods[method]) {
+		return &jsonrpcError{Code: errCodeMethodNotAllowed, Message: fmt.Sprintf("method %s is not allowed", method)}
+	}
+
+	if l.rateLimit == 0 && len(l.methodLimits) == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.sweep(now)
+
+	client, ok := l.clients[clientIP]
+	if !ok {
+		client = &clientLimiters{methods: make(map[string]*rate.Limiter)}
+		if l.rateLimit > 0 {
+			client.limiter = rate.NewLimiter(l.rateLimit, l.burst)
+		}
+		l.clients[clientIP] = client
+	}
+	client.lastSeen = now
+
+	if limit, ok := l.methodLimits[method]; ok {
+		limiter, ok := client.methods[method]
+		if !ok {
+			limiter = rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst)
+			client.methods[method] = limiter
+		}
+
+		if !limiter.AllowN(now, 1) {
+			return &jsonrpcError{Code: errCodeLimitExceeded, Message: fmt.Sprintf("rate limit exceeded for method %s", method)}
+		}
+	}
+
+	if client.limiter != nil && !client.limiter.AllowN(now, 1) {
+		return &jsonrpcError{Code: errCodeLimitExceeded, Message: "rate limit exceeded"}
+	}
+
+	return nil
+}
+
+// sweep releases the rate limiters of the idle clients, which are full again by then.
+// NOTE: the caller must hold the lock.
+func (l *RequestLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < clientIdleTimeout {
+		return
+	}
+
+	for ip, client := range l.clients {
+		if now.Sub(client.lastSeen) >= clientIdleTimeout {
+			delete(l.clients, ip)
+		}
+	}
+	l.lastSweep = now
+}
+
+// filter checks the methods of the single or batch request body. It returns the request body to
+// forward without the rejected batch elements, nil if all the requests are rejected, and the
+// error responses of the rejected requests. The requests that cannot be decoded are forwarded to
+// the server, which responds with the decoding error.
+func (l *RequestLimiter) filter(clientIP string, body []byte) (forward []byte, rejected []json.RawMessage, batch bool) {
+	if !isBatch(body) {
+		var req jsonrpcRequest
+		if err := json.Unmarshal(body, &req); err != nil {
+			return body, nil, false
+		}
+
+		if rpcErr := l.check(clientIP, req.Method); rpcErr != nil {
+			return nil, []json.RawMessage{newErrorResponse(req.ID, rpcErr)}, false
+		}
+		return body, nil, false
+	}
+
+	var elems []json.RawMessage
+	if err := json.Unmarshal(body, &elems); err != nil {
+		return body, nil, true
+	}
+
+	allowed := make([]json.RawMessage, 0, len(elems))
+	for _, elem := range elems {
+		var req jsonrpcRequest
+		if err := json.Unmarshal(elem, &req); err != nil {
+			allowed = append(allowed, elem)
+			continue
+		}
+
+		rpcErr := l.check(clientIP, req.Method)
+		switch {
+		case rpcErr == nil:
+			allowed = append(allowed, elem)
+		case len(req.ID) > 0:
+			rejected = append(rejected, newErrorResponse(req.ID, rpcErr))
+		}
+		// NOTE: no response is sent to the rejected notifications, which don't have an id
+	}
+
+	switch {
+	case len(allowed) == len(elems):
+		return body, nil, true
+	case len(allowed) == 0:
+		return nil, rejected, true
+	}
+
+	forward, err := json.Marshal(allowed)
+	if err != nil {
+		return body, nil, true
+	}
+	return forward, rejected, true
+}
+
+// Handler wraps the JSON-RPC HTTP handler with the limiter checks.
+func (l *RequestLimiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get(limiterForwardHeader)
+		if token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(l.forwardToken)) == 1 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(body) > maxRequestContentLength {
+			http.Error(w, "content length too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		forward, rejected, batch := l.filter(clientIPFromRequest(r), body)
+		if forward == nil {
+			writeRejected(w, rejected, batch)
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(forward))
+		r.ContentLength = int64(len(forward))
+		if len(rejected) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// serve the allowed batch elements and append the error responses of the rejected ones
+		rec := newBufferedResponseWriter()
+		next.ServeHTTP(rec, r)
+
+		for key, values := range rec.header {
+			w.Header()[key] = values
+		}
+		w.Header().Del("Content-Length")
+		w.WriteHeader(rec.status)
+		_, _ = w.Write(mergeBatchResponses(rec.body.Bytes(), rejected))
+	})
+}
+
+// forwardHeader sets the header of the requests forwarded by the WebSocket server, so that they
+// aren't checked twice.
+func (l *RequestLimiter) forwardHeader(h http.Header) {
+	h.Set(limiterForwardHeader, l.forwardToken)
+}
+
+// newErrorResponse returns the encoded JSON-RPC error response of a rejected request.
+func newErrorResponse(id json.RawMessage, rpcErr *jsonrpcError) json.RawMessage {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+
+	res, err := json.Marshal(jsonrpcErrorResponse{Jsonrpc: "2.0", ID: id, Error: *rpcErr})
+	if err != nil {
+		// unreachable, the id is valid JSON
+		panic(err)
+	}
+	return res
+}
+
+// rejectedResponse returns the response to the request whose elements are all rejected, nil if
+// none requires a response.
+func rejectedResponse(rejected []json.RawMessage, batch bool) []byte {
+	switch {
+	case len(rejected) == 0:
+		return nil
+	case !batch:
+		return rejected[0]
+	default:
+		return mergeBatchResponses(nil, rejected)
+	}
+}
+
+// writeRejected writes the error responses of the rejected requests.
+func writeRejected(w http.ResponseWriter, rejected []json.RawMessage, batch bool) {
+	res := rejectedResponse(rejected, batch)
+	if res == nil {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(res)
+}
+
+// mergeBatchResponses appends the error responses of the rejected requests to the batch
+// response of the server.
+func mergeBatchResponses(responses []byte, rejected []json.RawMessage) []byte {
+	var elems []json.RawMessage
+	if len(bytes.TrimSpace(responses)) > 0 {
+		if err := json.Unmarshal(responses, &elems); err != nil {
+			// not a batch response, return it unchanged
+			return responses
+		}
+	}
+
+	merged, err := json.Marshal(append(elems, rejected...))
+	if err != nil {
+		return responses
+	}
+	return merged
+}
+
+// clientIPFromRequest returns the IP of the client connected to the server. The forwarding
+// headers aren't trusted as they can be set by the client.
+func clientIPFromRequest(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// bufferedResponseWriter records the response of a handler.
+type bufferedResponseWriter struct {
+	header http.Header
+	status int
+	body   *bytes.Buffer
+}
+
+func newBufferedResponseWriter() *bufferedResponseWriter {
+	return &bufferedResponseWriter{
+		header: make(http.Header),
+		status: http.StatusOK,
+		body:   new(bytes.Buffer),
+	}
+}
+
+// Header implements http.ResponseWriter
+func (w *bufferedResponseWriter) Header() http.Header {
+	return w.header
+}
+
+// Write implements http.ResponseWriter
+func (w *bufferedResponseWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+// WriteHeader implements http.ResponseWriter
+func (w *bufferedResponseWriter) WriteHeader(status int) {
+	w.status = status
+}
@@ -0,0 +1,209 @@
+package rpc
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/server/config"
+)
+
+// echoHandler responds to each request with its method as result.
+var echoHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	respond := func(raw json.RawMessage) map[string]interface{} {
+		var req map[string]interface{}
+		_ = json.Unmarshal(raw, &req)
+		return map[string]interface{}{"jsonrpc": "2.0", "id": req["id"], "result": req["method"]}
+	}
+
+	var res interface{}
+	if isBatch(body) {
+		var elems []json.RawMessage
+		_ = json.Unmarshal(body, &elems)
+		results := make([]interface{}, 0, len(elems))
+		for _, elem := range elems {
+			results = append(results, respond(elem))
+		}
+		res = results
+	} else {
+		res = respond(body)
+	}
+
+	_ = json.NewEncoder(w).Encode(res)
+})
+
+func newLimiter(t *testing.T, malleate func(cfg *config.JSONRPCConfig)) *RequestLimiter {
+	cfg := config.DefaultJSONRPCConfig()
+	malleate(cfg)
+	require.NoError(t, cfg.Validate())
+
+	limiter, err := NewRequestLimiter(*cfg)
+	require.NoError(t, err)
+	return limiter
+}
+
+func serve(handler http.Handler, remoteAddr, body string) (int, string) {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.RemoteAddr = remoteAddr
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec.Code, rec.Body.String()
+}
+
+func TestNewRequestLimiterDisabled(t *testing.T) {
+	limiter, err := NewRequestLimiter(*config.DefaultJSONRPCConfig())
+	require.NoError(t, err)
+	require.Nil(t, limiter)
+}
+
+func TestRequestLimiterMethods(t *testing.T) {
+	testCases := []struct {
+		name       string
+		malleate   func(cfg *config.JSONRPCConfig)
+		method     string
+		expAllowed bool
+	}{
+		{
+			"denied method",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.DenyMethods = []string{"eth_sendTransaction"}
+			},
+			"eth_sendTransaction",
+			false,
+		},
+		{
+			"method not denied",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.DenyMethods = []string{"eth_sendTransaction"}
+			},
+			"eth_blockNumber",
+			true,
+		},
+		{
+			"allowed method",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.AllowMethods = []string{"eth_blockNumber"}
+			},
+			"eth_blockNumber",
+			true,
+		},
+		{
+			"method not allowed",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.AllowMethods = []string{"eth_blockNumber"}
+			},
+			"eth_chainId",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := newLimiter(t, tc.malleate).Handler(echoHandler)
+
+			code, body := serve(handler, "1.2.3.4:1234", `{"jsonrpc":"2.0","id":1,"method":"`+tc.method+`"}`)
+			require.Equal(t, http.StatusOK, code)
+
+			var res map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(body), &res))
+			require.Equal(t, float64(1), res["id"])
+			if tc.expAllowed {
+				require.Equal(t, tc.method, res["result"])
+			} else {
+				require.Equal(t, float64(errCodeMethodNotAllowed), res["error"].(map[string]interface{})["code"])
+			}
+		})
+	}
+}
+
+func TestRequestLimiterRateLimit(t *testing.T) {
+	handler := newLimiter(t, func(cfg *config.JSONRPCConfig) {
+		cfg.RateLimit = 0.001
+		cfg.RateLimitBurst = 3
+		cfg.MethodRateLimits = []string{"debug_traceBlockByNumber:0.001:1"}
+	}).Handler(echoHandler)
+
+	request := func(method string) string {
+		return `{"jsonrpc":"2.0","id":1,"method":"` + method + `"}`
+	}
+
+	// the method limit is exceeded on the second call
+	_, body := serve(handler, "1.2.3.4:1234", request("debug_traceBlockByNumber"))
+	require.Contains(t, body, `"result":"debug_traceBlockByNumber"`)
+	_, body = serve(handler, "1.2.3.4:1234", request("debug_traceBlockByNumber"))
+	require.Contains(t, body, "rate limit exceeded for method debug_traceBlockByNumber")
+
+	// the client limit is exceeded after three allowed calls, other clients aren't limited
+	for i := 0; i < 2; i++ {
+		_, body = serve(handler, "1.2.3.4:1234", request("eth_blockNumber"))
+		require.Contains(t, body, `"result":"eth_blockNumber"`)
+	}
+	_, body = serve(handler, "1.2.3.4:1234", request("eth_blockNumber"))
+	require.Contains(t, body, `"error"`)
+	_, body = serve(handler, "5.6.7.8:1234", request("eth_blockNumber"))
+	require.Contains(t, body, `"result":"eth_blockNumber"`)
+}
+
+func TestRequestLimiterBatch(t *testing.T) {
+	handler := newLimiter(t, func(cfg *config.JSONRPCConfig) {
+		cfg.DenyMethods = []string{"eth_sendTransaction"}
+		cfg.RateLimit = 0.001
+		cfg.RateLimitBurst = 2
+	}).Handler(echoHandler)
+
+	batch := `[
+		{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},
+		{"jsonrpc":"2.0","id":2,"method":"eth_sendTransaction"},
+		{"jsonrpc":"2.0","method":"eth_sendTransaction"},
+		{"jsonrpc":"2.0","id":3,"method":"eth_chainId"},
+		{"jsonrpc":"2.0","id":4,"method":"eth_gasPrice"}
+	]`
+
+	code, body := serve(handler, "1.2.3.4:1234", batch)
+	require.Equal(t, http.StatusOK, code)
+
+	var res []map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(body), &res))
+	require.Len(t, res, 4)
+
+	results := make(map[float64]map[string]interface{})
+	for _, r := range res {
+		results[r["id"].(float64)] = r
+	}
+	require.Equal(t, "eth_blockNumber", results[1]["result"])
+	require.Equal(t, float64(errCodeMethodNotAllowed), results[2]["error"].(map[string]interface{})["code"])
+	require.Equal(t, "eth_chainId", results[3]["result"])
+	require.Equal(t, float64(errCodeLimitExceeded), results[4]["error"].(map[string]interface{})["code"])
+
+	// all the elements are rejected
+	_, body = serve(handler, "1.2.3.4:1234", `[{"jsonrpc":"2.0","id":5,"method":"eth_sendTransaction"}]`)
+	require.NoError(t, json.Unmarshal([]byte(body), &res))
+	require.Len(t, res, 1)
+	require.Equal(t, float64(5), res[0]["id"])
+}
+
+func TestRequestLimiterForwarded(t *testing.T) {
+	limiter := newLimiter(t, func(cfg *config.JSONRPCConfig) {
+		cfg.DenyMethods = []string{"eth_sendTransaction"}
+	})
+	handler := limiter.Handler(echoHandler)
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"eth_sendTransaction"}`))
+	limiter.forwardHeader(req.Header)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Contains(t, rec.Body.String(), `"result":"eth_sendTransaction"`)
+
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"eth_sendTransaction"}`))
+	req.Header.Set(limiterForwardHeader, "invalid")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Contains(t, rec.Body.String(), "method eth_sendTransaction is not allowed")
+}
@@ -0,0 +1,199 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+
+// Package metrics defines the labelled Prometheus metrics of the JSON-RPC server, served along
+// with the go-ethereum metrics registry on the metrics server.
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/metrics/exp"
+	gethprometheus "github.com/ethereum/go-ethereum/metrics/prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	namespace = "ethermint"
+	subsystem = "jsonrpc"
+
+	// UnknownLabel is the label of the methods, namespaces and types that don't exist, which
+	// bounds the metrics cardinality.
+	UnknownLabel = "unknown"
+)
+
+var (
+	// Registry is the registry of the JSON-RPC server metrics.
+	Registry = prometheus.NewRegistry()
+
+	// Requests counts the JSON-RPC calls by method.
+	Requests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "requests_total",
+		Help:      "Number of JSON-RPC calls by method.",
+	}, []string{"method"})
+
+	// Errors counts the JSON-RPC calls that returned an error by method and error code.
+	Errors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "errors_total",
+		Help:      "Number of JSON-RPC calls that returned an error by method and error code.",
+	}, []string{"method", "code"})
+
+	// Duration observes the latency of the JSON-RPC calls by method.
+	Duration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "request_duration_seconds",
+		Help:      "Latency of the JSON-RPC calls by method.",
+		Buckets:   []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"method"})
+
+	// InFlight gauges the JSON-RPC calls being served by namespace.
+	InFlight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "requests_in_flight",
+		Help:      "Number of JSON-RPC calls being served by namespace.",
+	}, []string{"namespace"})
+
+	// WSSubscriptions gauges the active WebSocket subscriptions by type.
+	WSSubscriptions = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "ws_subscriptions",
+		Help:      "Number of active WebSocket subscriptions by type.",
+	}, []string{"type"})
+
+	// CacheHits counts the backend response cache hits by kind of response.
+	CacheHits = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "cache_hits_total",
+		Help:      "Number of backend response cache hits by kind of response.",
+	}, []string{"kind"})
+
+	// CacheMisses counts the backend response cache misses by kind of response.
+	CacheMisses = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "cache_misses_total",
+		Help:      "Number of backend response cache misses by kind of response.",
+	}, []string{"kind"})
+
+	filterCounts = &filterCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "filters"),
+			"Number of installed filters by type.",
+			[]string{"type"}, nil,
+		),
+	}
+)
+
+func init() {
+	Registry.MustRegister(Requests, Errors, Duration, InFlight, WSSubscriptions, CacheHits, CacheMisses, filterCounts)
+}
+
+// ObserveRequest records a served JSON-RPC call. The error code is empty if the call succeeded.
+func ObserveRequest(method, code string, seconds float64) {
+	Requests.WithLabelValues(method).Inc()
+	Duration.WithLabelValues(method).Observe(seconds)
+	if code != "" {
+		Errors.WithLabelValues(method, code).Inc()
+	}
+}
+
+// filterCollector collects the number of filters installed by type at scrape time.
+type filterCollector struct {
+	desc *prometheus.Desc
+
+	mu     sync.Mutex
+	counts func() map[string]int
+}
+
+// SetFilterCounter sets the function returning the number of installed filters by type.
+func SetFilterCounter(counts func() map[string]int) {
+	filterCounts.mu.Lock()
+	defer filterCounts.mu.Unlock()
+
+	filterCounts.counts = counts
+}
+
+// Describe implements prometheus.Collector
+func (c *filterCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect implements prometheus.Collector
+func (c *filterCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	counts := c.counts
+	c.mu.Unlock()
+
+	if counts == nil {
+		return
+	}
+
+	for typ, count := range counts() {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(count), typ)
+	}
+}
+
+// Handler returns the Prometheus handler serving the go-ethereum registry metrics followed by the
+// JSON-RPC server metrics.
+func Handler() http.Handler {
+	gethHandler := gethprometheus.Handler(gethmetrics.DefaultRegistry)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		families, err := Registry.Gather()
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to gather metrics: %s", err), http.StatusInternalServerError)
+			return
+		}
+
+		gethHandler.ServeHTTP(w, r)
+
+		enc := expfmt.NewEncoder(w, expfmt.FmtText)
+		for _, family := range families {
+			if err := enc.Encode(family); err != nil {
+				return
+			}
+		}
+	})
+}
+
+// StartServer starts the metrics server at the given address. It serves the same endpoints as the
+// go-ethereum metrics server, with the JSON-RPC server metrics added to the Prometheus one.
+func StartServer(address string, logger log.Logger) {
+	m := http.NewServeMux()
+	m.Handle("/debug/metrics", exp.ExpHandler(gethmetrics.DefaultRegistry))
+	m.Handle("/debug/metrics/prometheus", Handler())
+
+	logger.Info("Starting metrics server", "address", fmt.Sprintf("http://%s/debug/metrics", address))
+	go func() {
+		/* #nosec G114 -- http functions have no support for timeouts */
+		if err := http.ListenAndServe(address, m); err != nil {
+			logger.Error("failure in running metrics server", "error", err.Error())
+		}
+	}()
+}
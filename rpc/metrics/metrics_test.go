@@ -0,0 +1,29 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	ObserveRequest("eth_chainId", "", 0.01)
+	ObserveRequest("eth_call", "-32000", 0.5)
+	WSSubscriptions.WithLabelValues("newHeads").Inc()
+	SetFilterCounter(func() map[string]int {
+		return map[string]int{"logs": 2}
+	})
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/metrics/prometheus", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	require.Contains(t, body, `ethermint_jsonrpc_requests_total{method="eth_chainId"} 1`)
+	require.Contains(t, body, `ethermint_jsonrpc_errors_total{code="-32000",method="eth_call"} 1`)
+	require.Contains(t, body, `ethermint_jsonrpc_request_duration_seconds_bucket{method="eth_call",le="0.5"} 1`)
+	require.Contains(t, body, `ethermint_jsonrpc_ws_subscriptions{type="newHeads"} 1`)
+	require.Contains(t, body, `ethermint_jsonrpc_filters{type="logs"} 2`)
+}
@@ -0,0 +1,175 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+
+// Package dev implements the evm_ and anvil_ prefixed JSON-RPC methods of the dev node, compatible
+// with Hardhat and Anvil.
+package dev
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Node defines the controls of the dev node.
+type Node interface {
+	Mine(ctx context.Context) error
+	IncreaseTime(seconds uint64) int64
+	SetNextBlockTimestamp(timestamp uint64) error
+	Snapshot() uint64
+	Revert(ctx context.Context, id uint64) (bool, error)
+	SetBalance(ctx context.Context, addr common.Address, balance *big.Int) error
+	SetCode(ctx context.Context, addr common.Address, code []byte) error
+	SetStorageAt(ctx context.Context, addr common.Address, slot, value common.Hash) error
+	ImpersonateAccount(addr common.Address)
+	StopImpersonatingAccount(addr common.Address)
+}
+
+// Quantity is an unsigned integer given either as a JSON number or as a hex string, as accepted
+// by Hardhat and Anvil.
+type Quantity uint64
+
+// UnmarshalJSON implements json.Unmarshaler
+func (q *Quantity) UnmarshalJSON(input []byte) error {
+	if len(input) > 0 && input[0] == '"' {
+		var value hexutil.Uint64
+		if err := value.UnmarshalJSON(input); err != nil {
+			return err
+		}
+		*q = Quantity(value)
+		return nil
+	}
+
+	var value uint64
+	if err := json.Unmarshal(input, &value); err != nil {
+		return err
+	}
+	*q = Quantity(value)
+	return nil
+}
+
+// EVMAPI is the evm_ prefixed set of APIs of the dev node.
+type EVMAPI struct {
+	logger log.Logger
+	node   Node
+}
+
+// NewEVMAPI creates an instance of the evm API.
+func NewEVMAPI(logger log.Logger, node Node) *EVMAPI {
+	return &EVMAPI{
+		logger: logger.With("api", "evm"),
+		node:   node,
+	}
+}
+
+// Mine seals a new block, at the given time if any, and returns once it is committed.
+func (api *EVMAPI) Mine(ctx context.Context, timestamp *Quantity) (string, error) {
+	api.logger.Debug("evm_mine")
+	if timestamp != nil {
+		if err := api.node.SetNextBlockTimestamp(uint64(*timestamp)); err != nil {
+			return "", err
+		}
+	}
+	if err := api.node.Mine(ctx); err != nil {
+		return "", err
+	}
+	return "0x0", nil
+}
+
+// IncreaseTime moves the time of the next blocks forward by the given number of seconds, and
+// returns the total time offset in seconds.
+func (api *EVMAPI) IncreaseTime(seconds Quantity) int64 {
+	api.logger.Debug("evm_increaseTime", "seconds", uint64(seconds))
+	return api.node.IncreaseTime(uint64(seconds))
+}
+
+// SetNextBlockTimestamp sets the time of the next block, in seconds.
+func (api *EVMAPI) SetNextBlockTimestamp(timestamp Quantity) error {
+	api.logger.Debug("evm_setNextBlockTimestamp", "timestamp", uint64(timestamp))
+	return api.node.SetNextBlockTimestamp(uint64(timestamp))
+}
+
+// Snapshot records the current state and returns its ID.
+func (api *EVMAPI) Snapshot() hexutil.Uint64 {
+	api.logger.Debug("evm_snapshot")
+	return hexutil.Uint64(api.node.Snapshot())
+}
+
+// Revert restores the state of the snapshot in a new block, and returns false if the snapshot
+// doesn't exist. The snapshot and the later ones are discarded.
+func (api *EVMAPI) Revert(ctx context.Context, id Quantity) (bool, error) {
+	api.logger.Debug("evm_revert", "id", uint64(id))
+	return api.node.Revert(ctx, uint64(id))
+}
+
+// AnvilAPI is the anvil_ prefixed set of APIs of the dev node.
+type AnvilAPI struct {
+	logger log.Logger
+	node   Node
+}
+
+// NewAnvilAPI creates an instance of the anvil API.
+func NewAnvilAPI(logger log.Logger, node Node) *AnvilAPI {
+	return &AnvilAPI{
+		logger: logger.With("api", "anvil"),
+		node:   node,
+	}
+}
+
+// SetBalance sets the balance of the account in a new block.
+func (api *AnvilAPI) SetBalance(ctx context.Context, address common.Address, balance hexutil.Big) error {
+	api.logger.Debug("anvil_setBalance", "address", address.Hex(), "balance", balance.String())
+	if balance.ToInt().Sign() < 0 {
+		return errors.New("balance cannot be negative")
+	}
+	return api.node.SetBalance(ctx, address, balance.ToInt())
+}
+
+// SetCode sets the code of the account in a new block.
+func (api *AnvilAPI) SetCode(ctx context.Context, address common.Address, code hexutil.Bytes) error {
+	api.logger.Debug("anvil_setCode", "address", address.Hex())
+	return api.node.SetCode(ctx, address, code)
+}
+
+// SetStorageAt sets a storage slot of the account in a new block.
+func (api *AnvilAPI) SetStorageAt(ctx context.Context, address common.Address, slot hexutil.Big, value common.Hash) (bool, error) {
+	api.logger.Debug("anvil_setStorageAt", "address", address.Hex(), "slot", slot.String())
+	if slot.ToInt().Sign() < 0 || slot.ToInt().BitLen() > 256 {
+		return false, errors.New("invalid storage slot")
+	}
+	if err := api.node.SetStorageAt(ctx, address, common.BigToHash(slot.ToInt()), value); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ImpersonateAccount allows eth_sendTransaction to send the transactions of the account without
+// its key.
+func (api *AnvilAPI) ImpersonateAccount(address common.Address) {
+	api.logger.Debug("anvil_impersonateAccount", "address", address.Hex())
+	api.node.ImpersonateAccount(address)
+}
+
+// StopImpersonatingAccount requires the transactions of the account to be signed again.
+func (api *AnvilAPI) StopImpersonatingAccount(address common.Address) {
+	api.logger.Debug("anvil_stopImpersonatingAccount", "address", address.Hex())
+	api.node.StopImpersonatingAccount(address)
+}
@@ -68,6 +68,7 @@ type EthereumAPI interface {
 	// on-chain, and interact with smart contracts.
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
+	SignTransaction(args evmtypes.TransactionArgs) (*rpctypes.SignTransactionResult, error)
 	// eth_sendPrivateTransaction
 	// eth_cancel	PrivateTransaction
 
@@ -84,14 +85,19 @@ type EthereumAPI interface {
 	//
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
-	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, _ *rpctypes.StateOverride) (hexutil.Bytes, error)
+	Call(
+		ctx context.Context,
+		args evmtypes.TransactionArgs,
+		blockNrOrHash rpctypes.BlockNumberOrHash,
+		_ *rpctypes.StateOverride,
+	) (hexutil.Bytes, error)
 
 	// Chain Information
 	//
 	// Returns information on the Ethereum network and internal settings.
 	ProtocolVersion() hexutil.Uint
 	GasPrice() (*hexutil.Big, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
+	EstimateGas(ctx context.Context, args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
 	FeeHistory(blockCount rpc.DecimalOrHex, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 	MaxPriorityFeePerGas() (*hexutil.Big, error)
 	ChainId() (*hexutil.Big, error)
@@ -117,7 +123,6 @@ type EthereumAPI interface {
 	FillTransaction(args evmtypes.TransactionArgs) (*rpctypes.SignTransactionResult, error)
 	Resend(ctx context.Context, args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	GetPendingTransactions() ([]*rpctypes.RPCTransaction, error)
-	// eth_signTransaction (on Ethereum.org)
 	// eth_getCompilers (on Ethereum.org)
 	// eth_compileSolidity (on Ethereum.org)
 	// eth_compileLLL (on Ethereum.org)
@@ -236,6 +241,14 @@ func (e *PublicAPI) SendTransaction(args evmtypes.TransactionArgs) (common.Hash,
 	return e.backend.SendTransaction(args)
 }
 
+// SignTransaction fills the defaults of the transaction and signs it with the key of the sender,
+// without broadcasting it. It returns the RLP encoded signed transaction, to be submitted with
+// eth_sendRawTransaction, and the transaction.
+func (e *PublicAPI) SignTransaction(args evmtypes.TransactionArgs) (*rpctypes.SignTransactionResult, error) {
+	e.logger.Debug("eth_signTransaction", "args", args.String())
+	return e.backend.SignTransaction(args)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Account Information				                    ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -278,7 +291,9 @@ func (e *PublicAPI) GetProof(address common.Address,
 ///////////////////////////////////////////////////////////////////////////////
 
 // Call performs a raw contract call.
-func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
+func (e *PublicAPI) Call(
+	ctx context.Context,
+	args evmtypes.TransactionArgs,
 	blockNrOrHash rpctypes.BlockNumberOrHash,
 	_ *rpctypes.StateOverride,
 ) (hexutil.Bytes, error) {
@@ -288,7 +303,7 @@ func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
 	if err != nil {
 		return nil, err
 	}
-	data, err := e.backend.DoCall(args, blockNum)
+	data, err := e.backend.DoCall(ctx, args, blockNum)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -318,9 +333,13 @@ func (e *PublicAPI) GasPrice() (*hexutil.Big, error) {
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (e *PublicAPI) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+func (e *PublicAPI) EstimateGas(
+	ctx context.Context,
+	args evmtypes.TransactionArgs,
+	blockNrOptional *rpctypes.BlockNumber,
+) (hexutil.Uint64, error) {
 	e.logger.Debug("eth_estimateGas")
-	return e.backend.EstimateGas(args, blockNrOptional)
+	return e.backend.EstimateGas(ctx, args, blockNrOptional)
 }
 
 func (e *PublicAPI) FeeHistory(blockCount rpc.DecimalOrHex,
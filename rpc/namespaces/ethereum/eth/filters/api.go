@@ -17,7 +17,9 @@ package filters
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
 	"sync"
 	"time"
 
@@ -35,14 +37,15 @@ import (
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	rpcmetrics "github.com/evmos/ethermint/rpc/metrics"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
 // FilterAPI gathers
 type FilterAPI interface {
-	NewPendingTransactionFilter() rpc.ID
-	NewBlockFilter() rpc.ID
-	NewFilter(criteria filters.FilterCriteria) (rpc.ID, error)
+	NewPendingTransactionFilter(ctx context.Context) rpc.ID
+	NewBlockFilter(ctx context.Context) rpc.ID
+	NewFilter(ctx context.Context, criteria filters.FilterCriteria) (rpc.ID, error)
 	GetFilterChanges(id rpc.ID) (interface{}, error)
 	GetFilterLogs(ctx context.Context, id rpc.ID) ([]*ethtypes.Log, error)
 	UninstallFilter(id rpc.ID) bool
@@ -62,6 +65,7 @@ type Backend interface {
 
 	BloomStatus() (uint64, uint64)
 
+	// RPCFilterCap is the number of filters that each client can install
 	RPCFilterCap() int32
 	RPCLogsCap() int32
 	RPCBlockRangeCap() int32
@@ -74,6 +78,7 @@ var deadline = 5 * time.Minute
 // and associated subscription in the event system.
 type filter struct {
 	typ      filters.Type
+	owner    string      // client that installed the filter
 	deadline *time.Timer // filter is inactive when deadline triggers
 	hashes   []common.Hash
 	crit     filters.FilterCriteria
@@ -90,24 +95,94 @@ type PublicFilterAPI struct {
 	events    *EventSystem
 	filtersMu sync.Mutex
 	filters   map[rpc.ID]*filter
+	// clientFilters is the number of filters installed by each client
+	clientFilters map[string]int
 }
 
-// NewPublicAPI returns a new PublicFilterAPI instance.
+// NewPublicAPI returns a new PublicFilterAPI instance. The filters share the event system of the
+// Tendermint websocket client.
 func NewPublicAPI(logger log.Logger, clientCtx client.Context, tmWSClient *rpcclient.WSClient, backend Backend) *PublicFilterAPI {
 	logger = logger.With("api", "filter")
 	api := &PublicFilterAPI{
-		logger:    logger,
-		clientCtx: clientCtx,
-		backend:   backend,
-		filters:   make(map[rpc.ID]*filter),
-		events:    NewEventSystem(logger, tmWSClient),
+		logger:        logger,
+		clientCtx:     clientCtx,
+		backend:       backend,
+		filters:       make(map[rpc.ID]*filter),
+		clientFilters: make(map[string]int),
+		events:        SharedEventSystem(logger, tmWSClient),
 	}
 
 	go api.timeoutLoop()
+	rpcmetrics.SetFilterCounter(api.filterCounts)
 
 	return api
 }
 
+// filterCounts returns the number of installed filters by type.
+func (api *PublicFilterAPI) filterCounts() map[string]int {
+	api.filtersMu.Lock()
+	defer api.filtersMu.Unlock()
+
+	counts := make(map[string]int)
+	for _, f := range api.filters {
+		counts[filterTypeLabel(f.typ)]++
+	}
+	return counts
+}
+
+// FilterOwner returns the client calling the API in the given context, identified by its IP
+// address, so that the filter cap applies to each client. The requests forwarded by the WebSocket
+// server have the address of their WebSocket client.
+func FilterOwner(ctx context.Context) string {
+	addr := rpc.PeerInfoFromContext(ctx).RemoteAddr
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
+
+// installFilter installs the filter of its owner, unless the owner has reached the filter cap. The
+// caller must hold the filters lock.
+func (api *PublicFilterAPI) installFilter(id rpc.ID, f *filter) error {
+	if api.clientFilters[f.owner] >= int(api.backend.RPCFilterCap()) {
+		return errors.New("max limit reached")
+	}
+
+	api.filters[id] = f
+	api.clientFilters[f.owner]++
+	return nil
+}
+
+// removeFilter removes the filter, if it is still installed. The caller must hold the filters lock.
+func (api *PublicFilterAPI) removeFilter(id rpc.ID) (*filter, bool) {
+	f, found := api.filters[id]
+	if !found {
+		return nil, false
+	}
+
+	delete(api.filters, id)
+	api.clientFilters[f.owner]--
+	if api.clientFilters[f.owner] <= 0 {
+		delete(api.clientFilters, f.owner)
+	}
+	return f, true
+}
+
+// filterTypeLabel returns the metrics label of a filter type.
+func filterTypeLabel(typ filters.Type) string {
+	switch typ {
+	case filters.LogsSubscription:
+		return "logs"
+	case filters.PendingTransactionsSubscription:
+		return "pending_transactions"
+	case filters.BlocksSubscription:
+		return "blocks"
+	default:
+		return rpcmetrics.UnknownLabel
+	}
+}
+
 // timeoutLoop runs every 5 minutes and deletes filters that have not been recently used.
 // Tt is started when the api is created.
 func (api *PublicFilterAPI) timeoutLoop() {
@@ -120,8 +195,8 @@ func (api *PublicFilterAPI) timeoutLoop() {
 		for id, f := range api.filters {
 			select {
 			case <-f.deadline.C:
+				api.removeFilter(id)
 				f.s.Unsubscribe(api.events)
-				delete(api.filters, id)
 			default:
 				continue
 			}
@@ -137,69 +212,60 @@ func (api *PublicFilterAPI) timeoutLoop() {
 // `eth_getFilterChanges` polling method that is also used for log filters.
 //
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_newPendingTransactionFilter
-func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
+func (api *PublicFilterAPI) NewPendingTransactionFilter(ctx context.Context) rpc.ID {
 	api.filtersMu.Lock()
 	defer api.filtersMu.Unlock()
 
-	if len(api.filters) >= int(api.backend.RPCFilterCap()) {
-		return rpc.ID("error creating pending tx filter: max limit reached")
-	}
-
 	pendingTxSub, cancelSubs, err := api.events.SubscribePendingTxs()
 	if err != nil {
 		// wrap error on the ID
 		return rpc.ID(fmt.Sprintf("error creating pending tx filter: %s", err.Error()))
 	}
 
-	api.filters[pendingTxSub.ID()] = &filter{
+	if err := api.installFilter(pendingTxSub.ID(), &filter{
 		typ:      filters.PendingTransactionsSubscription,
+		owner:    FilterOwner(ctx),
 		deadline: time.NewTimer(deadline),
 		hashes:   make([]common.Hash, 0),
 		s:        pendingTxSub,
+	}); err != nil {
+		cancelSubs()
+		return rpc.ID(fmt.Sprintf("error creating pending tx filter: %s", err.Error()))
 	}
 
-	go func(txsCh <-chan coretypes.ResultEvent, errCh <-chan error) {
+	go func(txsCh <-chan coretypes.ResultEvent) {
 		defer cancelSubs()
 
-		for {
-			select {
-			case ev, ok := <-txsCh:
-				if !ok {
-					api.filtersMu.Lock()
-					delete(api.filters, pendingTxSub.ID())
-					api.filtersMu.Unlock()
-					return
-				}
-
-				data, ok := ev.Data.(tmtypes.EventDataTx)
-				if !ok {
-					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
-					continue
-				}
+		for ev := range txsCh {
+			data, ok := ev.Data.(tmtypes.EventDataTx)
+			if !ok {
+				api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
+				continue
+			}
 
-				tx, err := api.clientCtx.TxConfig.TxDecoder()(data.Tx)
-				if err != nil {
-					api.logger.Debug("fail to decode tx", "error", err.Error())
-					continue
-				}
+			tx, err := api.clientCtx.TxConfig.TxDecoder()(data.Tx)
+			if err != nil {
+				api.logger.Debug("fail to decode tx", "error", err.Error())
+				continue
+			}
 
-				api.filtersMu.Lock()
-				if f, found := api.filters[pendingTxSub.ID()]; found {
-					for _, msg := range tx.GetMsgs() {
-						ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
-						if ok {
-							f.hashes = append(f.hashes, ethTx.AsTransaction().Hash())
-						}
+			api.filtersMu.Lock()
+			if f, found := api.filters[pendingTxSub.ID()]; found {
+				for _, msg := range tx.GetMsgs() {
+					ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
+					if ok {
+						f.hashes = append(f.hashes, ethTx.AsTransaction().Hash())
 					}
 				}
-				api.filtersMu.Unlock()
-			case <-errCh:
-				api.filtersMu.Lock()
-				delete(api.filters, pendingTxSub.ID())
-				api.filtersMu.Unlock()
 			}
+			api.filtersMu.Unlock()
 		}
-	}(pendingTxSub.eventCh, pendingTxSub.Err())
+
+		// the subscription is uninstalled
+		api.filtersMu.Lock()
+		api.removeFilter(pendingTxSub.ID())
+		api.filtersMu.Unlock()
+	}(pendingTxSub.Event())
 
 	return pendingTxSub.ID()
 }
@@ -214,11 +280,6 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 
 	rpcSub := notifier.CreateSubscription()
 
-	ctx, cancelFn := context.WithTimeout(context.Background(), deadline)
-	defer cancelFn()
-
-	api.events.WithContext(ctx)
-
 	pendingTxSub, cancelSubs, err := api.events.SubscribePendingTxs()
 	if err != nil {
 		return nil, err
@@ -231,9 +292,6 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 			select {
 			case ev, ok := <-txsCh:
 				if !ok {
-					api.filtersMu.Lock()
-					delete(api.filters, pendingTxSub.ID())
-					api.filtersMu.Unlock()
 					return
 				}
 
@@ -256,14 +314,12 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 					}
 				}
 			case <-rpcSub.Err():
-				pendingTxSub.Unsubscribe(api.events)
 				return
 			case <-notifier.Closed():
-				pendingTxSub.Unsubscribe(api.events)
 				return
 			}
 		}
-	}(pendingTxSub.eventCh)
+	}(pendingTxSub.Event())
 
 	return rpcSub, err
 }
@@ -272,54 +328,49 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 // It is part of the filter package since polling goes with eth_getFilterChanges.
 //
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_newblockfilter
-func (api *PublicFilterAPI) NewBlockFilter() rpc.ID {
+func (api *PublicFilterAPI) NewBlockFilter(ctx context.Context) rpc.ID {
 	api.filtersMu.Lock()
 	defer api.filtersMu.Unlock()
 
-	if len(api.filters) >= int(api.backend.RPCFilterCap()) {
-		return rpc.ID("error creating block filter: max limit reached")
-	}
-
 	headerSub, cancelSubs, err := api.events.SubscribeNewHeads()
 	if err != nil {
 		// wrap error on the ID
 		return rpc.ID(fmt.Sprintf("error creating block filter: %s", err.Error()))
 	}
 
-	api.filters[headerSub.ID()] = &filter{typ: filters.BlocksSubscription, deadline: time.NewTimer(deadline), hashes: []common.Hash{}, s: headerSub}
+	if err := api.installFilter(headerSub.ID(), &filter{
+		typ:      filters.BlocksSubscription,
+		owner:    FilterOwner(ctx),
+		deadline: time.NewTimer(deadline),
+		hashes:   []common.Hash{},
+		s:        headerSub,
+	}); err != nil {
+		cancelSubs()
+		return rpc.ID(fmt.Sprintf("error creating block filter: %s", err.Error()))
+	}
 
-	go func(headersCh <-chan coretypes.ResultEvent, errCh <-chan error) {
+	go func(headersCh <-chan coretypes.ResultEvent) {
 		defer cancelSubs()
 
-		for {
-			select {
-			case ev, ok := <-headersCh:
-				if !ok {
-					api.filtersMu.Lock()
-					delete(api.filters, headerSub.ID())
-					api.filtersMu.Unlock()
-					return
-				}
-
-				data, ok := ev.Data.(tmtypes.EventDataNewBlockHeader)
-				if !ok {
-					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
-					continue
-				}
+		for ev := range headersCh {
+			data, ok := ev.Data.(tmtypes.EventDataNewBlockHeader)
+			if !ok {
+				api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
+				continue
+			}
 
-				api.filtersMu.Lock()
-				if f, found := api.filters[headerSub.ID()]; found {
-					f.hashes = append(f.hashes, common.BytesToHash(data.Header.Hash()))
-				}
-				api.filtersMu.Unlock()
-			case <-errCh:
-				api.filtersMu.Lock()
-				delete(api.filters, headerSub.ID())
-				api.filtersMu.Unlock()
-				return
+			api.filtersMu.Lock()
+			if f, found := api.filters[headerSub.ID()]; found {
+				f.hashes = append(f.hashes, common.BytesToHash(data.Header.Hash()))
 			}
+			api.filtersMu.Unlock()
 		}
-	}(headerSub.eventCh, headerSub.Err())
+
+		// the subscription is uninstalled
+		api.filtersMu.Lock()
+		api.removeFilter(headerSub.ID())
+		api.filtersMu.Unlock()
+	}(headerSub.Event())
 
 	return headerSub.ID()
 }
@@ -331,7 +382,6 @@ func (api *PublicFilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, er
 		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
 	}
 
-	api.events.WithContext(ctx)
 	rpcSub := notifier.CreateSubscription()
 
 	headersSub, cancelSubs, err := api.events.SubscribeNewHeads()
@@ -346,7 +396,6 @@ func (api *PublicFilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, er
 			select {
 			case ev, ok := <-headersCh:
 				if !ok {
-					headersSub.Unsubscribe(api.events)
 					return
 				}
 
@@ -360,16 +409,15 @@ func (api *PublicFilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, er
 
 				// TODO: fetch bloom from events
 				header := types.EthHeaderFromTendermint(data.Header, ethtypes.Bloom{}, baseFee)
+				header.Time = types.BlockTimeFromEvents(data.Header, data.ResultBeginBlock.Events)
 				_ = notifier.Notify(rpcSub.ID, header)
 			case <-rpcSub.Err():
-				headersSub.Unsubscribe(api.events)
 				return
 			case <-notifier.Closed():
-				headersSub.Unsubscribe(api.events)
 				return
 			}
 		}
-	}(headersSub.eventCh)
+	}(headersSub.Event())
 
 	return rpcSub, err
 }
@@ -381,7 +429,6 @@ func (api *PublicFilterAPI) Logs(ctx context.Context, crit filters.FilterCriteri
 		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
 	}
 
-	api.events.WithContext(ctx)
 	rpcSub := notifier.CreateSubscription()
 
 	logsSub, cancelSubs, err := api.events.SubscribeLogs(crit)
@@ -389,52 +436,26 @@ func (api *PublicFilterAPI) Logs(ctx context.Context, crit filters.FilterCriteri
 		return &rpc.Subscription{}, err
 	}
 
-	go func(logsCh <-chan coretypes.ResultEvent) {
+	go func(logsCh <-chan []*ethtypes.Log) {
 		defer cancelSubs()
 
 		for {
 			select {
-			case ev, ok := <-logsCh:
+			case logs, ok := <-logsCh:
 				if !ok {
-					logsSub.Unsubscribe(api.events)
 					return
 				}
 
-				// filter only events from EVM module txs
-				_, isMsgEthereumTx := ev.Events[evmtypes.TypeMsgEthereumTx]
-
-				if !isMsgEthereumTx {
-					// ignore transaction as it's not from the evm module
-					return
-				}
-
-				// get transaction result data
-				dataTx, ok := ev.Data.(tmtypes.EventDataTx)
-				if !ok {
-					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
-					continue
-				}
-
-				txResponse, err := evmtypes.DecodeTxResponse(dataTx.TxResult.Result.Data)
-				if err != nil {
-					api.logger.Error("fail to decode tx response", "error", err)
-					return
-				}
-
-				logs := FilterLogs(evmtypes.LogsToEthereum(txResponse.Logs), crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
-
 				for _, log := range logs {
 					_ = notifier.Notify(rpcSub.ID, log)
 				}
 			case <-rpcSub.Err(): // client send an unsubscribe request
-				logsSub.Unsubscribe(api.events)
 				return
 			case <-notifier.Closed(): // connection dropped
-				logsSub.Unsubscribe(api.events)
 				return
 			}
 		}
-	}(logsSub.eventCh)
+	}(logsSub.Logs())
 
 	return rpcSub, err
 }
@@ -452,75 +473,48 @@ func (api *PublicFilterAPI) Logs(ctx context.Context, crit filters.FilterCriteri
 // In case "fromBlock" > "toBlock" an error is returned.
 //
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_newfilter
-func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID, error) {
+func (api *PublicFilterAPI) NewFilter(ctx context.Context, criteria filters.FilterCriteria) (rpc.ID, error) {
 	api.filtersMu.Lock()
 	defer api.filtersMu.Unlock()
 
-	if len(api.filters) >= int(api.backend.RPCFilterCap()) {
-		return rpc.ID(""), fmt.Errorf("error creating filter: max limit reached")
-	}
-
-	var (
-		filterID = rpc.ID("")
-		err      error
-	)
-
 	logsSub, cancelSubs, err := api.events.SubscribeLogs(criteria)
 	if err != nil {
 		return rpc.ID(""), err
 	}
 
-	filterID = logsSub.ID()
+	filterID := logsSub.ID()
 
-	api.filters[filterID] = &filter{
+	if err := api.installFilter(filterID, &filter{
 		typ:      filters.LogsSubscription,
+		owner:    FilterOwner(ctx),
 		crit:     criteria,
 		deadline: time.NewTimer(deadline),
 		hashes:   []common.Hash{},
 		s:        logsSub,
+	}); err != nil {
+		cancelSubs()
+		return rpc.ID(""), fmt.Errorf("error creating filter: %w", err)
 	}
 
-	go func(eventCh <-chan coretypes.ResultEvent) {
+	go func(logsCh <-chan []*ethtypes.Log) {
 		defer cancelSubs()
 
-		for {
-			select {
-			case ev, ok := <-eventCh:
-				if !ok {
-					api.filtersMu.Lock()
-					delete(api.filters, filterID)
-					api.filtersMu.Unlock()
-					return
-				}
-				dataTx, ok := ev.Data.(tmtypes.EventDataTx)
-				if !ok {
-					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
-					continue
-				}
-
-				txResponse, err := evmtypes.DecodeTxResponse(dataTx.TxResult.Result.Data)
-				if err != nil {
-					api.logger.Error("fail to decode tx response", "error", err)
-					return
-				}
-
-				logs := FilterLogs(evmtypes.LogsToEthereum(txResponse.Logs), criteria.FromBlock, criteria.ToBlock, criteria.Addresses, criteria.Topics)
-
-				api.filtersMu.Lock()
-				if f, found := api.filters[filterID]; found {
-					f.logs = append(f.logs, logs...)
-				}
-				api.filtersMu.Unlock()
-			case <-logsSub.Err():
-				api.filtersMu.Lock()
-				delete(api.filters, filterID)
-				api.filtersMu.Unlock()
-				return
+		// the logs are matched against the criteria by the event system
+		for logs := range logsCh {
+			api.filtersMu.Lock()
+			if f, found := api.filters[filterID]; found {
+				f.logs = append(f.logs, logs...)
 			}
+			api.filtersMu.Unlock()
 		}
-	}(logsSub.eventCh)
 
-	return filterID, err
+		// the subscription is uninstalled
+		api.filtersMu.Lock()
+		api.removeFilter(filterID)
+		api.filtersMu.Unlock()
+	}(logsSub.Logs())
+
+	return filterID, nil
 }
 
 // GetLogs returns logs matching the given argument that are stored within the state.
@@ -559,10 +553,7 @@ func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit filters.FilterCrit
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_uninstallfilter
 func (api *PublicFilterAPI) UninstallFilter(id rpc.ID) bool {
 	api.filtersMu.Lock()
-	f, found := api.filters[id]
-	if found {
-		delete(api.filters, id)
-	}
+	f, found := api.removeFilter(id)
 	api.filtersMu.Unlock()
 
 	if !found {
@@ -30,7 +30,6 @@ import (
 	rpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
 	tmtypes "github.com/tendermint/tendermint/types"
 
-	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -51,32 +50,45 @@ var (
 	headerEvents = tmtypes.QueryForEvent(tmtypes.EventNewBlockHeader).String()
 )
 
-// EventSystem creates subscriptions, processes events and broadcasts them to the
-// subscription which match the subscription criteria using the Tendermint's RPC client.
+const (
+	// subscribeTimeout bounds the subscription to the Tendermint events
+	subscribeTimeout = 10 * time.Second
+	// subscriptionBuffer is the number of events buffered for each subscription, the events of
+	// the lagging subscriptions are dropped once it is full
+	subscriptionBuffer = 128
+)
+
+var (
+	sharedEventSystemsMu sync.Mutex
+	sharedEventSystems   = make(map[*rpcclient.WSClient]*EventSystem)
+)
+
+// EventSystem fans out the Tendermint events to the filters and subscriptions which match their
+// criteria. It subscribes once to each Tendermint event type, on first use, and keeps the
+// subscription for its lifetime, so that the number of Tendermint subscriptions doesn't grow with
+// the number of clients. The log subscriptions are indexed by address and topic, so that each
+// log is only matched against the subscriptions that can include it.
 type EventSystem struct {
 	logger     log.Logger
-	ctx        context.Context
 	tmWSClient *rpcclient.WSClient
 
 	// light client mode
 	lightMode bool
 
-	index      filterIndex
-	topicChans map[string]chan<- coretypes.ResultEvent
-	indexMux   *sync.RWMutex
+	// subscribed are the Tendermint queries subscribed to
+	subscribed  map[string]bool
+	subscribeMu sync.Mutex
 
-	// Channels
-	install   chan *Subscription // install filter for event notification
-	uninstall chan *Subscription // remove filter for event notification
-	eventBus  pubsub.EventBus
+	index    filterIndex
+	logIndex *logIndex
+	indexMux *sync.RWMutex
 }
 
-// NewEventSystem creates a new manager that listens for event on the given mux,
-// parses and filters them. It uses the all map to retrieve filter changes. The
-// work loop holds its own index that is used to forward events to filters.
+// NewEventSystem creates a new manager that consumes the events of the given Tendermint websocket
+// client, parses and filters them. The work loop holds its own index that is used to forward
+// events to filters.
 //
-// The returned manager has a loop that needs to be stopped with the Stop function
-// or by stopping the given mux.
+// A websocket client must only be consumed by a single event system, see SharedEventSystem.
 func NewEventSystem(logger log.Logger, tmWSClient *rpcclient.WSClient) *EventSystem {
 	index := make(filterIndex)
 	for i := filters.UnknownSubscription; i < filters.LastIndexSubscription; i++ {
@@ -85,80 +97,124 @@ func NewEventSystem(logger log.Logger, tmWSClient *rpcclient.WSClient) *EventSys
 
 	es := &EventSystem{
 		logger:     logger,
-		ctx:        context.Background(),
 		tmWSClient: tmWSClient,
 		lightMode:  false,
+		subscribed: make(map[string]bool),
 		index:      index,
-		topicChans: make(map[string]chan<- coretypes.ResultEvent, len(index)),
+		logIndex:   newLogIndex(),
 		indexMux:   new(sync.RWMutex),
-		install:    make(chan *Subscription),
-		uninstall:  make(chan *Subscription),
-		eventBus:   pubsub.NewEventBus(),
 	}
 
-	go es.eventLoop()
 	go es.consumeEvents()
 	return es
 }
 
-// WithContext sets a new context to the EventSystem. This is required to set a timeout context when
-// a new filter is intantiated.
-func (es *EventSystem) WithContext(ctx context.Context) {
-	es.ctx = ctx
+// SharedEventSystem returns the event system consuming the events of the given Tendermint
+// websocket client, creating it on first use. The filter API and the websocket server share it,
+// so that they share the Tendermint subscriptions.
+func SharedEventSystem(logger log.Logger, tmWSClient *rpcclient.WSClient) *EventSystem {
+	sharedEventSystemsMu.Lock()
+	defer sharedEventSystemsMu.Unlock()
+
+	es, ok := sharedEventSystems[tmWSClient]
+	if !ok {
+		es = NewEventSystem(logger.With("module", "event-system"), tmWSClient)
+		sharedEventSystems[tmWSClient] = es
+	}
+	return es
 }
 
-// subscribe performs a new event subscription to a given Tendermint event.
-// The subscription creates a unidirectional receive event channel to receive the ResultEvent.
-func (es *EventSystem) subscribe(sub *Subscription) (*Subscription, pubsub.UnsubscribeFunc, error) {
-	var (
-		err      error
-		cancelFn context.CancelFunc
-	)
-
-	ctx, cancelFn := context.WithCancel(context.Background())
-	defer cancelFn()
+// ResubscribeSharedEventSystem subscribes the shared event system of the given Tendermint
+// websocket client, if any, to its Tendermint events again. It must be called when the client
+// reconnects, as the subscriptions don't survive the connection.
+func ResubscribeSharedEventSystem(tmWSClient *rpcclient.WSClient) {
+	sharedEventSystemsMu.Lock()
+	es, ok := sharedEventSystems[tmWSClient]
+	sharedEventSystemsMu.Unlock()
 
-	existingSubs := es.eventBus.Topics()
-	for _, topic := range existingSubs {
-		if topic == sub.event {
-			eventCh, unsubFn, err := es.eventBus.Subscribe(sub.event)
-			if err != nil {
-				err := errors.Wrapf(err, "failed to subscribe to topic: %s", sub.event)
-				return nil, nil, err
-			}
+	if ok {
+		es.resubscribe()
+	}
+}
 
-			sub.eventCh = eventCh
-			return sub, unsubFn, nil
+// resubscribe subscribes again to the Tendermint queries subscribed to. The queries that fail
+// are subscribed to again by the next subscription of their type.
+func (es *EventSystem) resubscribe() {
+	es.subscribeMu.Lock()
+	defer es.subscribeMu.Unlock()
+
+	for query := range es.subscribed {
+		ctx, cancelFn := context.WithTimeout(context.Background(), subscribeTimeout)
+		err := es.tmWSClient.Subscribe(ctx, query)
+		cancelFn()
+		if err != nil {
+			es.logger.Error("failed to subscribe again to topic", "query", query, "error", err.Error())
+			delete(es.subscribed, query)
 		}
 	}
+}
 
+// subscribe installs the subscription, after subscribing to its Tendermint event if it is the
+// first subscription of this event type.
+func (es *EventSystem) subscribe(sub *Subscription) (*Subscription, pubsub.UnsubscribeFunc, error) {
 	switch sub.typ {
-	case filters.LogsSubscription:
-		err = es.tmWSClient.Subscribe(ctx, sub.event)
-	case filters.BlocksSubscription:
-		err = es.tmWSClient.Subscribe(ctx, sub.event)
-	case filters.PendingTransactionsSubscription:
-		err = es.tmWSClient.Subscribe(ctx, sub.event)
+	case filters.LogsSubscription, filters.BlocksSubscription, filters.PendingTransactionsSubscription:
 	default:
-		err = fmt.Errorf("invalid filter subscription type %d", sub.typ)
+		return nil, nil, fmt.Errorf("invalid filter subscription type %d", sub.typ)
 	}
 
-	if err != nil {
-		sub.err <- err
+	if err := es.subscribeTendermint(sub.event); err != nil {
 		return nil, nil, err
 	}
 
-	// wrap events in a go routine to prevent blocking
-	es.install <- sub
-	<-sub.installed
+	es.indexMux.Lock()
+	es.index[sub.typ][sub.id] = sub
+	if sub.typ == filters.LogsSubscription {
+		es.logIndex.add(sub)
+	}
+	es.indexMux.Unlock()
 
-	eventCh, unsubFn, err := es.eventBus.Subscribe(sub.event)
-	if err != nil {
-		return nil, nil, errors.Wrapf(err, "failed to subscribe to topic after installed: %s", sub.event)
+	return sub, func() { sub.Unsubscribe(es) }, nil
+}
+
+// subscribeTendermint subscribes to the Tendermint query, unless it is already subscribed to.
+func (es *EventSystem) subscribeTendermint(query string) error {
+	es.subscribeMu.Lock()
+	defer es.subscribeMu.Unlock()
+
+	if es.subscribed[query] {
+		return nil
 	}
 
-	sub.eventCh = eventCh
-	return sub, unsubFn, nil
+	ctx, cancelFn := context.WithTimeout(context.Background(), subscribeTimeout)
+	defer cancelFn()
+
+	if err := es.tmWSClient.Subscribe(ctx, query); err != nil {
+		return errors.Wrapf(err, "failed to subscribe to topic: %s", query)
+	}
+
+	es.subscribed[query] = true
+	return nil
+}
+
+// uninstall removes the subscription and closes its channels.
+func (es *EventSystem) uninstall(sub *Subscription) {
+	es.indexMux.Lock()
+	defer es.indexMux.Unlock()
+
+	if _, ok := es.index[sub.typ][sub.id]; !ok {
+		// already uninstalled
+		return
+	}
+
+	delete(es.index[sub.typ], sub.id)
+	if sub.typ == filters.LogsSubscription {
+		es.logIndex.remove(sub)
+		close(sub.logs)
+	} else {
+		close(sub.eventCh)
+	}
+	close(sub.err)
 }
 
 // SubscribeLogs creates a subscription that will write all logs matching the
@@ -194,94 +250,46 @@ func (es *EventSystem) SubscribeLogs(crit filters.FilterCriteria) (*Subscription
 // given criteria to the given logs channel.
 func (es *EventSystem) subscribeLogs(crit filters.FilterCriteria) (*Subscription, pubsub.UnsubscribeFunc, error) {
 	sub := &Subscription{
-		id:        rpc.NewID(),
-		typ:       filters.LogsSubscription,
-		event:     evmEvents,
-		logsCrit:  crit,
-		created:   time.Now().UTC(),
-		logs:      make(chan []*ethtypes.Log),
-		installed: make(chan struct{}, 1),
-		err:       make(chan error, 1),
+		id:       rpc.NewID(),
+		typ:      filters.LogsSubscription,
+		event:    evmEvents,
+		logsCrit: crit,
+		created:  time.Now().UTC(),
+		logs:     make(chan []*ethtypes.Log, subscriptionBuffer),
+		err:      make(chan error),
 	}
 	return es.subscribe(sub)
 }
 
 // SubscribeNewHeads subscribes to new block headers events.
-func (es EventSystem) SubscribeNewHeads() (*Subscription, pubsub.UnsubscribeFunc, error) {
+func (es *EventSystem) SubscribeNewHeads() (*Subscription, pubsub.UnsubscribeFunc, error) {
 	sub := &Subscription{
-		id:        rpc.NewID(),
-		typ:       filters.BlocksSubscription,
-		event:     headerEvents,
-		created:   time.Now().UTC(),
-		headers:   make(chan *ethtypes.Header),
-		installed: make(chan struct{}, 1),
-		err:       make(chan error, 1),
+		id:      rpc.NewID(),
+		typ:     filters.BlocksSubscription,
+		event:   headerEvents,
+		created: time.Now().UTC(),
+		eventCh: make(chan coretypes.ResultEvent, subscriptionBuffer),
+		err:     make(chan error),
 	}
 	return es.subscribe(sub)
 }
 
 // SubscribePendingTxs subscribes to new pending transactions events from the mempool.
-func (es EventSystem) SubscribePendingTxs() (*Subscription, pubsub.UnsubscribeFunc, error) {
+func (es *EventSystem) SubscribePendingTxs() (*Subscription, pubsub.UnsubscribeFunc, error) {
 	sub := &Subscription{
-		id:        rpc.NewID(),
-		typ:       filters.PendingTransactionsSubscription,
-		event:     txEvents,
-		created:   time.Now().UTC(),
-		hashes:    make(chan []common.Hash),
-		installed: make(chan struct{}, 1),
-		err:       make(chan error, 1),
+		id:      rpc.NewID(),
+		typ:     filters.PendingTransactionsSubscription,
+		event:   txEvents,
+		created: time.Now().UTC(),
+		eventCh: make(chan coretypes.ResultEvent, subscriptionBuffer),
+		err:     make(chan error),
 	}
 	return es.subscribe(sub)
 }
 
 type filterIndex map[filters.Type]map[rpc.ID]*Subscription
 
-// eventLoop (un)installs filters and processes mux events.
-func (es *EventSystem) eventLoop() {
-	for {
-		select {
-		case f := <-es.install:
-			es.indexMux.Lock()
-			es.index[f.typ][f.id] = f
-			ch := make(chan coretypes.ResultEvent)
-			es.topicChans[f.event] = ch
-			if err := es.eventBus.AddTopic(f.event, ch); err != nil {
-				es.logger.Error("failed to add event topic to event bus", "topic", f.event, "error", err.Error())
-			}
-			es.indexMux.Unlock()
-			close(f.installed)
-		case f := <-es.uninstall:
-			es.indexMux.Lock()
-			delete(es.index[f.typ], f.id)
-
-			var channelInUse bool
-			for _, sub := range es.index[f.typ] {
-				if sub.event == f.event {
-					channelInUse = true
-					break
-				}
-			}
-
-			// remove topic only when channel is not used by other subscriptions
-			if !channelInUse {
-				if err := es.tmWSClient.Unsubscribe(es.ctx, f.event); err != nil {
-					es.logger.Error("failed to unsubscribe from query", "query", f.event, "error", err.Error())
-				}
-
-				ch, ok := es.topicChans[f.event]
-				if ok {
-					es.eventBus.RemoveTopic(f.event)
-					close(ch)
-					delete(es.topicChans, f.event)
-				}
-			}
-
-			es.indexMux.Unlock()
-			close(f.err)
-		}
-	}
-}
-
+// consumeEvents reads the events of the Tendermint subscriptions and dispatches them.
 func (es *EventSystem) consumeEvents() {
 	for {
 		for rpcResp := range es.tmWSClient.ResponsesCh {
@@ -300,24 +308,71 @@ func (es *EventSystem) consumeEvents() {
 				continue
 			}
 
-			es.indexMux.RLock()
-			ch, ok := es.topicChans[ev.Query]
-			es.indexMux.RUnlock()
-			if !ok {
-				es.logger.Debug("channel for subscription not found", "topic", ev.Query)
-				es.logger.Debug("list of available channels", "channels", es.eventBus.Topics())
-				continue
-			}
-
-			// gracefully handle lagging subscribers
-			t := time.NewTimer(time.Second)
-			select {
-			case <-t.C:
-				es.logger.Debug("dropped event during lagging subscription", "topic", ev.Query)
-			case ch <- ev:
-			}
+			es.dispatch(ev)
 		}
 
 		time.Sleep(time.Second)
 	}
 }
+
+// dispatch forwards the event to the subscriptions of its type.
+func (es *EventSystem) dispatch(ev coretypes.ResultEvent) {
+	switch ev.Query {
+	case evmEvents:
+		es.dispatchLogs(ev)
+	case headerEvents:
+		es.broadcast(filters.BlocksSubscription, ev)
+	case txEvents:
+		es.broadcast(filters.PendingTransactionsSubscription, ev)
+	default:
+		es.logger.Debug("event of unknown subscription", "topic", ev.Query)
+	}
+}
+
+// broadcast sends the event to all the subscriptions of the given type.
+func (es *EventSystem) broadcast(typ filters.Type, ev coretypes.ResultEvent) {
+	es.indexMux.RLock()
+	defer es.indexMux.RUnlock()
+
+	for _, sub := range es.index[typ] {
+		// gracefully handle lagging subscribers
+		select {
+		case sub.eventCh <- ev:
+		default:
+			es.logger.Debug("dropped event during lagging subscription", "topic", ev.Query, "subscription-id", sub.id)
+		}
+	}
+}
+
+// dispatchLogs decodes the logs of the EVM transaction once and sends them to the log
+// subscriptions whose criteria they match.
+func (es *EventSystem) dispatchLogs(ev coretypes.ResultEvent) {
+	dataTx, ok := ev.Data.(tmtypes.EventDataTx)
+	if !ok {
+		es.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
+		return
+	}
+
+	txResponse, err := evmtypes.DecodeTxResponse(dataTx.TxResult.Result.Data)
+	if err != nil {
+		es.logger.Debug("fail to decode tx response", "error", err.Error())
+		return
+	}
+
+	logs := evmtypes.LogsToEthereum(txResponse.Logs)
+	if len(logs) == 0 {
+		return
+	}
+
+	es.indexMux.RLock()
+	defer es.indexMux.RUnlock()
+
+	for sub, matched := range es.logIndex.match(logs) {
+		// gracefully handle lagging subscribers
+		select {
+		case sub.logs <- matched:
+		default:
+			es.logger.Debug("dropped logs during lagging subscription", "subscription-id", sub.id)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package filters
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var (
+	addrA  = common.HexToAddress("0xa")
+	addrB  = common.HexToAddress("0xb")
+	topicX = common.HexToHash("0x1")
+	topicY = common.HexToHash("0x2")
+)
+
+// newTestEventSystem returns an event system that isn't connected to Tendermint, with all the
+// Tendermint queries marked as subscribed.
+func newTestEventSystem() *EventSystem {
+	index := make(filterIndex)
+	for i := filters.UnknownSubscription; i < filters.LastIndexSubscription; i++ {
+		index[i] = make(map[rpc.ID]*Subscription)
+	}
+
+	return &EventSystem{
+		logger:     log.NewNopLogger(),
+		subscribed: map[string]bool{evmEvents: true, headerEvents: true, txEvents: true},
+		index:      index,
+		logIndex:   newLogIndex(),
+		indexMux:   new(sync.RWMutex),
+	}
+}
+
+// evmTxEvent returns the Tendermint event of an EVM transaction emitting the given logs.
+func evmTxEvent(t *testing.T, logs ...*ethtypes.Log) coretypes.ResultEvent {
+	res := &evmtypes.MsgEthereumTxResponse{Logs: evmtypes.NewLogsFromEth(logs)}
+	data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{codectypes.UnsafePackAny(res)}})
+	require.NoError(t, err)
+
+	return coretypes.ResultEvent{
+		Query: evmEvents,
+		Data: tmtypes.EventDataTx{TxResult: abci.TxResult{
+			Result: abci.ResponseDeliverTx{Data: data},
+		}},
+	}
+}
+
+func TestLogIndex(t *testing.T) {
+	es := newTestEventSystem()
+
+	subscribe := func(crit filters.FilterCriteria) *Subscription {
+		sub, _, err := es.SubscribeLogs(crit)
+		require.NoError(t, err)
+		return sub
+	}
+
+	byAddress := subscribe(filters.FilterCriteria{Addresses: []common.Address{addrA}})
+	byTopic := subscribe(filters.FilterCriteria{Topics: [][]common.Hash{{}, {topicX, topicY}}})
+	byAddressAndTopic := subscribe(filters.FilterCriteria{
+		Addresses: []common.Address{addrA, addrB},
+		Topics:    [][]common.Hash{{topicY}},
+	})
+	wildcard := subscribe(filters.FilterCriteria{})
+
+	logA := &ethtypes.Log{Address: addrA, Topics: []common.Hash{topicX}}
+	logB := &ethtypes.Log{Address: addrB, Topics: []common.Hash{topicY, topicX}}
+	logC := &ethtypes.Log{Address: common.HexToAddress("0xc"), Topics: []common.Hash{topicX}}
+
+	matches := es.logIndex.match([]*ethtypes.Log{logA, logB, logC})
+	require.Len(t, matches, 4)
+	require.Equal(t, []*ethtypes.Log{logA}, matches[byAddress])
+	require.Equal(t, []*ethtypes.Log{logB}, matches[byTopic])
+	require.Equal(t, []*ethtypes.Log{logB}, matches[byAddressAndTopic])
+	require.Equal(t, []*ethtypes.Log{logA, logB, logC}, matches[wildcard])
+
+	byTopic.Unsubscribe(es)
+	byAddressAndTopic.Unsubscribe(es)
+	wildcard.Unsubscribe(es)
+	// unsubscribing twice is a no-op
+	wildcard.Unsubscribe(es)
+
+	matches = es.logIndex.match([]*ethtypes.Log{logA, logB, logC})
+	require.Len(t, matches, 1)
+	require.Empty(t, es.logIndex.byTopic)
+	require.Empty(t, es.logIndex.wildcard)
+	require.Len(t, es.logIndex.byAddress, 1)
+
+	_, ok := <-wildcard.Logs()
+	require.False(t, ok, "channel of uninstalled subscription must be closed")
+}
+
+func TestEventSystemDispatch(t *testing.T) {
+	es := newTestEventSystem()
+
+	logsSub, unsubLogs, err := es.SubscribeLogs(filters.FilterCriteria{Addresses: []common.Address{addrB}})
+	require.NoError(t, err)
+	defer unsubLogs()
+
+	headsSubs := make([]*Subscription, 2)
+	for i := range headsSubs {
+		headsSubs[i], _, err = es.SubscribeNewHeads()
+		require.NoError(t, err)
+	}
+
+	logA := &ethtypes.Log{Address: addrA, Topics: []common.Hash{topicX}}
+	logB := &ethtypes.Log{Address: addrB, Topics: []common.Hash{topicY}}
+
+	// the transaction logs are decoded once and only the matching ones are sent
+	es.dispatch(evmTxEvent(t, logA, logB))
+	logs := <-logsSub.Logs()
+	require.Len(t, logs, 1)
+	require.Equal(t, addrB, logs[0].Address)
+
+	// no event is sent when no log matches
+	es.dispatch(evmTxEvent(t, logA))
+	require.Empty(t, logsSub.Logs())
+
+	// the header events are sent to every block subscription
+	ev := coretypes.ResultEvent{Query: headerEvents, Data: tmtypes.EventDataNewBlockHeader{}}
+	es.dispatch(ev)
+	for _, sub := range headsSubs {
+		require.Equal(t, ev, <-sub.Event())
+	}
+
+	// the events of lagging subscriptions are dropped instead of blocking the others
+	for i := 0; i < subscriptionBuffer+1; i++ {
+		es.dispatch(ev)
+	}
+	require.Len(t, headsSubs[0].Event(), subscriptionBuffer)
+
+	headsSubs[0].Unsubscribe(es)
+	_, ok := <-headsSubs[0].Err()
+	require.False(t, ok)
+	require.Len(t, es.index[filters.BlocksSubscription], 1)
+}
+
+type capBackend struct {
+	Backend
+	filterCap int32
+}
+
+func (b capBackend) RPCFilterCap() int32 {
+	return b.filterCap
+}
+
+func TestFilterCapPerClient(t *testing.T) {
+	api := &PublicFilterAPI{
+		logger:        log.NewNopLogger(),
+		backend:       capBackend{filterCap: 2},
+		events:        newTestEventSystem(),
+		filters:       make(map[rpc.ID]*filter),
+		clientFilters: make(map[string]int),
+	}
+
+	install := func(owner string) error {
+		api.filtersMu.Lock()
+		defer api.filtersMu.Unlock()
+		return api.installFilter(rpc.NewID(), &filter{typ: filters.BlocksSubscription, owner: owner})
+	}
+
+	require.NoError(t, install("10.0.0.1"))
+	require.NoError(t, install("10.0.0.1"))
+	require.Error(t, install("10.0.0.1"))
+	// the other clients aren't affected
+	require.NoError(t, install("10.0.0.2"))
+
+	// the filters of the calling client are counted
+	id := api.NewBlockFilter(context.Background())
+	_, found := api.filters[id]
+	require.True(t, found)
+	require.Equal(t, 1, api.clientFilters[FilterOwner(context.Background())])
+
+	// uninstalling a filter releases its slot
+	require.True(t, api.UninstallFilter(id))
+	require.False(t, api.UninstallFilter(id))
+	require.Zero(t, api.clientFilters[FilterOwner(context.Background())])
+
+	api.filtersMu.Lock()
+	for id, f := range api.filters {
+		if f.owner == "10.0.0.1" {
+			api.removeFilter(id)
+			break
+		}
+	}
+	api.filtersMu.Unlock()
+	require.NoError(t, install("10.0.0.1"))
+}
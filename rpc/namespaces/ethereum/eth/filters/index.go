@@ -0,0 +1,143 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package filters
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// topicKey identifies a topic at a position of the log topics.
+type topicKey struct {
+	position int
+	topic    common.Hash
+}
+
+// logIndex indexes the log subscriptions by the most selective rule of their criteria, so that a
+// log is only matched against the subscriptions that can include it:
+//
+//   - the subscriptions with addresses are indexed by each of their addresses
+//   - otherwise, the subscriptions with topics are indexed by the topics of their first non-empty position
+//   - the remaining subscriptions match every log
+type logIndex struct {
+	byAddress map[common.Address]map[rpc.ID]*Subscription
+	byTopic   map[topicKey]map[rpc.ID]*Subscription
+	wildcard  map[rpc.ID]*Subscription
+}
+
+func newLogIndex() *logIndex {
+	return &logIndex{
+		byAddress: make(map[common.Address]map[rpc.ID]*Subscription),
+		byTopic:   make(map[topicKey]map[rpc.ID]*Subscription),
+		wildcard:  make(map[rpc.ID]*Subscription),
+	}
+}
+
+// add indexes the log subscription.
+func (idx *logIndex) add(sub *Subscription) {
+	crit := sub.logsCrit
+	if len(crit.Addresses) > 0 {
+		for _, addr := range crit.Addresses {
+			subs, ok := idx.byAddress[addr]
+			if !ok {
+				subs = make(map[rpc.ID]*Subscription)
+				idx.byAddress[addr] = subs
+			}
+			subs[sub.id] = sub
+		}
+		return
+	}
+
+	position, topics := firstTopicRule(crit.Topics)
+	if position < 0 {
+		idx.wildcard[sub.id] = sub
+		return
+	}
+
+	for _, topic := range topics {
+		key := topicKey{position: position, topic: topic}
+		subs, ok := idx.byTopic[key]
+		if !ok {
+			subs = make(map[rpc.ID]*Subscription)
+			idx.byTopic[key] = subs
+		}
+		subs[sub.id] = sub
+	}
+}
+
+// remove removes the log subscription from the index.
+func (idx *logIndex) remove(sub *Subscription) {
+	crit := sub.logsCrit
+	if len(crit.Addresses) > 0 {
+		for _, addr := range crit.Addresses {
+			delete(idx.byAddress[addr], sub.id)
+			if len(idx.byAddress[addr]) == 0 {
+				delete(idx.byAddress, addr)
+			}
+		}
+		return
+	}
+
+	position, topics := firstTopicRule(crit.Topics)
+	if position < 0 {
+		delete(idx.wildcard, sub.id)
+		return
+	}
+
+	for _, topic := range topics {
+		key := topicKey{position: position, topic: topic}
+		delete(idx.byTopic[key], sub.id)
+		if len(idx.byTopic[key]) == 0 {
+			delete(idx.byTopic, key)
+		}
+	}
+}
+
+// match returns the logs matching the criteria of each subscription, in the order of the given logs.
+func (idx *logIndex) match(logs []*ethtypes.Log) map[*Subscription][]*ethtypes.Log {
+	matches := make(map[*Subscription][]*ethtypes.Log)
+	for _, log := range logs {
+		// a subscription is indexed by a single rule and a log has a single address and a single
+		// topic per position, so the candidates are distinct
+		matchSubscriptions(log, idx.byAddress[log.Address], matches)
+		for i, topic := range log.Topics {
+			matchSubscriptions(log, idx.byTopic[topicKey{position: i, topic: topic}], matches)
+		}
+		matchSubscriptions(log, idx.wildcard, matches)
+	}
+	return matches
+}
+
+// matchSubscriptions adds the log to the matches of the candidate subscriptions whose criteria it matches.
+func matchSubscriptions(log *ethtypes.Log, candidates map[rpc.ID]*Subscription, matches map[*Subscription][]*ethtypes.Log) {
+	for _, sub := range candidates {
+		crit := sub.logsCrit
+		if matchLog(log, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics) {
+			matches[sub] = append(matches[sub], log)
+		}
+	}
+}
+
+// firstTopicRule returns the first position of the topics criteria that isn't a wildcard, or -1.
+func firstTopicRule(topics [][]common.Hash) (int, []common.Hash) {
+	for i, sub := range topics {
+		if len(sub) > 0 {
+			return i, sub
+		}
+	}
+	return -1, nil
+}
@@ -18,7 +18,6 @@ package filters
 import (
 	"time"
 
-	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -27,17 +26,14 @@ import (
 
 // Subscription defines a wrapper for the private subscription
 type Subscription struct {
-	id        rpc.ID
-	typ       filters.Type
-	event     string
-	created   time.Time
-	logsCrit  filters.FilterCriteria
-	logs      chan []*ethtypes.Log
-	hashes    chan []common.Hash
-	headers   chan *ethtypes.Header
-	installed chan struct{} // closed when the filter is installed
-	eventCh   <-chan coretypes.ResultEvent
-	err       chan error
+	id       rpc.ID
+	typ      filters.Type
+	event    string
+	created  time.Time
+	logsCrit filters.FilterCriteria
+	logs     chan []*ethtypes.Log       // matching logs of the log subscriptions
+	eventCh  chan coretypes.ResultEvent // events of the block and pending transaction subscriptions
+	err      chan error                 // closed when the subscription is uninstalled
 }
 
 // ID returns the underlying subscription RPC identifier.
@@ -45,33 +41,26 @@ func (s Subscription) ID() rpc.ID {
 	return s.id
 }
 
-// Unsubscribe from the current subscription to Tendermint Websocket. It sends an error to the
-// subscription error channel if unsubscribe fails.
+// Unsubscribe removes the subscription from the event system, which closes its channels. The
+// shared Tendermint subscription is kept for the other subscriptions. It is safe to call it
+// more than once.
 func (s *Subscription) Unsubscribe(es *EventSystem) {
-	go func() {
-	uninstallLoop:
-		for {
-			// write uninstall request and consume logs/hashes. This prevents
-			// the eventLoop broadcast method to deadlock when writing to the
-			// filter event channel while the subscription loop is waiting for
-			// this method to return (and thus not reading these events).
-			select {
-			case es.uninstall <- s:
-				break uninstallLoop
-			case <-s.logs:
-			case <-s.hashes:
-			case <-s.headers:
-			}
-		}
-	}()
+	es.uninstall(s)
 }
 
-// Err returns the error channel
+// Err returns the error channel, which is closed when the subscription is uninstalled
 func (s *Subscription) Err() <-chan error {
 	return s.err
 }
 
-// Event returns the tendermint result event channel
+// Event returns the tendermint result event channel of the block and pending transaction
+// subscriptions
 func (s *Subscription) Event() <-chan coretypes.ResultEvent {
 	return s.eventCh
 }
+
+// Logs returns the channel of the logs matching the criteria of a log subscription, grouped by
+// transaction
+func (s *Subscription) Logs() <-chan []*ethtypes.Log {
+	return s.logs
+}
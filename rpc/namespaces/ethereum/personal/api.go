@@ -17,7 +17,9 @@ package personal
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"os"
 	"time"
 
@@ -36,9 +38,13 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 
+	rpctypes "github.com/evmos/ethermint/rpc/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
+// defaultUnlockDuration is the duration of an unlock if not specified
+const defaultUnlockDuration = 300 * time.Second
+
 // PrivateAccountAPI is the personal_ prefixed set of APIs in the Web3 JSON-RPC spec.
 type PrivateAccountAPI struct {
 	backend    backend.EVMBackend
@@ -76,6 +82,14 @@ func (api *PrivateAccountAPI) ImportRawKey(privkey, password string) (common.Add
 	return api.backend.ImportRawKey(privkey, password)
 }
 
+// ImportKeystore decrypts a given Ethereum keystore JSON key (Web3 Secret Storage, scrypt or pbkdf2)
+// with the password, then armors and encrypts the private key with the same password and stores it
+// into the key directory, with the key name format of ImportRawKey.
+func (api *PrivateAccountAPI) ImportKeystore(keyJSON, password string) (common.Address, error) {
+	api.logger.Debug("personal_importKeystore")
+	return api.backend.ImportKeystore(keyJSON, password)
+}
+
 // ListAccounts will return a list of addresses for accounts this node manages.
 func (api *PrivateAccountAPI) ListAccounts() ([]common.Address, error) {
 	api.logger.Debug("personal_listAccounts")
@@ -83,12 +97,10 @@ func (api *PrivateAccountAPI) ListAccounts() ([]common.Address, error) {
 }
 
 // LockAccount will lock the account associated with the given address when it's unlocked.
-// It removes the key corresponding to the given address from the API's local keys.
+// The accounts can only be locked with the file keyring backend, which encrypts the keys.
 func (api *PrivateAccountAPI) LockAccount(address common.Address) bool {
 	api.logger.Debug("personal_lockAccount", "address", address.String())
-	api.logger.Info("personal_lockAccount not supported")
-	// TODO: Not supported. See underlying issue  https://github.com/99designs/keyring/issues/85
-	return false
+	return api.backend.LockAccount(address)
 }
 
 // NewAccount will create a new account and returns the address for the new account.
@@ -118,19 +130,40 @@ func (api *PrivateAccountAPI) NewAccount(password string) (common.Address, error
 
 // UnlockAccount will unlock the account associated with the given address with
 // the given password for duration seconds. If duration is nil it will use a
-// default of 300 seconds. It returns an indication if the account was unlocked.
-func (api *PrivateAccountAPI) UnlockAccount(_ context.Context, addr common.Address, _ string, _ *uint64) (bool, error) {
+// default of 300 seconds, and zero unlocks the account indefinitely. It returns
+// an indication if the account was unlocked.
+//
+// The accounts are locked by default. The passphrase is only verified with the file keyring
+// backend, which encrypts the keys with it, and is ignored with the other backends.
+func (api *PrivateAccountAPI) UnlockAccount(_ context.Context, addr common.Address, password string, duration *uint64) (bool, error) {
 	api.logger.Debug("personal_unlockAccount", "address", addr.String())
-	// TODO: Not supported. See underlying issue  https://github.com/99designs/keyring/issues/85
-	return false, nil
+
+	const max = uint64(time.Duration(math.MaxInt64) / time.Second)
+	d := defaultUnlockDuration
+	if duration != nil {
+		if *duration > max {
+			return false, errors.New("unlock duration too large")
+		}
+		d = time.Duration(*duration) * time.Second
+	}
+
+	return api.backend.UnlockAccount(addr, password, d)
 }
 
 // SendTransaction will create a transaction from the given arguments and
-// tries to sign it with the key associated with args.To. If the given password isn't
+// tries to sign it with the key associated with args.From. If the given password isn't
 // able to decrypt the key it fails.
-func (api *PrivateAccountAPI) SendTransaction(_ context.Context, args evmtypes.TransactionArgs, _ string) (common.Hash, error) {
-	api.logger.Debug("personal_sendTransaction", "address", args.To.String())
-	return api.backend.SendTransaction(args)
+func (api *PrivateAccountAPI) SendTransaction(_ context.Context, args evmtypes.TransactionArgs, password string) (common.Hash, error) {
+	api.logger.Debug("personal_sendTransaction", "address", args.GetFrom().String())
+	return api.backend.SendTransactionWithPassphrase(args, password)
+}
+
+// SignTransaction will create a transaction from the given arguments and tries to sign it with
+// the key associated with args.From, without broadcasting it. It returns the RLP encoded signed
+// transaction and the transaction. If the given password isn't able to decrypt the key it fails.
+func (api *PrivateAccountAPI) SignTransaction(_ context.Context, args evmtypes.TransactionArgs, password string) (*rpctypes.SignTransactionResult, error) {
+	api.logger.Debug("personal_signTransaction", "address", args.GetFrom().String())
+	return api.backend.SignTransactionWithPassphrase(args, password)
 }
 
 // Sign calculates an Ethereum ECDSA signature for:
@@ -142,9 +175,9 @@ func (api *PrivateAccountAPI) SendTransaction(_ context.Context, args evmtypes.T
 // The key used to calculate the signature is decrypted with the given password.
 //
 // https://github.com/ethereum/go-ethereum/wiki/Management-APIs#personal_sign
-func (api *PrivateAccountAPI) Sign(_ context.Context, data hexutil.Bytes, addr common.Address, _ string) (hexutil.Bytes, error) {
+func (api *PrivateAccountAPI) Sign(_ context.Context, data hexutil.Bytes, addr common.Address, password string) (hexutil.Bytes, error) {
 	api.logger.Debug("personal_sign", "data", data, "address", addr.String())
-	return api.backend.Sign(addr, data)
+	return api.backend.SignWithPassphrase(addr, data, password)
 }
 
 // EcRecover returns the address for the account that was used to create the signature.
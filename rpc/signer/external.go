@@ -0,0 +1,196 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package signer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// dialTimeout is the timeout of the connection to the external signer
+const dialTimeout = 10 * time.Second
+
+var _ Signer = (*ExternalSigner)(nil)
+
+// ExternalSigner signs with an external signer implementing the account namespace of Clef
+// (account_list, account_signTransaction, account_signData and account_signTypedData), so that
+// the keys stay out of the node process. The signer is reached over its unix socket or its HTTP
+// endpoint, and the connection is opened on first use.
+type ExternalSigner struct {
+	endpoint string
+
+	mu     sync.Mutex
+	client *rpc.Client
+}
+
+// NewExternalSigner returns a signer calling the external signer at the given endpoint, either the
+// path of a unix socket or an HTTP URL.
+func NewExternalSigner(endpoint string) *ExternalSigner {
+	return &ExternalSigner{endpoint: endpoint}
+}
+
+// signTransactionResult is the response of account_signTransaction
+type signTransactionResult struct {
+	Raw hexutil.Bytes         `json:"raw"`
+	Tx  *ethtypes.Transaction `json:"tx"`
+}
+
+// Accounts implements Signer
+func (s *ExternalSigner) Accounts() ([]common.Address, error) {
+	addresses := make([]common.Address, 0) // return [] instead of nil if empty
+	if err := s.call(&addresses, "account_list"); err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+// CheckAccount implements Signer
+func (s *ExternalSigner) CheckAccount(address common.Address) error {
+	addresses, err := s.Accounts()
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range addresses {
+		if addr == address {
+			return nil
+		}
+	}
+	return fmt.Errorf("failed to find account %s in the external signer; %s", address.Hex(), accounts.ErrUnknownAccount)
+}
+
+// SignTx implements Signer. The signed transaction is checked against the requested one.
+func (s *ExternalSigner) SignTx(from common.Address, tx *ethtypes.Transaction, ethSigner ethtypes.Signer) (*ethtypes.Transaction, error) {
+	data := hexutil.Bytes(tx.Data())
+	var to *common.MixedcaseAddress
+	if tx.To() != nil {
+		addr := common.NewMixedcaseAddress(*tx.To())
+		to = &addr
+	}
+
+	args := &apitypes.SendTxArgs{
+		From:  common.NewMixedcaseAddress(from),
+		To:    to,
+		Gas:   hexutil.Uint64(tx.Gas()),
+		Value: hexutil.Big(*tx.Value()),
+		Nonce: hexutil.Uint64(tx.Nonce()),
+		Data:  &data,
+	}
+
+	switch tx.Type() {
+	case ethtypes.LegacyTxType, ethtypes.AccessListTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case ethtypes.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	default:
+		return nil, fmt.Errorf("unsupported tx type %d", tx.Type())
+	}
+
+	if chainID := ethSigner.ChainID(); chainID != nil && chainID.Sign() != 0 {
+		args.ChainID = (*hexutil.Big)(chainID)
+	}
+	if tx.Type() != ethtypes.LegacyTxType {
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	}
+
+	var res signTransactionResult
+	if err := s.call(&res, "account_signTransaction", args); err != nil {
+		return nil, err
+	}
+	if res.Tx == nil {
+		return nil, fmt.Errorf("the external signer returned no transaction")
+	}
+
+	// the signer may not alter the transaction
+	if ethSigner.Hash(res.Tx) != ethSigner.Hash(tx) {
+		return nil, fmt.Errorf("the external signer returned a different transaction")
+	}
+	sender, err := ethtypes.Sender(ethSigner, res.Tx)
+	if err != nil {
+		return nil, err
+	}
+	if sender != from {
+		return nil, fmt.Errorf("the transaction is signed by %s instead of %s", sender.Hex(), from.Hex())
+	}
+
+	return res.Tx, nil
+}
+
+// SignData implements Signer. The external signer signs the EIP-191 hash of the data as a personal
+// message, the text/plain content type of Clef.
+func (s *ExternalSigner) SignData(from common.Address, data []byte) ([]byte, error) {
+	var signature hexutil.Bytes
+	addr := common.NewMixedcaseAddress(from)
+	if err := s.call(&signature, "account_signData", accounts.MimetypeTextPlain, &addr, hexutil.Encode(data)); err != nil {
+		return nil, err
+	}
+	return legacySignature(signature)
+}
+
+// SignTypedData implements Signer
+func (s *ExternalSigner) SignTypedData(from common.Address, typedData apitypes.TypedData) ([]byte, error) {
+	var signature hexutil.Bytes
+	addr := common.NewMixedcaseAddress(from)
+	if err := s.call(&signature, "account_signTypedData", &addr, typedData); err != nil {
+		return nil, err
+	}
+	return legacySignature(signature)
+}
+
+// call calls a method of the external signer, connecting to it first if needed.
+func (s *ExternalSigner) call(result interface{}, method string, args ...interface{}) error {
+	s.mu.Lock()
+	if s.client == nil {
+		ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+		client, err := rpc.DialContext(ctx, s.endpoint)
+		cancel()
+		if err != nil {
+			s.mu.Unlock()
+			return fmt.Errorf("failed to connect to the external signer: %w", err)
+		}
+		s.client = client
+	}
+	client := s.client
+	s.mu.Unlock()
+
+	if err := client.Call(result, method, args...); err != nil {
+		return fmt.Errorf("external signer: %w", err)
+	}
+	return nil
+}
+
+// legacySignature checks the length of the signature and sets V to 27 or 28.
+func legacySignature(signature []byte) ([]byte, error) {
+	if len(signature) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid signature length %d from the external signer", len(signature))
+	}
+	if signature[crypto.RecoveryIDOffset] < 27 {
+		signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
+	}
+	return signature, nil
+}
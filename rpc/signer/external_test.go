@@ -0,0 +1,166 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"net"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClef implements the account namespace of Clef with a single key.
+type fakeClef struct {
+	key *ecdsa.PrivateKey
+	// tamper alters the transactions before signing them
+	tamper bool
+}
+
+func (c *fakeClef) List(_ context.Context) ([]common.Address, error) {
+	return []common.Address{crypto.PubkeyToAddress(c.key.PublicKey)}, nil
+}
+
+func (c *fakeClef) SignTransaction(_ context.Context, args apitypes.SendTxArgs, _ *string) (*signTransactionResult, error) {
+	if c.tamper {
+		args.Nonce++
+	}
+	tx, err := ethtypes.SignTx(args.ToTransaction(), ethtypes.LatestSignerForChainID(args.ChainID.ToInt()), c.key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &signTransactionResult{Raw: raw, Tx: tx}, nil
+}
+
+func (c *fakeClef) SignData(_ context.Context, contentType string, _ common.MixedcaseAddress, data interface{}) (hexutil.Bytes, error) {
+	if contentType != accounts.MimetypeTextPlain {
+		return nil, accounts.ErrNotSupported
+	}
+	return c.sign(accounts.TextHash(hexutil.MustDecode(data.(string))))
+}
+
+func (c *fakeClef) SignTypedData(_ context.Context, _ common.MixedcaseAddress, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return c.sign(sigHash)
+}
+
+func (c *fakeClef) sign(hash []byte) (hexutil.Bytes, error) {
+	signature, err := crypto.Sign(hash, c.key)
+	if err != nil {
+		return nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+// startFakeClef serves the fake signer over HTTP and over a unix socket, and returns both endpoints.
+func startFakeClef(t *testing.T, clef *fakeClef) (string, string) {
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("account", clef))
+	t.Cleanup(srv.Stop)
+
+	httpSrv := httptest.NewServer(srv)
+	t.Cleanup(httpSrv.Close)
+
+	socket := filepath.Join(t.TempDir(), "clef.ipc")
+	ln, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	go func() {
+		_ = srv.ServeListener(ln)
+	}()
+	t.Cleanup(func() { ln.Close() })
+
+	return httpSrv.URL, socket
+}
+
+func TestExternalSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	chainID := big.NewInt(9000)
+	ethSigner := ethtypes.LatestSignerForChainID(chainID)
+
+	clef := &fakeClef{key: key}
+	httpURL, socket := startFakeClef(t, clef)
+
+	for _, endpoint := range []string{httpURL, socket} {
+		s := NewExternalSigner(endpoint)
+
+		addresses, err := s.Accounts()
+		require.NoError(t, err)
+		require.Equal(t, []common.Address{from}, addresses)
+
+		require.NoError(t, s.CheckAccount(from))
+		require.ErrorContains(t, s.CheckAccount(to), accounts.ErrUnknownAccount.Error())
+
+		txs := []*ethtypes.Transaction{
+			ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+				ChainID: chainID, Nonce: 1, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10), Gas: 21000, To: &to, Value: big.NewInt(5),
+			}),
+			ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 2, GasPrice: big.NewInt(10), Gas: 100000, Data: []byte{0x60, 0x00}}),
+		}
+		for _, tx := range txs {
+			signed, err := s.SignTx(from, tx, ethSigner)
+			require.NoError(t, err)
+			require.Equal(t, ethSigner.Hash(tx), ethSigner.Hash(signed))
+			sender, err := ethtypes.Sender(ethSigner, signed)
+			require.NoError(t, err)
+			require.Equal(t, from, sender)
+		}
+
+		// the data is signed as a personal message
+		data := []byte("hello")
+		signature, err := s.SignData(from, data)
+		require.NoError(t, err)
+		require.Contains(t, []byte{27, 28}, signature[crypto.RecoveryIDOffset])
+		signature[crypto.RecoveryIDOffset] -= 27
+		pubKey, err := crypto.SigToPub(accounts.TextHash(data), signature)
+		require.NoError(t, err)
+		require.Equal(t, from, crypto.PubkeyToAddress(*pubKey))
+
+		typedData := apitypes.TypedData{
+			Types: apitypes.Types{
+				"EIP712Domain": {{Name: "name", Type: "string"}, {Name: "chainId", Type: "uint256"}},
+				"Mail":         {{Name: "contents", Type: "string"}},
+			},
+			PrimaryType: "Mail",
+			Domain:      apitypes.TypedDataDomain{Name: "test", ChainId: (*math.HexOrDecimal256)(chainID)},
+			Message:     apitypes.TypedDataMessage{"contents": "hello"},
+		}
+		signature, err = s.SignTypedData(from, typedData)
+		require.NoError(t, err)
+		sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+		require.NoError(t, err)
+		signature[crypto.RecoveryIDOffset] -= 27
+		pubKey, err = crypto.SigToPub(sigHash, signature)
+		require.NoError(t, err)
+		require.Equal(t, from, crypto.PubkeyToAddress(*pubKey))
+	}
+
+	// the transactions altered by the signer are rejected
+	clef.tamper = true
+	_, err = NewExternalSigner(httpURL).SignTx(from, ethtypes.NewTx(&ethtypes.LegacyTx{GasPrice: big.NewInt(10), Gas: 21000, To: &to}), ethSigner)
+	require.ErrorContains(t, err, "different transaction")
+
+	// the connection errors are returned
+	_, err = NewExternalSigner(filepath.Join(t.TempDir(), "missing.ipc")).Accounts()
+	require.ErrorContains(t, err, "failed to connect to the external signer")
+}
@@ -0,0 +1,197 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package signer
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/evmos/ethermint/crypto/hd"
+)
+
+// PassphraseVerifier returns an error if the passphrase doesn't unlock the key of the account.
+type PassphraseVerifier func(address common.Address, passphrase string) error
+
+// KeyringPassphraseVerifier returns a verifier decrypting the key of the account in the keyring of
+// the file backend stored in the given directory.
+func KeyringPassphraseVerifier(dir string, cdc codec.Codec) PassphraseVerifier {
+	return func(address common.Address, passphrase string) error {
+		// the passphrase is read once, so a wrong passphrase fails on the next prompts
+		kr, err := keyring.New(
+			sdk.KeyringServiceName(), keyring.BackendFile, dir, strings.NewReader(passphrase+"\n"), cdc, hd.EthSecp256k1Option(),
+		)
+		if err != nil {
+			return err
+		}
+
+		if _, err := kr.KeyByAddress(sdk.AccAddress(address.Bytes())); err != nil {
+			return fmt.Errorf("could not decrypt key with given password")
+		}
+		return nil
+	}
+}
+
+var _ Signer = (*LockingSigner)(nil)
+
+// LockingSigner wraps a signer to only sign for the unlocked accounts. The accounts are locked by
+// default, and are unlocked with their passphrase for a given duration.
+type LockingSigner struct {
+	Signer
+	verify PassphraseVerifier
+
+	mu sync.Mutex
+	// unlocked maps the unlocked accounts to the end of their unlock, zero if unlocked indefinitely
+	unlocked map[common.Address]time.Time
+}
+
+// NewLockingSigner returns a signer signing with the given signer for the unlocked accounts, and
+// verifying their passphrase with the verifier. The passphrases aren't verified if the verifier is
+// nil, for the keyrings whose keys aren't encrypted with a passphrase the node can verify.
+func NewLockingSigner(signer Signer, verify PassphraseVerifier) *LockingSigner {
+	return &LockingSigner{
+		Signer:   signer,
+		verify:   verify,
+		unlocked: make(map[common.Address]time.Time),
+	}
+}
+
+// Unlock unlocks the account for the given duration, or indefinitely if the duration is zero, if
+// the passphrase is valid.
+func (s *LockingSigner) Unlock(address common.Address, passphrase string, duration time.Duration) error {
+	if err := s.verifyAccount(address, passphrase); err != nil {
+		return err
+	}
+
+	var end time.Time
+	if duration > 0 {
+		end = time.Now().Add(duration)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.unlocked[address] = end
+	return nil
+}
+
+// UnlockAccounts unlocks all the accounts of the signer indefinitely, without their passphrase.
+func (s *LockingSigner) UnlockAccounts() error {
+	addresses, err := s.Signer.Accounts()
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, address := range addresses {
+		s.unlocked[address] = time.Time{}
+	}
+	return nil
+}
+
+// Lock locks the account, and returns false if it wasn't unlocked.
+func (s *LockingSigner) Lock(address common.Address) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	unlocked := s.isUnlocked(address)
+	delete(s.unlocked, address)
+	return unlocked
+}
+
+// WithPassphrase returns the signer of the account, whether it is locked or not, if the passphrase
+// is valid.
+func (s *LockingSigner) WithPassphrase(address common.Address, passphrase string) (Signer, error) {
+	if err := s.verifyAccount(address, passphrase); err != nil {
+		return nil, err
+	}
+	return s.Signer, nil
+}
+
+// CheckAccount implements Signer. The locked accounts are refused.
+func (s *LockingSigner) CheckAccount(address common.Address) error {
+	if err := s.Signer.CheckAccount(address); err != nil {
+		return err
+	}
+	return s.checkUnlocked(address)
+}
+
+// SignTx implements Signer
+func (s *LockingSigner) SignTx(from common.Address, tx *ethtypes.Transaction, ethSigner ethtypes.Signer) (*ethtypes.Transaction, error) {
+	if err := s.checkUnlocked(from); err != nil {
+		return nil, err
+	}
+	return s.Signer.SignTx(from, tx, ethSigner)
+}
+
+// SignData implements Signer
+func (s *LockingSigner) SignData(from common.Address, data []byte) ([]byte, error) {
+	if err := s.checkUnlocked(from); err != nil {
+		return nil, err
+	}
+	return s.Signer.SignData(from, data)
+}
+
+// SignTypedData implements Signer
+func (s *LockingSigner) SignTypedData(from common.Address, typedData apitypes.TypedData) ([]byte, error) {
+	if err := s.checkUnlocked(from); err != nil {
+		return nil, err
+	}
+	return s.Signer.SignTypedData(from, typedData)
+}
+
+// verifyAccount returns an error if the signer doesn't manage the account or if the passphrase is
+// invalid, when it can be verified.
+func (s *LockingSigner) verifyAccount(address common.Address, passphrase string) error {
+	if err := s.Signer.CheckAccount(address); err != nil {
+		return err
+	}
+	if s.verify == nil {
+		return nil
+	}
+	return s.verify(address, passphrase)
+}
+
+// checkUnlocked returns an error if the account is locked.
+func (s *LockingSigner) checkUnlocked(address common.Address) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isUnlocked(address) {
+		return fmt.Errorf("account %s is locked; %w", address.Hex(), keystore.ErrLocked)
+	}
+	return nil
+}
+
+// isUnlocked returns true if the account is unlocked, and forgets the expired unlocks. The mutex
+// must be held.
+func (s *LockingSigner) isUnlocked(address common.Address) bool {
+	end, ok := s.unlocked[address]
+	if ok && !end.IsZero() && !time.Now().Before(end) {
+		delete(s.unlocked, address)
+		return false
+	}
+	return ok
+}
@@ -0,0 +1,107 @@
+package signer
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/crypto/hd"
+	"github.com/evmos/ethermint/encoding"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+func TestLockingSigner(t *testing.T) {
+	encCfg := encoding.MakeConfig(nil)
+	dir := t.TempDir()
+
+	// the passphrase of a new file keyring is entered twice
+	kr, err := keyring.New(
+		sdk.KeyringServiceName(), keyring.BackendFile, dir, strings.NewReader("password1\npassword1\n"), encCfg.Codec, hd.EthSecp256k1Option(),
+	)
+	require.NoError(t, err)
+	record, _, err := kr.NewMnemonic("key", keyring.English, ethermint.BIP44HDPath, keyring.DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+	from := common.BytesToAddress(addr)
+	other := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	s := NewLockingSigner(NewKeyringSigner(kr), KeyringPassphraseVerifier(dir, encCfg.Codec))
+
+	chainID := big.NewInt(9000)
+	ethSigner := ethtypes.LatestSignerForChainID(chainID)
+	tx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{ChainID: chainID, Gas: 21000, To: &other})
+
+	// the accounts are locked by default
+	_, err = s.SignTx(from, tx, ethSigner)
+	require.ErrorIs(t, err, keystore.ErrLocked)
+	_, err = s.SignData(from, []byte("hello"))
+	require.ErrorIs(t, err, keystore.ErrLocked)
+	require.ErrorIs(t, s.CheckAccount(from), keystore.ErrLocked)
+	require.False(t, s.Lock(from))
+
+	// the passphrase is verified
+	require.Error(t, s.Unlock(from, "password2", 0))
+	require.Error(t, s.Unlock(other, "password1", 0))
+	_, err = s.WithPassphrase(from, "password2")
+	require.Error(t, err)
+
+	unlocked, err := s.WithPassphrase(from, "password1")
+	require.NoError(t, err)
+	_, err = unlocked.SignTx(from, tx, ethSigner)
+	require.NoError(t, err)
+
+	// the account is unlocked indefinitely until locked
+	require.NoError(t, s.Unlock(from, "password1", 0))
+	signed, err := s.SignTx(from, tx, ethSigner)
+	require.NoError(t, err)
+	sender, err := ethtypes.Sender(ethSigner, signed)
+	require.NoError(t, err)
+	require.Equal(t, from, sender)
+	require.True(t, s.Lock(from))
+	_, err = s.SignData(from, []byte("hello"))
+	require.ErrorIs(t, err, keystore.ErrLocked)
+
+	// the account is locked again at the end of the unlock
+	require.NoError(t, s.Unlock(from, "password1", 100*time.Millisecond))
+	_, err = s.SignData(from, []byte("hello"))
+	require.NoError(t, err)
+	time.Sleep(150 * time.Millisecond)
+	_, err = s.SignData(from, []byte("hello"))
+	require.ErrorIs(t, err, keystore.ErrLocked)
+	require.False(t, s.Lock(from))
+}
+
+func TestLockingSignerWithoutVerifier(t *testing.T) {
+	encCfg := encoding.MakeConfig(nil)
+	kr := keyring.NewInMemory(encCfg.Codec, hd.EthSecp256k1Option())
+	record, _, err := kr.NewMnemonic("key", keyring.English, ethermint.BIP44HDPath, keyring.DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+	from := common.BytesToAddress(addr)
+
+	s := NewLockingSigner(NewKeyringSigner(kr), nil)
+
+	// the accounts are locked by default, and unlocked without verifying the passphrase
+	_, err = s.SignData(from, []byte("hello"))
+	require.ErrorIs(t, err, keystore.ErrLocked)
+	require.NoError(t, s.Unlock(from, "any", 0))
+	_, err = s.SignData(from, []byte("hello"))
+	require.NoError(t, err)
+	require.Error(t, s.Unlock(common.HexToAddress("0x2222222222222222222222222222222222222222"), "any", 0))
+
+	// all the accounts of the signer are unlocked at once
+	require.True(t, s.Lock(from))
+	require.NoError(t, s.UnlockAccounts())
+	_, err = s.SignData(from, []byte("hello"))
+	require.NoError(t, err)
+}
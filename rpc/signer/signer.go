@@ -0,0 +1,128 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package signer
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// Signer signs the transactions and the data of the accounts it manages on behalf of the JSON-RPC
+// signing methods. The signatures are returned in the [R || S || V] format with V equal to 27 or 28.
+type Signer interface {
+	// Accounts returns the addresses of the accounts managed by the signer.
+	Accounts() ([]common.Address, error)
+	// CheckAccount returns an error if the signer doesn't manage the account.
+	CheckAccount(address common.Address) error
+	// SignTx returns the transaction signed by the sender with the given Ethereum signer.
+	SignTx(from common.Address, tx *ethtypes.Transaction, ethSigner ethtypes.Signer) (*ethtypes.Transaction, error)
+	// SignData returns the signature of the data by the account, as computed by eth_sign.
+	SignData(from common.Address, data []byte) ([]byte, error)
+	// SignTypedData returns the signature of the EIP-712 typed data by the account.
+	SignTypedData(from common.Address, typedData apitypes.TypedData) ([]byte, error)
+}
+
+var _ Signer = (*KeyringSigner)(nil)
+
+// KeyringSigner signs with the eth_secp256k1 keys of the node keyring.
+type KeyringSigner struct {
+	keyring keyring.Keyring
+}
+
+// NewKeyringSigner returns a signer using the keys of the keyring.
+func NewKeyringSigner(kr keyring.Keyring) *KeyringSigner {
+	return &KeyringSigner{keyring: kr}
+}
+
+// Accounts implements Signer
+func (s *KeyringSigner) Accounts() ([]common.Address, error) {
+	addresses := make([]common.Address, 0) // return [] instead of nil if empty
+
+	infos, err := s.keyring.List()
+	if err != nil {
+		return addresses, err
+	}
+
+	for _, info := range infos {
+		pubKey, err := info.GetPubKey()
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, common.BytesToAddress(pubKey.Address().Bytes()))
+	}
+
+	return addresses, nil
+}
+
+// CheckAccount implements Signer
+func (s *KeyringSigner) CheckAccount(address common.Address) error {
+	if _, err := s.keyring.KeyByAddress(sdk.AccAddress(address.Bytes())); err != nil {
+		return fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
+	}
+	return nil
+}
+
+// SignTx implements Signer
+func (s *KeyringSigner) SignTx(from common.Address, tx *ethtypes.Transaction, ethSigner ethtypes.Signer) (*ethtypes.Transaction, error) {
+	sig, _, err := s.keyring.SignByAddress(sdk.AccAddress(from.Bytes()), ethSigner.Hash(tx).Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.WithSignature(ethSigner, sig)
+}
+
+// SignData implements Signer. The data is hashed with keccak256 unless it is a 32 bytes digest.
+func (s *KeyringSigner) SignData(from common.Address, data []byte) ([]byte, error) {
+	if err := s.CheckAccount(from); err != nil {
+		return nil, err
+	}
+
+	signature, _, err := s.keyring.SignByAddress(sdk.AccAddress(from.Bytes()), data)
+	if err != nil {
+		return nil, err
+	}
+
+	signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
+	return signature, nil
+}
+
+// SignTypedData implements Signer
+func (s *KeyringSigner) SignTypedData(from common.Address, typedData apitypes.TypedData) ([]byte, error) {
+	if err := s.CheckAccount(from); err != nil {
+		return nil, err
+	}
+
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, _, err := s.keyring.SignByAddress(sdk.AccAddress(from.Bytes()), sigHash)
+	if err != nil {
+		return nil, err
+	}
+
+	signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
+	return signature, nil
+}
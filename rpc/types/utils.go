@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -248,6 +249,26 @@ func BaseFeeFromEvents(events []abci.Event) *big.Int {
 	return nil
 }
 
+// BlockTimeFromEvents returns the block time of the EVM, in seconds, which the dev node reports in the
+// begin block events when it overrides the time of the header.
+func BlockTimeFromEvents(header tmtypes.Header, events []abci.Event) uint64 {
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeDevBlock {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if bytes.Equal(attr.Key, []byte(evmtypes.AttributeKeyTimestamp)) {
+				timestamp, err := strconv.ParseUint(string(attr.Value), 10, 64)
+				if err == nil {
+					return timestamp
+				}
+			}
+		}
+	}
+	return uint64(header.Time.UTC().Unix())
+}
+
 // CheckTxFee is an internal function used to check whether the fee of
 // the given transaction is _reasonable_(under the cap).
 func CheckTxFee(gasPrice *big.Int, gas uint64, cap float64) error {
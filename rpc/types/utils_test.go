@@ -0,0 +1,28 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestBlockTimeFromEvents(t *testing.T) {
+	header := tmtypes.Header{Time: time.Unix(1000, 0)}
+
+	require.Equal(t, uint64(1000), BlockTimeFromEvents(header, nil))
+
+	events := []abci.Event{
+		{Type: "mint"},
+		{Type: evmtypes.EventTypeDevBlock, Attributes: []abci.EventAttribute{
+			{Key: []byte(evmtypes.AttributeKeyTimestamp), Value: []byte("4000000000")},
+		}},
+	}
+	require.Equal(t, uint64(4000000000), BlockTimeFromEvents(header, events))
+
+	events[1].Attributes[0].Value = []byte("invalid")
+	require.Equal(t, uint64(1000), BlockTimeFromEvents(header, events))
+}
@@ -0,0 +1,302 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+
+// Package upstream implements the failover between the upstream nodes of a JSON-RPC gateway. The
+// nodes are health-checked periodically, the calls are routed to the healthy nodes that have the
+// state of the requested height and fail over to the next node on connection errors.
+package upstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/tendermint/tendermint/libs/service"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+)
+
+const (
+	// DefaultHealthCheckInterval is the default interval between the health checks of the nodes
+	DefaultHealthCheckInterval = 5 * time.Second
+	// DefaultMaxHeightLag is the default number of blocks a node can lag behind the highest node
+	DefaultMaxHeightLag = 5
+
+	healthCheckTimeout = 5 * time.Second
+)
+
+var (
+	errNotChecked = errors.New("node not checked yet")
+	errNotStarted = errors.New("upstream client not started")
+)
+
+// Config defines the health checks of the upstream nodes.
+type Config struct {
+	// HealthCheckInterval is the interval between the status queries of the nodes
+	HealthCheckInterval time.Duration
+	// MaxHeightLag is the number of blocks a node can lag behind the highest node and stay healthy
+	MaxHeightLag int64
+}
+
+// DefaultConfig returns the default health check configuration.
+func DefaultConfig() Config {
+	return Config{
+		HealthCheckInterval: DefaultHealthCheckInterval,
+		MaxHeightLag:        DefaultMaxHeightLag,
+	}
+}
+
+// nodeStatus is the state of a node known from the last health check and calls.
+type nodeStatus struct {
+	latest     int64
+	earliest   int64
+	catchingUp bool
+	err        error
+}
+
+// Node is an upstream node, reached through its Tendermint RPC endpoint.
+type Node struct {
+	address string
+	client  rpcclient.Client
+
+	mu     sync.RWMutex
+	status nodeStatus
+}
+
+// Address returns the Tendermint RPC address of the node.
+func (n *Node) Address() string {
+	return n.address
+}
+
+func (n *Node) getStatus() nodeStatus {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	return n.status
+}
+
+func (n *Node) setStatus(status nodeStatus) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.status = status
+}
+
+// fail marks the node as unhealthy until the next successful health check.
+func (n *Node) fail(err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.status.err = err
+}
+
+// Client is a Tendermint RPC client over a set of upstream nodes. Each call is sent to the first
+// healthy node, in the configured order, that has the requested height and fails over to the
+// next nodes on connection errors. The event subscriptions are served over a websocket connection
+// to the first reachable node, which fails over to the next reachable node when it is lost.
+type Client struct {
+	service.BaseService
+
+	cfg    Config
+	nodes  []*Node
+	events *eventClient
+	quit   chan struct{}
+}
+
+var _ rpcclient.Client = (*Client)(nil)
+
+// NewClient creates a client over the nodes at the given Tendermint RPC addresses.
+func NewClient(cfg Config, addresses []string) (*Client, error) {
+	if len(addresses) == 0 {
+		return nil, errors.New("no upstream node")
+	}
+
+	nodes := make([]*Node, len(addresses))
+	for i, address := range addresses {
+		nodeClient, err := client.NewClientFromNode(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid upstream node %s: %w", address, err)
+		}
+		nodes[i] = &Node{address: address, client: nodeClient}
+	}
+
+	return newClient(cfg, nodes), nil
+}
+
+func newClient(cfg Config, nodes []*Node) *Client {
+	if cfg.HealthCheckInterval <= 0 {
+		cfg.HealthCheckInterval = DefaultHealthCheckInterval
+	}
+
+	for _, n := range nodes {
+		n.status.err = errNotChecked
+	}
+
+	c := &Client{cfg: cfg, nodes: nodes}
+	c.BaseService = *service.NewBaseService(nil, "UpstreamClient", c)
+	return c
+}
+
+// Nodes returns the upstream nodes.
+func (c *Client) Nodes() []*Node {
+	return c.nodes
+}
+
+// OnStart implements service.Service by checking the health of the nodes and starting the
+// periodic health checks.
+func (c *Client) OnStart() error {
+	c.quit = make(chan struct{})
+	c.checkHealth()
+
+	events, err := newEventClient(c)
+	if err != nil {
+		return err
+	}
+	if err := events.start(); err != nil {
+		return err
+	}
+	c.events = events
+
+	go func() {
+		ticker := time.NewTicker(c.cfg.HealthCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				c.checkHealth()
+			case <-c.quit:
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// OnStop implements service.Service
+func (c *Client) OnStop() {
+	close(c.quit)
+	if err := c.events.stop(); err != nil {
+		c.Logger.Error("failed to stop the upstream event client", "error", err.Error())
+	}
+}
+
+// checkHealth queries the status of all the nodes concurrently.
+func (c *Client) checkHealth() {
+	var wg sync.WaitGroup
+	for _, n := range c.nodes {
+		wg.Add(1)
+		go func(n *Node) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+			defer cancel()
+
+			var status nodeStatus
+			res, err := n.client.Status(ctx)
+			if err != nil {
+				status.err = err
+			} else {
+				status.latest = res.SyncInfo.LatestBlockHeight
+				status.earliest = res.SyncInfo.EarliestBlockHeight
+				status.catchingUp = res.SyncInfo.CatchingUp
+			}
+
+			prev := n.getStatus()
+			n.setStatus(status)
+
+			switch {
+			case status.err != nil && prev.err == nil:
+				c.Logger.Error("upstream node is unreachable", "address", n.address, "error", status.err.Error())
+			case status.err == nil && prev.err != nil:
+				c.Logger.Info("upstream node is reachable", "address", n.address, "height", status.latest)
+			}
+		}(n)
+	}
+	wg.Wait()
+}
+
+// candidates returns the nodes ordered by preference to serve a query at the given height (0 =
+// latest): first the healthy nodes that have the height, then the healthy nodes that may have
+// reached it since the last health check and last the other nodes.
+func (c *Client) candidates(height int64) []*Node {
+	statuses := make([]nodeStatus, len(c.nodes))
+	highest := int64(0)
+	for i, n := range c.nodes {
+		statuses[i] = n.getStatus()
+		if statuses[i].err == nil && !statuses[i].catchingUp && statuses[i].latest > highest {
+			highest = statuses[i].latest
+		}
+	}
+
+	tiers := make(map[*Node]int, len(c.nodes))
+	for i, n := range c.nodes {
+		status := statuses[i]
+		switch {
+		case status.err != nil, status.catchingUp, status.latest < highest-c.cfg.MaxHeightLag:
+			// unhealthy
+			tiers[n] = 2
+		case height > 0 && height < status.earliest:
+			// pruned
+			tiers[n] = 2
+		case height > status.latest:
+			tiers[n] = 1
+		default:
+			tiers[n] = 0
+		}
+	}
+
+	nodes := make([]*Node, len(c.nodes))
+	copy(nodes, c.nodes)
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return tiers[nodes[i]] < tiers[nodes[j]]
+	})
+	return nodes
+}
+
+// do calls fn on the candidate nodes for the given height until it doesn't fail with a connection
+// error. The errors returned by the nodes themselves are not retried.
+func (c *Client) do(ctx context.Context, height int64, fn func(rpcclient.Client) error) error {
+	var err error
+	for _, n := range c.candidates(height) {
+		err = fn(n.client)
+		if err == nil || !isConnectionError(err) || ctx.Err() != nil {
+			return err
+		}
+
+		c.Logger.Debug("upstream node call failed, failing over", "address", n.address, "error", err.Error())
+		n.fail(err)
+	}
+	return err
+}
+
+// isConnectionError returns true if the error isn't a JSON-RPC error returned by the node.
+func isConnectionError(err error) bool {
+	var rpcErr *rpctypes.RPCError
+	return !errors.As(err, &rpcErr)
+}
+
+func heightOf(height *int64) int64 {
+	if height == nil {
+		return 0
+	}
+	return *height
+}
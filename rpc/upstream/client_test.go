@@ -0,0 +1,138 @@
+package upstream
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/evmos/ethermint/rpc/backend/mocks"
+)
+
+func newTestClient(t *testing.T, n int) (*Client, []*mocks.Client) {
+	nodes := make([]*Node, n)
+	clients := make([]*mocks.Client, n)
+	for i := range nodes {
+		clients[i] = mocks.NewClient(t)
+		nodes[i] = &Node{address: string(rune('a' + i)), client: clients[i]}
+	}
+	return newClient(Config{MaxHeightLag: 2}, nodes), clients
+}
+
+func registerStatus(client *mocks.Client, earliest, latest int64, catchingUp bool) {
+	client.On("Status", mock.Anything).Return(&ctypes.ResultStatus{
+		SyncInfo: ctypes.SyncInfo{
+			EarliestBlockHeight: earliest,
+			LatestBlockHeight:   latest,
+			CatchingUp:          catchingUp,
+		},
+	}, nil).Once()
+}
+
+func addresses(nodes []*Node) []string {
+	res := make([]string, len(nodes))
+	for i, n := range nodes {
+		res[i] = n.address
+	}
+	return res
+}
+
+func TestCandidates(t *testing.T) {
+	c, clients := newTestClient(t, 4)
+	registerStatus(clients[0], 50, 100, false) // pruned
+	registerStatus(clients[1], 1, 97, false)   // lagging
+	registerStatus(clients[2], 1, 99, false)
+	registerStatus(clients[3], 1, 100, true) // catching up
+	c.checkHealth()
+
+	require.Equal(t, []string{"a", "c", "b", "d"}, addresses(c.candidates(0)))
+	require.Equal(t, []string{"c", "a", "b", "d"}, addresses(c.candidates(10)))
+	// the node may have reached the height since the last health check
+	require.Equal(t, []string{"a", "c", "b", "d"}, addresses(c.candidates(100)))
+
+	c.nodes[0].fail(errors.New("connection refused"))
+	require.Equal(t, []string{"b", "c", "a", "d"}, addresses(c.candidates(0)))
+
+	// the nodes recover at the next health check
+	registerStatus(clients[0], 50, 101, false)
+	registerStatus(clients[1], 1, 101, false)
+	registerStatus(clients[2], 1, 101, false)
+	clients[3].On("Status", mock.Anything).Return(nil, errors.New("connection refused")).Once()
+	c.checkHealth()
+	require.Equal(t, []string{"a", "b", "c", "d"}, addresses(c.candidates(0)))
+}
+
+func TestFailover(t *testing.T) {
+	height := int64(10)
+	block := &ctypes.ResultBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: height}}}
+
+	testCases := []struct {
+		name     string
+		malleate func(clients []*mocks.Client)
+		expNode  int
+		expErr   bool
+	}{
+		{
+			"first node",
+			func(clients []*mocks.Client) {
+				clients[0].On("Block", mock.Anything, &height).Return(block, nil).Once()
+			},
+			0,
+			false,
+		},
+		{
+			"fail over on connection error",
+			func(clients []*mocks.Client) {
+				clients[0].On("Block", mock.Anything, &height).Return(nil, errors.New("post failed: connection refused")).Once()
+				clients[1].On("Block", mock.Anything, &height).Return(block, nil).Once()
+			},
+			1,
+			false,
+		},
+		{
+			"node error not retried",
+			func(clients []*mocks.Client) {
+				clients[0].On("Block", mock.Anything, &height).Return(nil, &rpctypes.RPCError{Code: -32603, Message: "Internal error"}).Once()
+			},
+			0,
+			true,
+		},
+		{
+			"all nodes unreachable",
+			func(clients []*mocks.Client) {
+				clients[0].On("Block", mock.Anything, &height).Return(nil, errors.New("post failed: connection refused")).Once()
+				clients[1].On("Block", mock.Anything, &height).Return(nil, errors.New("post failed: connection refused")).Once()
+			},
+			0,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, clients := newTestClient(t, 2)
+			registerStatus(clients[0], 1, 100, false)
+			registerStatus(clients[1], 1, 100, false)
+			c.checkHealth()
+			tc.malleate(clients)
+
+			res, err := c.Block(context.Background(), &height)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, block, res)
+			for i, n := range c.nodes {
+				// the nodes tried before the serving one are unhealthy
+				require.Equal(t, i < tc.expNode, n.getStatus().err != nil)
+			}
+		})
+	}
+}
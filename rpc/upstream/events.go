@@ -0,0 +1,219 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package upstream
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	jsonrpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
+)
+
+const (
+	// wsEndpoint is the websocket endpoint of the Tendermint RPC server
+	wsEndpoint = "/websocket"
+
+	dialTimeout      = 5 * time.Second
+	subscribeTimeout = 10 * time.Second
+)
+
+// Dial connects to the first reachable node, in the order of preference of the calls at the latest
+// height. The network and address are ignored, so that the client can be set as the dialer of a
+// Tendermint websocket client to make its connection, and reconnections, fail over between the
+// nodes. The websocket URL, and thus the host verified over TLS, remains the one of the first node.
+func (c *Client) Dial(_, _ string) (net.Conn, error) {
+	var err error
+	for _, n := range c.candidates(0) {
+		var conn net.Conn
+		if conn, err = n.dial(); err == nil {
+			return conn, nil
+		}
+
+		c.Logger.Debug("upstream node dial failed, failing over", "address", n.address, "error", err.Error())
+		n.fail(err)
+	}
+	return nil, err
+}
+
+// dial opens a connection to the Tendermint RPC endpoint of the node.
+func (n *Node) dial() (net.Conn, error) {
+	address := n.address
+	if !strings.Contains(address, "://") {
+		address = "tcp://" + address
+	}
+
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case "tcp", "http", "https", "ws", "wss":
+		return net.DialTimeout("tcp", u.Host, dialTimeout)
+	case "unix":
+		return net.DialTimeout("unix", u.Host+u.Path, dialTimeout)
+	default:
+		return nil, fmt.Errorf("unsupported protocol %s of node %s", u.Scheme, n.address)
+	}
+}
+
+// eventClient serves the event subscriptions of the client over a websocket connection to the
+// upstream nodes. When the connection is lost, it reconnects to the preferred reachable node and
+// subscribes again to the events, the events emitted in between are missed.
+type eventClient struct {
+	logger log.Logger
+	ws     *jsonrpcclient.WSClient
+
+	mu            sync.RWMutex
+	subscriptions map[string]chan ctypes.ResultEvent
+}
+
+func newEventClient(c *Client) (*eventClient, error) {
+	e := &eventClient{
+		logger:        c.Logger,
+		subscriptions: make(map[string]chan ctypes.ResultEvent),
+	}
+
+	ws, err := jsonrpcclient.NewWS(c.nodes[0].address, wsEndpoint, jsonrpcclient.OnReconnect(e.resubscribe))
+	if err != nil {
+		return nil, err
+	}
+	ws.Dialer = c.Dial
+	ws.SetLogger(c.Logger)
+	e.ws = ws
+	return e, nil
+}
+
+func (e *eventClient) start() error {
+	if err := e.ws.Start(); err != nil {
+		return err
+	}
+
+	go e.dispatch()
+	return nil
+}
+
+func (e *eventClient) stop() error {
+	return e.ws.Stop()
+}
+
+func (e *eventClient) subscribe(ctx context.Context, query string, outCapacity ...int) (<-chan ctypes.ResultEvent, error) {
+	if err := e.ws.Subscribe(ctx, query); err != nil {
+		return nil, err
+	}
+
+	outCap := 1
+	if len(outCapacity) > 0 && outCapacity[0] >= 0 {
+		outCap = outCapacity[0]
+	}
+
+	out := make(chan ctypes.ResultEvent, outCap)
+	e.mu.Lock()
+	e.subscriptions[query] = out
+	e.mu.Unlock()
+
+	return out, nil
+}
+
+func (e *eventClient) unsubscribe(ctx context.Context, query string) error {
+	if err := e.ws.Unsubscribe(ctx, query); err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	delete(e.subscriptions, query)
+	e.mu.Unlock()
+	return nil
+}
+
+func (e *eventClient) unsubscribeAll(ctx context.Context) error {
+	if err := e.ws.UnsubscribeAll(ctx); err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	e.subscriptions = make(map[string]chan ctypes.ResultEvent)
+	e.mu.Unlock()
+	return nil
+}
+
+// resubscribe subscribes to the events of the new connection, after a reconnection.
+func (e *eventClient) resubscribe() {
+	e.mu.RLock()
+	queries := make([]string, 0, len(e.subscriptions))
+	for query := range e.subscriptions {
+		queries = append(queries, query)
+	}
+	e.mu.RUnlock()
+
+	for _, query := range queries {
+		ctx, cancel := context.WithTimeout(context.Background(), subscribeTimeout)
+		err := e.ws.Subscribe(ctx, query)
+		cancel()
+		if err != nil {
+			e.logger.Error("failed to subscribe again to the events", "query", query, "error", err.Error())
+		}
+	}
+}
+
+// dispatch sends the events received over the websocket to their subscriptions.
+func (e *eventClient) dispatch() {
+	for {
+		select {
+		case resp, ok := <-e.ws.ResponsesCh:
+			if !ok {
+				return
+			}
+			if resp.Error != nil {
+				e.logger.Error("websocket error", "error", resp.Error.Error())
+				continue
+			}
+
+			var ev ctypes.ResultEvent
+			if err := tmjson.Unmarshal(resp.Result, &ev); err != nil {
+				e.logger.Debug("failed to unmarshal the websocket response", "error", err.Error())
+				continue
+			}
+			if ev.Query == "" {
+				// the responses to the subscription requests
+				continue
+			}
+
+			e.mu.RLock()
+			out, ok := e.subscriptions[ev.Query]
+			e.mu.RUnlock()
+			if !ok {
+				continue
+			}
+
+			select {
+			case out <- ev:
+			case <-e.ws.Quit():
+				return
+			}
+		case <-e.ws.Quit():
+			return
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package upstream
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcserver "github.com/tendermint/tendermint/rpc/jsonrpc/server"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// fakeNode serves the subscriptions of the Tendermint websocket endpoint.
+type fakeNode struct {
+	address string
+	srv     *http.Server
+
+	mu    sync.Mutex
+	subs  map[string]*rpctypes.Context
+	conns []net.Conn
+}
+
+func startFakeNode(t *testing.T) *fakeNode {
+	n := &fakeNode{subs: make(map[string]*rpctypes.Context)}
+
+	funcs := map[string]*rpcserver.RPCFunc{
+		"subscribe": rpcserver.NewWSRPCFunc(func(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, error) {
+			n.mu.Lock()
+			defer n.mu.Unlock()
+			n.subs[query] = ctx
+			return &ctypes.ResultSubscribe{}, nil
+		}, "query"),
+	}
+	wm := rpcserver.NewWebsocketManager(funcs)
+	wm.SetLogger(log.NewNopLogger())
+	mux := http.NewServeMux()
+	mux.HandleFunc(wsEndpoint, wm.WebsocketHandler)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	n.address = "tcp://" + ln.Addr().String()
+	n.srv = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: time.Second,
+		ConnState: func(conn net.Conn, state http.ConnState) {
+			if state == http.StateNew {
+				n.mu.Lock()
+				n.conns = append(n.conns, conn)
+				n.mu.Unlock()
+			}
+		},
+	}
+	go func() {
+		_ = n.srv.Serve(ln)
+	}()
+	t.Cleanup(n.stop)
+	return n
+}
+
+// stop shuts the node down, along with its websocket connections.
+func (n *fakeNode) stop() {
+	_ = n.srv.Close()
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, conn := range n.conns {
+		_ = conn.Close()
+	}
+	n.subs = make(map[string]*rpctypes.Context)
+}
+
+func (n *fakeNode) subscribed(query string) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	_, ok := n.subs[query]
+	return ok
+}
+
+func (n *fakeNode) publish(t *testing.T, query string, height int64) {
+	n.mu.Lock()
+	ctx := n.subs[query]
+	n.mu.Unlock()
+
+	ev := ctypes.ResultEvent{
+		Query: query,
+		Data:  tmtypes.EventDataNewBlockHeader{Header: tmtypes.Header{Height: height}},
+	}
+	require.NoError(t, ctx.WSConn.WriteRPCResponse(context.Background(), rpctypes.NewRPCSuccessResponse(ctx.JSONReq.ID, ev)))
+}
+
+func TestDial(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	require.NoError(t, closed.Close())
+
+	c, err := NewClient(DefaultConfig(), []string{"tcp://" + closed.Addr().String(), "http://" + ln.Addr().String()})
+	require.NoError(t, err)
+	c.SetLogger(log.NewNopLogger())
+
+	conn, err := c.Dial("tcp", "ignored")
+	require.NoError(t, err)
+	defer conn.Close()
+	require.Equal(t, ln.Addr().String(), conn.RemoteAddr().String())
+
+	// the unreachable node is unhealthy
+	require.ErrorContains(t, c.nodes[0].getStatus().err, "connection refused")
+}
+
+func TestSubscriptionFailover(t *testing.T) {
+	nodes := []*fakeNode{startFakeNode(t), startFakeNode(t)}
+	c, err := NewClient(Config{HealthCheckInterval: time.Hour}, []string{nodes[0].address, nodes[1].address})
+	require.NoError(t, err)
+	c.SetLogger(log.NewNopLogger())
+	require.NoError(t, c.Start())
+	defer func() {
+		_ = c.Stop()
+	}()
+
+	query := tmtypes.QueryForEvent(tmtypes.EventNewBlockHeader).String()
+	out, err := c.Subscribe(context.Background(), "test", query)
+	require.NoError(t, err)
+
+	receive := func(height int64) {
+		select {
+		case ev := <-out:
+			require.Equal(t, height, ev.Data.(tmtypes.EventDataNewBlockHeader).Header.Height)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("event of height %d not received", height)
+		}
+	}
+
+	require.Eventually(t, func() bool { return nodes[0].subscribed(query) }, 5*time.Second, 10*time.Millisecond)
+	require.False(t, nodes[1].subscribed(query))
+	nodes[0].publish(t, query, 1)
+	receive(1)
+
+	// the subscription is made again on the next node when the first one goes down
+	nodes[0].stop()
+	require.Eventually(t, func() bool { return nodes[1].subscribed(query) }, 10*time.Second, 10*time.Millisecond)
+	nodes[1].publish(t, query, 2)
+	receive(2)
+}
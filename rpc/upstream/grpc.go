@@ -0,0 +1,160 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package upstream
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/attributes"
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+	"google.golang.org/grpc/status"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+const (
+	balancerName = "ethermint_upstream"
+	resolverName = "upstream"
+
+	// the balancer picks the node of each call, the calls failing because the node became
+	// unavailable are retried on the next one
+	serviceConfig = `{
+	"loadBalancingConfig": [{"` + balancerName + `": {}}],
+	"methodConfig": [{
+		"name": [{}],
+		"retryPolicy": {
+			"maxAttempts": 3,
+			"initialBackoff": "0.1s",
+			"maxBackoff": "1s",
+			"backoffMultiplier": 2,
+			"retryableStatusCodes": ["UNAVAILABLE"]
+		}
+	}]
+}`
+)
+
+func init() {
+	balancer.Register(base.NewBalancerBuilder(balancerName, pickerBuilder{}, base.Config{}))
+}
+
+// nodeKey is the address attribute key of the node serving a gRPC endpoint.
+type nodeKey struct{}
+
+// upstream is the address attribute value of the node serving a gRPC endpoint.
+type upstream struct {
+	client *Client
+	node   *Node
+}
+
+// GRPCDialOptions returns the target and the dial options of a gRPC connection to the gRPC
+// endpoints of the nodes, given in the same order as the nodes. The calls are routed like the
+// Tendermint RPC calls, using the height of the gRPC block height header.
+func (c *Client) GRPCDialOptions(addresses []string) (string, []grpc.DialOption, error) {
+	if len(addresses) != len(c.nodes) {
+		return "", nil, fmt.Errorf("expected %d gRPC addresses, got %d", len(c.nodes), len(addresses))
+	}
+
+	addrs := make([]resolver.Address, len(addresses))
+	for i, address := range addresses {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid gRPC address %s: %w", address, err)
+		}
+
+		addrs[i] = resolver.Address{
+			Addr:       address,
+			ServerName: host,
+			Attributes: attributes.New(nodeKey{}, &upstream{client: c, node: c.nodes[i]}),
+		}
+	}
+
+	r := manual.NewBuilderWithScheme(resolverName)
+	r.InitialState(resolver.State{Addresses: addrs})
+
+	opts := []grpc.DialOption{
+		grpc.WithResolvers(r),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+	}
+	return r.Scheme() + ":///nodes", opts, nil
+}
+
+// pickerBuilder builds the pickers over the ready gRPC connections.
+type pickerBuilder struct{}
+
+// Build implements base.PickerBuilder
+func (pickerBuilder) Build(info base.PickerBuildInfo) balancer.Picker {
+	if len(info.ReadySCs) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+
+	p := &picker{subConns: make(map[*Node]balancer.SubConn, len(info.ReadySCs))}
+	for subConn, subConnInfo := range info.ReadySCs {
+		u, ok := subConnInfo.Address.Attributes.Value(nodeKey{}).(*upstream)
+		if !ok {
+			continue
+		}
+		p.client = u.client
+		p.subConns[u.node] = subConn
+	}
+
+	if p.client == nil {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+	return p
+}
+
+// picker picks the connection of the first candidate node of a call.
+type picker struct {
+	client   *Client
+	subConns map[*Node]balancer.SubConn
+}
+
+// Pick implements balancer.Picker
+func (p *picker) Pick(info balancer.PickInfo) (balancer.PickResult, error) {
+	height := int64(0)
+	if md, ok := metadata.FromOutgoingContext(info.Ctx); ok {
+		if values := md.Get(grpctypes.GRPCBlockHeightHeader); len(values) == 1 {
+			height, _ = strconv.ParseInt(values[0], 10, 64)
+		}
+	}
+
+	for _, n := range p.client.candidates(height) {
+		subConn, ok := p.subConns[n]
+		if !ok {
+			continue
+		}
+
+		node := n
+		return balancer.PickResult{
+			SubConn: subConn,
+			Done: func(info balancer.DoneInfo) {
+				if status.Code(info.Err) == codes.Unavailable {
+					node.fail(info.Err)
+				}
+			},
+		}, nil
+	}
+
+	return balancer.PickResult{}, balancer.ErrNoSubConnAvailable
+}
@@ -0,0 +1,85 @@
+package upstream
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// startHealthServer starts a gRPC server whose health status identifies it.
+func startHealthServer(t *testing.T, status healthpb.HealthCheckResponse_ServingStatus) (*grpc.Server, string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	healthSrv := health.NewServer()
+	healthSrv.SetServingStatus("", status)
+
+	srv := grpc.NewServer()
+	healthpb.RegisterHealthServer(srv, healthSrv)
+	go func() {
+		_ = srv.Serve(ln)
+	}()
+	t.Cleanup(srv.Stop)
+
+	return srv, ln.Addr().String()
+}
+
+func TestGRPCRouting(t *testing.T) {
+	srv0, addr0 := startHealthServer(t, healthpb.HealthCheckResponse_SERVING)
+	_, addr1 := startHealthServer(t, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	c, clients := newTestClient(t, 2)
+	registerStatus(clients[0], 50, 100, false) // pruned
+	registerStatus(clients[1], 1, 100, false)
+	c.checkHealth()
+
+	target, opts, err := c.GRPCDialOptions([]string{addr0, addr1})
+	require.NoError(t, err)
+
+	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(target, opts...)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	healthClient := healthpb.NewHealthClient(conn)
+	check := func(height string) healthpb.HealthCheckResponse_ServingStatus {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if height != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, height)
+		}
+
+		res, err := healthClient.Check(ctx, &healthpb.HealthCheckRequest{}, grpc.WaitForReady(true))
+		require.NoError(t, err)
+		return res.Status
+	}
+
+	// wait for both connections to be ready
+	require.Eventually(t, func() bool {
+		return check("10") == healthpb.HealthCheckResponse_NOT_SERVING
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, check(""))
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, check("60"))
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, check("10"))
+
+	// fail over to the second node
+	srv0.Stop()
+	require.Eventually(t, func() bool {
+		return check("") == healthpb.HealthCheckResponse_NOT_SERVING
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, _, err = c.GRPCDialOptions([]string{addr0})
+	require.Error(t, err)
+}
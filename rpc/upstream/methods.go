@@ -0,0 +1,298 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package upstream
+
+import (
+	"context"
+
+	"github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+// ABCIInfo implements rpcclient.Client
+func (c *Client) ABCIInfo(ctx context.Context) (res *ctypes.ResultABCIInfo, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.ABCIInfo(ctx)
+		return err
+	})
+	return res, err
+}
+
+// ABCIQuery implements rpcclient.Client
+func (c *Client) ABCIQuery(ctx context.Context, path string, data bytes.HexBytes) (res *ctypes.ResultABCIQuery, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.ABCIQuery(ctx, path, data)
+		return err
+	})
+	return res, err
+}
+
+// ABCIQueryWithOptions implements rpcclient.Client
+func (c *Client) ABCIQueryWithOptions(ctx context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (res *ctypes.ResultABCIQuery, err error) {
+	err = c.do(ctx, opts.Height, func(client rpcclient.Client) (err error) {
+		res, err = client.ABCIQueryWithOptions(ctx, path, data, opts)
+		return err
+	})
+	return res, err
+}
+
+// BroadcastTxCommit implements rpcclient.Client
+func (c *Client) BroadcastTxCommit(ctx context.Context, tx types.Tx) (res *ctypes.ResultBroadcastTxCommit, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.BroadcastTxCommit(ctx, tx)
+		return err
+	})
+	return res, err
+}
+
+// BroadcastTxAsync implements rpcclient.Client
+func (c *Client) BroadcastTxAsync(ctx context.Context, tx types.Tx) (res *ctypes.ResultBroadcastTx, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.BroadcastTxAsync(ctx, tx)
+		return err
+	})
+	return res, err
+}
+
+// BroadcastTxSync implements rpcclient.Client
+func (c *Client) BroadcastTxSync(ctx context.Context, tx types.Tx) (res *ctypes.ResultBroadcastTx, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.BroadcastTxSync(ctx, tx)
+		return err
+	})
+	return res, err
+}
+
+// Block implements rpcclient.Client
+func (c *Client) Block(ctx context.Context, height *int64) (res *ctypes.ResultBlock, err error) {
+	err = c.do(ctx, heightOf(height), func(client rpcclient.Client) (err error) {
+		res, err = client.Block(ctx, height)
+		return err
+	})
+	return res, err
+}
+
+// BlockByHash implements rpcclient.Client
+func (c *Client) BlockByHash(ctx context.Context, hash []byte) (res *ctypes.ResultBlock, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.BlockByHash(ctx, hash)
+		return err
+	})
+	return res, err
+}
+
+// BlockResults implements rpcclient.Client
+func (c *Client) BlockResults(ctx context.Context, height *int64) (res *ctypes.ResultBlockResults, err error) {
+	err = c.do(ctx, heightOf(height), func(client rpcclient.Client) (err error) {
+		res, err = client.BlockResults(ctx, height)
+		return err
+	})
+	return res, err
+}
+
+// Commit implements rpcclient.Client
+func (c *Client) Commit(ctx context.Context, height *int64) (res *ctypes.ResultCommit, err error) {
+	err = c.do(ctx, heightOf(height), func(client rpcclient.Client) (err error) {
+		res, err = client.Commit(ctx, height)
+		return err
+	})
+	return res, err
+}
+
+// Validators implements rpcclient.Client
+func (c *Client) Validators(ctx context.Context, height *int64, page, perPage *int) (res *ctypes.ResultValidators, err error) {
+	err = c.do(ctx, heightOf(height), func(client rpcclient.Client) (err error) {
+		res, err = client.Validators(ctx, height, page, perPage)
+		return err
+	})
+	return res, err
+}
+
+// Tx implements rpcclient.Client
+func (c *Client) Tx(ctx context.Context, hash []byte, prove bool) (res *ctypes.ResultTx, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.Tx(ctx, hash, prove)
+		return err
+	})
+	return res, err
+}
+
+// TxSearch implements rpcclient.Client
+func (c *Client) TxSearch(ctx context.Context, query string, prove bool, page, perPage *int, orderBy string) (res *ctypes.ResultTxSearch, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.TxSearch(ctx, query, prove, page, perPage, orderBy)
+		return err
+	})
+	return res, err
+}
+
+// BlockSearch implements rpcclient.Client
+func (c *Client) BlockSearch(ctx context.Context, query string, page, perPage *int, orderBy string) (res *ctypes.ResultBlockSearch, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.BlockSearch(ctx, query, page, perPage, orderBy)
+		return err
+	})
+	return res, err
+}
+
+// Genesis implements rpcclient.Client
+func (c *Client) Genesis(ctx context.Context) (res *ctypes.ResultGenesis, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.Genesis(ctx)
+		return err
+	})
+	return res, err
+}
+
+// GenesisChunked implements rpcclient.Client
+func (c *Client) GenesisChunked(ctx context.Context, id uint) (res *ctypes.ResultGenesisChunk, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.GenesisChunked(ctx, id)
+		return err
+	})
+	return res, err
+}
+
+// BlockchainInfo implements rpcclient.Client
+func (c *Client) BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (res *ctypes.ResultBlockchainInfo, err error) {
+	err = c.do(ctx, maxHeight, func(client rpcclient.Client) (err error) {
+		res, err = client.BlockchainInfo(ctx, minHeight, maxHeight)
+		return err
+	})
+	return res, err
+}
+
+// Status implements rpcclient.Client
+func (c *Client) Status(ctx context.Context) (res *ctypes.ResultStatus, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.Status(ctx)
+		return err
+	})
+	return res, err
+}
+
+// NetInfo implements rpcclient.Client
+func (c *Client) NetInfo(ctx context.Context) (res *ctypes.ResultNetInfo, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.NetInfo(ctx)
+		return err
+	})
+	return res, err
+}
+
+// DumpConsensusState implements rpcclient.Client
+func (c *Client) DumpConsensusState(ctx context.Context) (res *ctypes.ResultDumpConsensusState, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.DumpConsensusState(ctx)
+		return err
+	})
+	return res, err
+}
+
+// ConsensusState implements rpcclient.Client
+func (c *Client) ConsensusState(ctx context.Context) (res *ctypes.ResultConsensusState, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.ConsensusState(ctx)
+		return err
+	})
+	return res, err
+}
+
+// ConsensusParams implements rpcclient.Client
+func (c *Client) ConsensusParams(ctx context.Context, height *int64) (res *ctypes.ResultConsensusParams, err error) {
+	err = c.do(ctx, heightOf(height), func(client rpcclient.Client) (err error) {
+		res, err = client.ConsensusParams(ctx, height)
+		return err
+	})
+	return res, err
+}
+
+// Health implements rpcclient.Client
+func (c *Client) Health(ctx context.Context) (res *ctypes.ResultHealth, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.Health(ctx)
+		return err
+	})
+	return res, err
+}
+
+// UnconfirmedTxs implements rpcclient.Client
+func (c *Client) UnconfirmedTxs(ctx context.Context, limit *int) (res *ctypes.ResultUnconfirmedTxs, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.UnconfirmedTxs(ctx, limit)
+		return err
+	})
+	return res, err
+}
+
+// NumUnconfirmedTxs implements rpcclient.Client
+func (c *Client) NumUnconfirmedTxs(ctx context.Context) (res *ctypes.ResultUnconfirmedTxs, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.NumUnconfirmedTxs(ctx)
+		return err
+	})
+	return res, err
+}
+
+// CheckTx implements rpcclient.Client
+func (c *Client) CheckTx(ctx context.Context, tx types.Tx) (res *ctypes.ResultCheckTx, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.CheckTx(ctx, tx)
+		return err
+	})
+	return res, err
+}
+
+// BroadcastEvidence implements rpcclient.Client
+func (c *Client) BroadcastEvidence(ctx context.Context, ev types.Evidence) (res *ctypes.ResultBroadcastEvidence, err error) {
+	err = c.do(ctx, 0, func(client rpcclient.Client) (err error) {
+		res, err = client.BroadcastEvidence(ctx, ev)
+		return err
+	})
+	return res, err
+}
+
+// Subscribe implements rpcclient.Client, the subscriptions are served by the event client, which
+// fails over between the nodes. As with the Tendermint HTTP client, the subscriber is ignored and
+// there is a single subscription per query.
+func (c *Client) Subscribe(
+	ctx context.Context,
+	_, query string,
+	outCapacity ...int,
+) (out <-chan ctypes.ResultEvent, err error) {
+	if c.events == nil {
+		return nil, errNotStarted
+	}
+	return c.events.subscribe(ctx, query, outCapacity...)
+}
+
+// Unsubscribe implements rpcclient.Client
+func (c *Client) Unsubscribe(ctx context.Context, _, query string) error {
+	if c.events == nil {
+		return errNotStarted
+	}
+	return c.events.unsubscribe(ctx, query)
+}
+
+// UnsubscribeAll implements rpcclient.Client
+func (c *Client) UnsubscribeAll(ctx context.Context, _ string) error {
+	if c.events == nil {
+		return errNotStarted
+	}
+	return c.events.unsubscribeAll(ctx)
+}
@@ -42,10 +42,10 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/evmos/ethermint/rpc/ethereum/pubsub"
+	rpcmetrics "github.com/evmos/ethermint/rpc/metrics"
 	rpcfilters "github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
 	"github.com/evmos/ethermint/rpc/types"
 	"github.com/evmos/ethermint/server/config"
-	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
 type WebsocketsServer interface {
@@ -87,19 +87,45 @@ type websocketsServer struct {
 	keyFile  string
 	api      *pubSubAPI
 	logger   log.Logger
+	// jwtSecret authenticates the connections, authentication is disabled if nil
+	jwtSecret []byte
+	// rpcConfig defines the namespaces that require authentication
+	rpcConfig config.JSONRPCConfig
+	// limiter checks the requests methods and rate limits, disabled if nil
+	limiter *RequestLimiter
+	// forwarder identifies the client of the requests forwarded to the JSON-RPC server, disabled if nil
+	forwarder *ClientForwarder
+	// batchLimits bounds the batch requests, the responses size is bounded by the JSON-RPC server
+	batchLimits BatchLimits
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+// NewWebsocketsServer creates the WebSocket JSON-RPC server. The connections are authenticated
+// with the given JWT secret and the requests are checked by the given limiter when they aren't nil.
+// The requests forwarded to the JSON-RPC server carry the client IP through the given forwarder.
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	cfg *config.Config,
+	jwtSecret []byte,
+	limiter *RequestLimiter,
+	forwarder *ClientForwarder,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address)
 
 	return &websocketsServer{
-		rpcAddr:  "localhost:" + port, // FIXME: this shouldn't be hardcoded to localhost
-		wsAddr:   cfg.JSONRPC.WsAddress,
-		certFile: cfg.TLS.CertificatePath,
-		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
-		logger:   logger,
+		rpcAddr:     "localhost:" + port, // FIXME: this shouldn't be hardcoded to localhost
+		wsAddr:      cfg.JSONRPC.WsAddress,
+		certFile:    cfg.TLS.CertificatePath,
+		keyFile:     cfg.TLS.KeyPath,
+		api:         newPubSubAPI(clientCtx, logger, tmWSClient, cfg.JSONRPC.FilterCap),
+		logger:      logger,
+		jwtSecret:   jwtSecret,
+		rpcConfig:   cfg.JSONRPC,
+		limiter:     limiter,
+		forwarder:   forwarder,
+		batchLimits: NewBatchLimits(cfg.JSONRPC),
 	}
 }
 
@@ -127,6 +153,21 @@ func (s *websocketsServer) Start() {
 }
 
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	authenticated := false
+	if s.jwtSecret != nil {
+		var err error
+		authenticated, err = authenticate(s.jwtSecret, r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+
+		if !authenticated && len(s.rpcConfig.AuthAPI) == 0 {
+			http.Error(w, "missing token", http.StatusForbidden)
+			return
+		}
+	}
+
 	upgrader := websocket.Upgrader{
 		CheckOrigin: func(r *http.Request) bool {
 			return true
@@ -140,8 +181,10 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	s.readLoop(&wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
+		mux:           new(sync.Mutex),
+		conn:          conn,
+		authenticated: authenticated,
+		clientIP:      clientIPFromRequest(r),
 	})
 }
 
@@ -161,6 +204,10 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 type wsConn struct {
 	conn *websocket.Conn
 	mux  *sync.Mutex
+	// authenticated is true if the connection was opened with a valid JWT token
+	authenticated bool
+	// clientIP is the IP of the client, used for rate limiting and the subscription cap
+	clientIP string
 }
 
 func (w *wsConn) WriteJSON(v interface{}) error {
@@ -202,7 +249,34 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 		}
 
 		if isBatch(mb) {
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
+			var elems []json.RawMessage
+			if err := json.Unmarshal(mb, &elems); err == nil {
+				if res := s.batchLimits.tooLarge(len(elems)); res != nil {
+					_ = wsConn.WriteJSON(json.RawMessage(res))
+					continue
+				}
+			}
+		}
+
+		var rejected []json.RawMessage
+		if s.limiter != nil {
+			var (
+				forward []byte
+				batch   bool
+			)
+			forward, rejected, batch = s.limiter.filter(wsConn.clientIP, mb)
+			if forward == nil {
+				// all the requests are rejected
+				if res := rejectedResponse(rejected, batch); res != nil {
+					_ = wsConn.WriteJSON(json.RawMessage(res))
+				}
+				continue
+			}
+			mb = forward
+		}
+
+		if isBatch(mb) {
+			if err := s.tcpGetAndSendResponse(wsConn, mb, rejected...); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
 			}
 			continue
@@ -236,6 +310,11 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 
 		switch method {
 		case "eth_subscribe":
+			if s.rpcConfig.RequiresAuth(EthNamespace) && !wsConn.authenticated {
+				s.sendErrResponse(wsConn, "missing token")
+				continue
+			}
+
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
 				continue
@@ -247,7 +326,14 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				s.sendErrResponse(wsConn, err.Error())
 				continue
 			}
-			subscriptions[subID] = unsubFn
+			// the subscription type is valid as the subscription succeeded
+			subType, _ := params[0].(string)
+			subGauge := rpcmetrics.WSSubscriptions.WithLabelValues(subType)
+			subGauge.Inc()
+			subscriptions[subID] = func() {
+				unsubFn()
+				subGauge.Dec()
+			}
 
 			res := &SubscriptionResponseJSON{
 				Jsonrpc: "2.0",
@@ -312,14 +398,30 @@ func (s *websocketsServer) getParamsAndCheckValid(msg map[string]interface{}, ws
 }
 
 // tcpGetAndSendResponse connects to the rest-server over tcp, posts a JSON-RPC request, and sends the response
-// to the client over websockets
-func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) error {
+// to the client over websockets. The error responses of the rejected batch elements are appended to the
+// batch response.
+func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte, rejected ...json.RawMessage) error {
 	req, err := http.NewRequestWithContext(context.Background(), "POST", "http://"+s.rpcAddr, bytes.NewBuffer(mb))
 	if err != nil {
 		return errors.Wrap(err, "Could not build request")
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if wsConn.authenticated {
+		// forward the connection authentication to the JSON-RPC server
+		token, err := NewJWTToken(s.jwtSecret)
+		if err != nil {
+			return errors.Wrap(err, "could not create JWT token")
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if s.limiter != nil {
+		s.limiter.forwardHeader(req.Header)
+	}
+	if s.forwarder != nil {
+		s.forwarder.forwardHeader(req.Header, wsConn.clientIP)
+	}
+
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -333,6 +435,10 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 		return errors.Wrap(err, "could not read body from response")
 	}
 
+	if len(rejected) > 0 {
+		body = mergeBatchResponses(body, rejected)
+	}
+
 	var wsSend interface{}
 	err = json.Unmarshal(body, &wsSend)
 	if err != nil {
@@ -347,19 +453,64 @@ type pubSubAPI struct {
 	events    *rpcfilters.EventSystem
 	logger    log.Logger
 	clientCtx client.Context
+
+	// filterCap is the number of subscriptions that each client can create
+	filterCap int32
+	// clientSubs is the number of subscriptions of each client, over all its connections
+	clientSubs   map[string]int
+	clientSubsMu sync.Mutex
 }
 
-// newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient) *pubSubAPI {
+// newPubSubAPI creates an instance of the ethereum PubSub API. The subscriptions share the event
+// system of the Tendermint websocket client.
+func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, filterCap int32) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
 	return &pubSubAPI{
-		events:    rpcfilters.NewEventSystem(logger, tmWSClient),
-		logger:    logger,
-		clientCtx: clientCtx,
+		events:     rpcfilters.SharedEventSystem(logger, tmWSClient),
+		logger:     logger,
+		clientCtx:  clientCtx,
+		filterCap:  filterCap,
+		clientSubs: make(map[string]int),
 	}
 }
 
+// subscribe creates the subscription of the client, unless the client has reached the filter cap.
+// The returned function cancels the subscription and releases its slot.
 func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}) (pubsub.UnsubscribeFunc, error) {
+	api.clientSubsMu.Lock()
+	if api.clientSubs[wsConn.clientIP] >= int(api.filterCap) {
+		api.clientSubsMu.Unlock()
+		return nil, errors.New("error creating subscription: max limit reached")
+	}
+	api.clientSubs[wsConn.clientIP]++
+	api.clientSubsMu.Unlock()
+
+	release := func() {
+		api.clientSubsMu.Lock()
+		defer api.clientSubsMu.Unlock()
+
+		api.clientSubs[wsConn.clientIP]--
+		if api.clientSubs[wsConn.clientIP] <= 0 {
+			delete(api.clientSubs, wsConn.clientIP)
+		}
+	}
+
+	unsubFn, err := api.subscribeMethod(wsConn, subID, params)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			unsubFn()
+			release()
+		})
+	}, nil
+}
+
+func (api *pubSubAPI) subscribeMethod(wsConn *wsConn, subID rpc.ID, params []interface{}) (pubsub.UnsubscribeFunc, error) {
 	method, ok := params[0].(string)
 	if !ok {
 		return nil, errors.New("invalid parameters")
@@ -409,6 +560,7 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.Un
 				}
 
 				header := types.EthHeaderFromTendermint(data.Header, ethtypes.Bloom{}, baseFee)
+				header.Time = types.BlockTimeFromEvents(data.Header, data.ResultBeginBlock.Events)
 
 				// write to ws conn
 				res := &SubscriptionNotification{
@@ -566,32 +718,16 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 	}
 
 	go func() {
-		ch := sub.Event()
+		// the logs are matched against the criteria by the event system
+		ch := sub.Logs()
 		errCh := sub.Err()
 		for {
 			select {
-			case event, ok := <-ch:
+			case logs, ok := <-ch:
 				if !ok {
 					return
 				}
 
-				dataTx, ok := event.Data.(tmtypes.EventDataTx)
-				if !ok {
-					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", event.Data))
-					continue
-				}
-
-				txResponse, err := evmtypes.DecodeTxResponse(dataTx.TxResult.Result.Data)
-				if err != nil {
-					api.logger.Error("failed to decode tx response", "error", err.Error())
-					return
-				}
-
-				logs := rpcfilters.FilterLogs(evmtypes.LogsToEthereum(txResponse.Logs), crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
-				if len(logs) == 0 {
-					continue
-				}
-
 				for _, ethLog := range logs {
 					res := &SubscriptionNotification{
 						Jsonrpc: "2.0",
@@ -634,7 +770,11 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 		errCh := sub.Err()
 		for {
 			select {
-			case ev := <-txsCh:
+			case ev, ok := <-txsCh:
+				if !ok {
+					return
+				}
+
 				data, ok := ev.Data.(tmtypes.EventDataTx)
 				if !ok {
 					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
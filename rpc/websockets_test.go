@@ -0,0 +1,71 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
+)
+
+// ownerService returns the filter owner of the calls.
+type ownerService struct{}
+
+func (ownerService) Owner(ctx context.Context) string {
+	return filters.FilterOwner(ctx)
+}
+
+func TestWebsocketsForwardClientIP(t *testing.T) {
+	rpcServer := ethrpc.NewServer()
+	defer rpcServer.Stop()
+	require.NoError(t, rpcServer.RegisterName("test", ownerService{}))
+
+	forwarder, err := NewClientForwarder()
+	require.NoError(t, err)
+
+	httpSrv := httptest.NewServer(forwarder.Handler(rpcServer))
+	defer httpSrv.Close()
+
+	wsSrv := &websocketsServer{
+		rpcAddr:   strings.TrimPrefix(httpSrv.URL, "http://"),
+		logger:    log.NewNopLogger(),
+		forwarder: forwarder,
+	}
+	// the WebSocket client connects from a remote address
+	wsHTTPSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.RemoteAddr = "203.0.113.7:1234"
+		wsSrv.ServeHTTP(w, r)
+	}))
+	defer wsHTTPSrv.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(wsHTTPSrv.URL, "http"), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "method": "test_owner"}))
+	var res map[string]interface{}
+	require.NoError(t, conn.ReadJSON(&res))
+	require.Equal(t, "203.0.113.7", res["result"])
+
+	// the client IP of the requests that aren't forwarded is ignored
+	req, err := http.NewRequest(http.MethodPost, httpSrv.URL, strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"test_owner"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(forwardTokenHeader, "invalid")
+	req.Header.Set(forwardClientIPHeader, "203.0.113.7")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	res = nil
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+	require.Equal(t, "127.0.0.1", res["result"])
+}
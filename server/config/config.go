@@ -18,7 +18,9 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"path"
+	"strconv"
 	"time"
 
 	"github.com/spf13/viper"
@@ -28,6 +30,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/evmos/ethermint/tracing"
 )
 
 const (
@@ -75,9 +79,43 @@ const (
 
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
+
+	// DefaultRateLimit is the default number of requests per second per client IP (unlimited = 0)
+	DefaultRateLimit float64 = 0
+
+	// DefaultRateLimitBurst is the default number of requests per client IP allowed in a burst
+	// (0 = the rate limit rounded up)
+	DefaultRateLimitBurst = 0
+
+	// DefaultBatchRequestLimit is the default maximum number of calls in a batch request
+	DefaultBatchRequestLimit = 1000
+
+	// DefaultBatchResponseMaxSize is the default maximum size in bytes of a batch response
+	DefaultBatchResponseMaxSize = 25 * 1000 * 1000
+
+	// DefaultResponseCacheSize is the default number of cached immutable responses (disabled = 0)
+	DefaultResponseCacheSize = 0
+
+	// DefaultTracingExporter is the default span exporter
+	DefaultTracingExporter = tracing.ExporterOTLP
+
+	// DefaultTracingEndpoint is the default address of the OTLP gRPC collector
+	DefaultTracingEndpoint = "localhost:4317"
+
+	// DefaultTracingFilePath is the default file the spans are appended to, relative to the node home
+	DefaultTracingFilePath = "data/traces.json"
+
+	// DefaultTracingSampleRatio is the default ratio of the traces sampled
+	DefaultTracingSampleRatio = 1.0
+
+	// DefaultTracingServiceName is the default service name reported with the spans
+	DefaultTracingServiceName = "ethermint"
 )
 
-var evmTracers = []string{"json", "markdown", "struct", "access_list"}
+var (
+	evmTracers       = []string{"json", "markdown", "struct", "access_list"}
+	tracingExporters = []string{tracing.ExporterOTLP, tracing.ExporterFile}
+)
 
 // Config defines the server's top level configuration. It includes the default app config
 // from the SDK as well as the EVM configuration to enable the JSON-RPC APIs.
@@ -87,6 +125,7 @@ type Config struct {
 	EVM     EVMConfig     `mapstructure:"evm"`
 	JSONRPC JSONRPCConfig `mapstructure:"json-rpc"`
 	TLS     TLSConfig     `mapstructure:"tls"`
+	Tracing TracingConfig `mapstructure:"tracing"`
 }
 
 // EVMConfig defines the application configuration values for the EVM.
@@ -112,7 +151,7 @@ type JSONRPCConfig struct {
 	EVMTimeout time.Duration `mapstructure:"evm-timeout"`
 	// TxFeeCap is the global tx-fee cap for send transaction
 	TxFeeCap float64 `mapstructure:"txfee-cap"`
-	// FilterCap is the global cap for total number of filters that can be created.
+	// FilterCap is the cap for the number of filters and subscriptions that each client, identified by its IP, can create.
 	FilterCap int32 `mapstructure:"filter-cap"`
 	// FeeHistoryCap is the global cap for total number of blocks that can be fetched
 	FeeHistoryCap int32 `mapstructure:"feehistory-cap"`
@@ -138,6 +177,45 @@ type JSONRPCConfig struct {
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
+	// JWTSecret defines the path to the hex encoded secret used to authenticate the JSON-RPC requests
+	// with HS256 JWT tokens. Authentication is disabled if empty.
+	JWTSecret string `mapstructure:"jwt-secret"`
+	// AuthAPI defines a list of JSON-RPC namespaces that require a JWT token. All the namespaces
+	// require it if empty and the JWT secret is set.
+	AuthAPI []string `mapstructure:"auth-api"`
+	// AllowMethods defines a list of JSON-RPC methods that can be called. All the methods of the
+	// enabled namespaces can be called if empty.
+	AllowMethods []string `mapstructure:"allow-methods"`
+	// DenyMethods defines a list of JSON-RPC methods that cannot be called.
+	DenyMethods []string `mapstructure:"deny-methods"`
+	// RateLimit defines the maximum number of requests per second per client IP (0 = unlimited).
+	RateLimit float64 `mapstructure:"rate-limit"`
+	// RateLimitBurst defines the maximum number of requests per client IP allowed in a burst.
+	RateLimitBurst int `mapstructure:"rate-limit-burst"`
+	// MethodRateLimits defines the rate limits per client IP of specific methods, formatted as
+	// "method:rate:burst".
+	MethodRateLimits []string `mapstructure:"method-rate-limits"`
+	// BatchRequestLimit defines the maximum number of calls in a batch request (0 = unlimited).
+	BatchRequestLimit int `mapstructure:"batch-request-limit"`
+	// BatchResponseMaxSize defines the maximum size in bytes of a batch response (0 = unlimited).
+	BatchResponseMaxSize int `mapstructure:"batch-response-max-size"`
+	// IPCPath defines the path of the unix domain socket serving the JSON-RPC namespaces, relative
+	// to the node home directory if not absolute. The IPC server is disabled if empty.
+	IPCPath string `mapstructure:"ipc-path"`
+	// ResponseCacheSize defines the maximum number of immutable responses, such as the blocks at a
+	// given height or the receipts of mined transactions, kept in memory (0 = disabled).
+	ResponseCacheSize int `mapstructure:"response-cache-size"`
+	// Signer defines the endpoint of a Clef-compatible external signer, either a unix socket path
+	// or an HTTP URL, used by the signing methods instead of the node keyring if not empty.
+	Signer string `mapstructure:"signer"`
+}
+
+// MethodRateLimit defines the rate limit of a JSON-RPC method.
+type MethodRateLimit struct {
+	// Rate is the maximum number of requests per second
+	Rate float64
+	// Burst is the maximum number of requests allowed in a burst
+	Burst int
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -148,6 +226,26 @@ type TLSConfig struct {
 	KeyPath string `mapstructure:"key-path"`
 }
 
+// TracingConfig defines the OpenTelemetry tracing of the JSON-RPC requests, the gRPC queries and
+// the EVM execution.
+type TracingConfig struct {
+	// Enable defines if the spans are recorded and exported.
+	Enable bool `mapstructure:"enable"`
+	// Exporter defines the span exporter, either "otlp" or "file".
+	Exporter string `mapstructure:"exporter"`
+	// Endpoint defines the address of the OTLP gRPC collector.
+	Endpoint string `mapstructure:"endpoint"`
+	// Insecure disables the TLS of the connection to the OTLP collector.
+	Insecure bool `mapstructure:"insecure"`
+	// FilePath defines the file the spans are appended to with the file exporter, relative to the
+	// node home directory if not absolute.
+	FilePath string `mapstructure:"file-path"`
+	// SampleRatio defines the ratio of the traces sampled, unless the parent span is sampled.
+	SampleRatio float64 `mapstructure:"sample-ratio"`
+	// ServiceName defines the service name reported with the spans.
+	ServiceName string `mapstructure:"service-name"`
+}
+
 // AppConfig helps to override default appConfig template and configs.
 // return "", nil if no custom configuration is required for the application.
 func AppConfig(denom string) (string, interface{}) {
@@ -176,6 +274,7 @@ func AppConfig(denom string) (string, interface{}) {
 		EVM:     *DefaultEVMConfig(),
 		JSONRPC: *DefaultJSONRPCConfig(),
 		TLS:     *DefaultTLSConfig(),
+		Tracing: *DefaultTracingConfig(),
 	}
 
 	customAppTemplate := config.DefaultConfigTemplate + DefaultConfigTemplate
@@ -190,6 +289,7 @@ func DefaultConfig() *Config {
 		EVM:     *DefaultEVMConfig(),
 		JSONRPC: *DefaultJSONRPCConfig(),
 		TLS:     *DefaultTLSConfig(),
+		Tracing: *DefaultTracingConfig(),
 	}
 }
 
@@ -241,6 +341,15 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		EnableIndexer:            false,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
+		AuthAPI:                  []string{},
+		AllowMethods:             []string{},
+		DenyMethods:              []string{},
+		RateLimit:                DefaultRateLimit,
+		RateLimitBurst:           DefaultRateLimitBurst,
+		MethodRateLimits:         []string{},
+		BatchRequestLimit:        DefaultBatchRequestLimit,
+		BatchResponseMaxSize:     DefaultBatchResponseMaxSize,
+		ResponseCacheSize:        DefaultResponseCacheSize,
 	}
 }
 
@@ -292,9 +401,105 @@ func (c JSONRPCConfig) Validate() error {
 		seenAPIs[api] = true
 	}
 
+	if len(c.AuthAPI) > 0 && c.JWTSecret == "" {
+		return errors.New("JSON-RPC auth-api requires the jwt-secret to be set")
+	}
+
+	seenAuthAPIs := make(map[string]bool)
+	for _, api := range c.AuthAPI {
+		if seenAuthAPIs[api] {
+			return fmt.Errorf("repeated auth API namespace '%s'", api)
+		}
+
+		if !seenAPIs[api] {
+			return fmt.Errorf("auth API namespace '%s' is not enabled", api)
+		}
+
+		seenAuthAPIs[api] = true
+	}
+
+	if c.RateLimit < 0 {
+		return errors.New("JSON-RPC rate limit cannot be negative")
+	}
+
+	if c.RateLimitBurst < 0 {
+		return errors.New("JSON-RPC rate limit burst cannot be negative")
+	}
+
+	if c.ResponseCacheSize < 0 {
+		return errors.New("JSON-RPC response cache size cannot be negative")
+	}
+
+	if c.Signer != "" {
+		u, err := url.Parse(c.Signer)
+		if err != nil {
+			return fmt.Errorf("invalid JSON-RPC signer endpoint: %w", err)
+		}
+		if u.Scheme != "" && u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("invalid JSON-RPC signer endpoint scheme '%s', expected http or https", u.Scheme)
+		}
+	}
+
+	if c.BatchRequestLimit < 0 {
+		return errors.New("JSON-RPC batch request limit cannot be negative")
+	}
+
+	if c.BatchResponseMaxSize < 0 {
+		return errors.New("JSON-RPC batch response max size cannot be negative")
+	}
+
+	for _, method := range c.AllowMethods {
+		if strings.StringInSlice(method, c.DenyMethods) {
+			return fmt.Errorf("JSON-RPC method '%s' is both allowed and denied", method)
+		}
+	}
+
+	if _, err := c.GetMethodRateLimits(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// GetMethodRateLimits parses the method rate limits, formatted as "method:rate:burst".
+func (c JSONRPCConfig) GetMethodRateLimits() (map[string]MethodRateLimit, error) {
+	limits := make(map[string]MethodRateLimit, len(c.MethodRateLimits))
+	for _, limit := range c.MethodRateLimits {
+		fields := strings.SplitAndTrim(limit, ":", " ")
+		if len(fields) != 3 || fields[0] == "" {
+			return nil, fmt.Errorf("invalid JSON-RPC method rate limit '%s', expected 'method:rate:burst'", limit)
+		}
+
+		if _, ok := limits[fields[0]]; ok {
+			return nil, fmt.Errorf("repeated JSON-RPC method rate limit '%s'", fields[0])
+		}
+
+		rate, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("invalid JSON-RPC method rate limit '%s', rate must be positive", limit)
+		}
+
+		burst, err := strconv.Atoi(fields[2])
+		if err != nil || burst <= 0 {
+			return nil, fmt.Errorf("invalid JSON-RPC method rate limit '%s', burst must be positive", limit)
+		}
+
+		limits[fields[0]] = MethodRateLimit{Rate: rate, Burst: burst}
+	}
+
+	return limits, nil
+}
+
+// RequiresAuth returns true if the requests to the given JSON-RPC namespace must be
+// authenticated with a JWT token.
+func (c JSONRPCConfig) RequiresAuth(namespace string) bool {
+	if c.JWTSecret == "" {
+		return false
+	}
+
+	return len(c.AuthAPI) == 0 || strings.StringInSlice(namespace, c.AuthAPI)
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
@@ -320,6 +525,40 @@ func (c TLSConfig) Validate() error {
 	return nil
 }
 
+// DefaultTracingConfig returns the default tracing configuration
+func DefaultTracingConfig() *TracingConfig {
+	return &TracingConfig{
+		Enable:      false,
+		Exporter:    DefaultTracingExporter,
+		Endpoint:    DefaultTracingEndpoint,
+		Insecure:    false,
+		FilePath:    DefaultTracingFilePath,
+		SampleRatio: DefaultTracingSampleRatio,
+		ServiceName: DefaultTracingServiceName,
+	}
+}
+
+// Validate returns an error if the tracing exporter or sample ratio are invalid.
+func (c TracingConfig) Validate() error {
+	if !strings.StringInSlice(c.Exporter, tracingExporters) {
+		return fmt.Errorf("invalid span exporter type %s, available types: %v", c.Exporter, tracingExporters)
+	}
+
+	if c.Exporter == tracing.ExporterOTLP && c.Endpoint == "" {
+		return errors.New("OTLP endpoint cannot be empty")
+	}
+
+	if c.Exporter == tracing.ExporterFile && c.FilePath == "" {
+		return errors.New("span file path cannot be empty")
+	}
+
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return fmt.Errorf("sample ratio must be between 0 and 1, got %v", c.SampleRatio)
+	}
+
+	return nil
+}
+
 // GetConfig returns a fully parsed Config object.
 func GetConfig(v *viper.Viper) (Config, error) {
 	cfg, err := config.GetConfig(v)
@@ -351,11 +590,32 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			EnableIndexer:            v.GetBool("json-rpc.enable-indexer"),
 			MetricsAddress:           v.GetString("json-rpc.metrics-address"),
 			FixRevertGasRefundHeight: v.GetInt64("json-rpc.fix-revert-gas-refund-height"),
+			JWTSecret:                v.GetString("json-rpc.jwt-secret"),
+			AuthAPI:                  v.GetStringSlice("json-rpc.auth-api"),
+			AllowMethods:             v.GetStringSlice("json-rpc.allow-methods"),
+			DenyMethods:              v.GetStringSlice("json-rpc.deny-methods"),
+			RateLimit:                v.GetFloat64("json-rpc.rate-limit"),
+			RateLimitBurst:           v.GetInt("json-rpc.rate-limit-burst"),
+			MethodRateLimits:         v.GetStringSlice("json-rpc.method-rate-limits"),
+			BatchRequestLimit:        v.GetInt("json-rpc.batch-request-limit"),
+			BatchResponseMaxSize:     v.GetInt("json-rpc.batch-response-max-size"),
+			IPCPath:                  v.GetString("json-rpc.ipc-path"),
+			ResponseCacheSize:        v.GetInt("json-rpc.response-cache-size"),
+			Signer:                   v.GetString("json-rpc.signer"),
 		},
 		TLS: TLSConfig{
 			CertificatePath: v.GetString("tls.certificate-path"),
 			KeyPath:         v.GetString("tls.key-path"),
 		},
+		Tracing: TracingConfig{
+			Enable:      v.GetBool("tracing.enable"),
+			Exporter:    v.GetString("tracing.exporter"),
+			Endpoint:    v.GetString("tracing.endpoint"),
+			Insecure:    v.GetBool("tracing.insecure"),
+			FilePath:    v.GetString("tracing.file-path"),
+			SampleRatio: v.GetFloat64("tracing.sample-ratio"),
+			ServiceName: v.GetString("tracing.service-name"),
+		},
 	}, nil
 }
 
@@ -382,5 +642,9 @@ func (c Config) ValidateBasic() error {
 		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid tls config value: %s", err.Error())
 	}
 
+	if err := c.Tracing.Validate(); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid tracing config value: %s", err.Error())
+	}
+
 	return c.Config.ValidateBasic()
 }
@@ -12,3 +12,282 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
+
+func TestJSONRPCConfigAuth(t *testing.T) {
+	testCases := []struct {
+		name      string
+		malleate  func(cfg *JSONRPCConfig)
+		expPass   bool
+		authedAPI []string
+		publicAPI []string
+	}{
+		{
+			"auth disabled",
+			func(cfg *JSONRPCConfig) {},
+			true,
+			nil,
+			[]string{"eth", "personal"},
+		},
+		{
+			"all namespaces authenticated",
+			func(cfg *JSONRPCConfig) {
+				cfg.JWTSecret = "jwt.hex"
+			},
+			true,
+			[]string{"eth", "personal"},
+			nil,
+		},
+		{
+			"authenticated namespaces",
+			func(cfg *JSONRPCConfig) {
+				cfg.API = []string{"eth", "personal", "debug"}
+				cfg.JWTSecret = "jwt.hex"
+				cfg.AuthAPI = []string{"personal", "debug"}
+			},
+			true,
+			[]string{"personal", "debug"},
+			[]string{"eth"},
+		},
+		{
+			"auth namespaces without secret",
+			func(cfg *JSONRPCConfig) {
+				cfg.API = []string{"eth", "personal"}
+				cfg.AuthAPI = []string{"personal"}
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"auth namespace not enabled",
+			func(cfg *JSONRPCConfig) {
+				cfg.JWTSecret = "jwt.hex"
+				cfg.AuthAPI = []string{"personal"}
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"repeated auth namespace",
+			func(cfg *JSONRPCConfig) {
+				cfg.API = []string{"eth", "personal"}
+				cfg.JWTSecret = "jwt.hex"
+				cfg.AuthAPI = []string{"personal", "personal"}
+			},
+			false,
+			nil,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			tc.malleate(cfg)
+
+			err := cfg.Validate()
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			for _, api := range tc.authedAPI {
+				require.True(t, cfg.RequiresAuth(api), api)
+			}
+			for _, api := range tc.publicAPI {
+				require.False(t, cfg.RequiresAuth(api), api)
+			}
+		})
+	}
+}
+
+func TestJSONRPCConfigLimits(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(cfg *JSONRPCConfig)
+		expPass  bool
+	}{
+		{
+			"valid limits",
+			func(cfg *JSONRPCConfig) {
+				cfg.DenyMethods = []string{"eth_sendTransaction"}
+				cfg.RateLimit = 10
+				cfg.MethodRateLimits = []string{"debug_traceBlockByNumber:0.5:2", "eth_getLogs:5:10"}
+			},
+			true,
+		},
+		{
+			"method allowed and denied",
+			func(cfg *JSONRPCConfig) {
+				cfg.AllowMethods = []string{"eth_sendTransaction"}
+				cfg.DenyMethods = []string{"eth_sendTransaction"}
+			},
+			false,
+		},
+		{
+			"negative rate limit",
+			func(cfg *JSONRPCConfig) {
+				cfg.RateLimit = -1
+			},
+			false,
+		},
+		{
+			"negative rate limit burst",
+			func(cfg *JSONRPCConfig) {
+				cfg.RateLimitBurst = -1
+			},
+			false,
+		},
+		{
+			"invalid method rate limit format",
+			func(cfg *JSONRPCConfig) {
+				cfg.MethodRateLimits = []string{"eth_getLogs:5"}
+			},
+			false,
+		},
+		{
+			"invalid method rate",
+			func(cfg *JSONRPCConfig) {
+				cfg.MethodRateLimits = []string{"eth_getLogs:0:10"}
+			},
+			false,
+		},
+		{
+			"invalid method burst",
+			func(cfg *JSONRPCConfig) {
+				cfg.MethodRateLimits = []string{"eth_getLogs:5:x"}
+			},
+			false,
+		},
+		{
+			"negative batch request limit",
+			func(cfg *JSONRPCConfig) {
+				cfg.BatchRequestLimit = -1
+			},
+			false,
+		},
+		{
+			"negative batch response max size",
+			func(cfg *JSONRPCConfig) {
+				cfg.BatchResponseMaxSize = -1
+			},
+			false,
+		},
+		{
+			"negative response cache size",
+			func(cfg *JSONRPCConfig) {
+				cfg.ResponseCacheSize = -1
+			},
+			false,
+		},
+		{
+			"external signer socket",
+			func(cfg *JSONRPCConfig) {
+				cfg.Signer = "/home/user/.clef/clef.ipc"
+			},
+			true,
+		},
+		{
+			"external signer URL",
+			func(cfg *JSONRPCConfig) {
+				cfg.Signer = "http://127.0.0.1:8550"
+			},
+			true,
+		},
+		{
+			"invalid external signer scheme",
+			func(cfg *JSONRPCConfig) {
+				cfg.Signer = "ftp://127.0.0.1:8550"
+			},
+			false,
+		},
+		{
+			"repeated method rate limit",
+			func(cfg *JSONRPCConfig) {
+				cfg.MethodRateLimits = []string{"eth_getLogs:5:10", "eth_getLogs:1:1"}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			tc.malleate(cfg)
+
+			err := cfg.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTracingConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(cfg *TracingConfig)
+		expPass  bool
+	}{
+		{
+			"default",
+			func(cfg *TracingConfig) {},
+			true,
+		},
+		{
+			"file exporter",
+			func(cfg *TracingConfig) {
+				cfg.Exporter = "file"
+				cfg.SampleRatio = 0.1
+			},
+			true,
+		},
+		{
+			"invalid exporter",
+			func(cfg *TracingConfig) {
+				cfg.Exporter = "zipkin"
+			},
+			false,
+		},
+		{
+			"empty endpoint",
+			func(cfg *TracingConfig) {
+				cfg.Endpoint = ""
+			},
+			false,
+		},
+		{
+			"empty file path",
+			func(cfg *TracingConfig) {
+				cfg.Exporter = "file"
+				cfg.FilePath = ""
+			},
+			false,
+		},
+		{
+			"sample ratio above one",
+			func(cfg *TracingConfig) {
+				cfg.SampleRatio = 1.5
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultTracingConfig()
+			tc.malleate(cfg)
+
+			err := cfg.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
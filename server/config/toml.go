@@ -59,7 +59,7 @@ evm-timeout = "{{ .JSONRPC.EVMTimeout }}"
 # TxFeeCap is the global tx-fee cap for send transaction. Default: 1eth.
 txfee-cap = {{ .JSONRPC.TxFeeCap }}
 
-# FilterCap sets the global cap for total number of filters that can be created
+# FilterCap sets the cap for the number of filters and subscriptions that each client, identified by its IP, can create
 filter-cap = {{ .JSONRPC.FilterCap }}
 
 # FeeHistoryCap sets the global cap for total number of blocks that can be fetched
@@ -95,6 +95,62 @@ metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 # Upgrade height for fix of revert gas refund logic when transaction reverted.
 fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
+# JWTSecret defines the path to the hex encoded secret used to authenticate the JSON-RPC and
+# WebSocket requests with HS256 JWT tokens (Authorization: Bearer <token>). A new secret is
+# generated if the file doesn't exist. Authentication is disabled if empty.
+jwt-secret = "{{ .JSONRPC.JWTSecret }}"
+
+# AuthAPI defines a list of JSON-RPC namespaces that require a JWT token, the other namespaces
+# stay public. All the namespaces require it if empty and the jwt-secret is set.
+# Example: "personal,debug"
+auth-api = "{{range $index, $elmt := .JSONRPC.AuthAPI}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# AllowMethods defines a list of JSON-RPC methods that can be called, over both HTTP and WebSocket.
+# All the methods of the enabled namespaces can be called if empty.
+allow-methods = "{{range $index, $elmt := .JSONRPC.AllowMethods}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# DenyMethods defines a list of JSON-RPC methods that cannot be called, over both HTTP and WebSocket.
+# Example: "eth_sendTransaction,personal_sign"
+deny-methods = "{{range $index, $elmt := .JSONRPC.DenyMethods}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# RateLimit defines the maximum number of requests per second per client IP, including each
+# element of the batch requests (0 = unlimited).
+rate-limit = {{ .JSONRPC.RateLimit }}
+
+# RateLimitBurst defines the maximum number of requests per client IP allowed in a burst
+# (0 = the rate limit rounded up).
+rate-limit-burst = {{ .JSONRPC.RateLimitBurst }}
+
+# MethodRateLimits defines the rate limits per client IP of specific methods, formatted as
+# "method:rate:burst", where rate is the number of requests per second.
+# Example: "debug_traceBlockByNumber:0.5:2,eth_getLogs:5:10"
+method-rate-limits = "{{range $index, $elmt := .JSONRPC.MethodRateLimits}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# BatchRequestLimit defines the maximum number of calls in a batch request, over both HTTP and
+# WebSocket (0 = unlimited).
+batch-request-limit = {{ .JSONRPC.BatchRequestLimit }}
+
+# BatchResponseMaxSize defines the maximum size in bytes of a batch response. The calls remaining
+# once the limit is exceeded are answered with an error (0 = unlimited).
+batch-response-max-size = {{ .JSONRPC.BatchResponseMaxSize }}
+
+# IPCPath defines the path of the unix domain socket serving all the enabled JSON-RPC namespaces
+# to the local processes, relative to the node home directory if not absolute. The socket is only
+# accessible by the node user. The IPC server is disabled if empty.
+# Example: "ethermint.ipc"
+ipc-path = "{{ .JSONRPC.IPCPath }}"
+
+# ResponseCacheSize defines the maximum number of immutable responses kept in memory, such as the
+# blocks at a given height, the receipts of mined transactions or the code at a given height
+# (0 = disabled).
+response-cache-size = {{ .JSONRPC.ResponseCacheSize }}
+
+# Signer defines the endpoint of a Clef-compatible external signer, either a unix socket path or an
+# HTTP URL. The signing methods of the eth and personal namespaces use it instead of the node
+# keyring if not empty.
+# Example: "/home/user/.clef/clef.ipc"
+signer = "{{ .JSONRPC.Signer }}"
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
@@ -106,4 +162,34 @@ certificate-path = "{{ .TLS.CertificatePath }}"
 
 # Key path defines the key.pem file path for the TLS configuration.
 key-path = "{{ .TLS.KeyPath }}"
+
+###############################################################################
+###                           Tracing Configuration                         ###
+###############################################################################
+
+[tracing]
+
+# Enable defines if the OpenTelemetry spans of the JSON-RPC requests, the EVM gRPC queries and the
+# EVM execution are recorded and exported. The trace context is propagated from the W3C
+# traceparent header of the JSON-RPC requests.
+enable = {{ .Tracing.Enable }}
+
+# Exporter defines the span exporter. Available exporters: "otlp" (OTLP over gRPC) and "file" (JSON).
+exporter = "{{ .Tracing.Exporter }}"
+
+# Endpoint defines the address of the OTLP gRPC collector.
+endpoint = "{{ .Tracing.Endpoint }}"
+
+# Insecure disables the TLS of the connection to the OTLP collector.
+insecure = {{ .Tracing.Insecure }}
+
+# FilePath defines the file the spans are appended to with the file exporter, relative to the node
+# home directory if not absolute.
+file-path = "{{ .Tracing.FilePath }}"
+
+# SampleRatio defines the ratio of the traces sampled, unless the parent span is sampled.
+sample-ratio = {{ .Tracing.SampleRatio }}
+
+# ServiceName defines the service name reported with the spans.
+service-name = "{{ .Tracing.ServiceName }}"
 `
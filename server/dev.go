@@ -0,0 +1,175 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+	tmstrings "github.com/tendermint/tendermint/libs/strings"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/evmos/ethermint/crypto/hd"
+	"github.com/evmos/ethermint/rpc"
+	"github.com/evmos/ethermint/server/config"
+	"github.com/evmos/ethermint/server/dev"
+	srvflags "github.com/evmos/ethermint/server/flags"
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// addDevFlags adds the flags of the dev mode to the start command.
+func addDevFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(srvflags.Dev, false, "Run a single validator dev chain with funded accounts and the evm and anvil JSON-RPC namespaces, created in the home directory if it has no genesis") //nolint:lll
+	cmd.Flags().String(srvflags.DevMnemonic, dev.DefaultMnemonic, "the mnemonic of the funded accounts of the dev chain")
+	cmd.Flags().Int(srvflags.DevAccounts, dev.DefaultAccounts, "the number of funded accounts of the dev chain")
+	cmd.Flags().Uint64(srvflags.DevBalance, dev.DefaultBalance, "the balance of each funded account of the dev chain created, in tokens of 10^18 aphoton")
+	cmd.Flags().Duration(srvflags.DevBlockTime, 0, "the interval between the blocks of the dev chain (0=seal a block for each transaction)")
+	cmd.Flags().String(srvflags.DevChainID, dev.DefaultChainID, "the chain ID of the dev chain created")
+	cmd.Flags().String(srvflags.DevForkURL, "", "the gRPC address of an ethermint node whose EVM state is loaded on first access by the dev chain")
+	cmd.Flags().Int64(srvflags.DevForkHeight, 0, "the height of the forked chain (0=the latest height when the dev chain is forked)")
+}
+
+// setupDevMode prepares the node to run the dev chain: the genesis is created when the home
+// directory has none, the funded accounts are imported in a test keyring of the home directory,
+// the blocks are sealed on demand or at the block time, and every state is kept for the snapshots.
+func setupDevMode(ctx *server.Context, clientCtx client.Context, opts StartOptions) (client.Context, error) {
+	cfg := ctx.Config
+	mnemonic := ctx.Viper.GetString(srvflags.DevMnemonic)
+
+	accounts, err := dev.DeriveAccounts(mnemonic, ctx.Viper.GetInt(srvflags.DevAccounts))
+	if err != nil {
+		return clientCtx, err
+	}
+
+	if _, err := os.Stat(cfg.GenesisFile()); os.IsNotExist(err) {
+		if opts.ModuleBasics == nil {
+			return clientCtx, errors.New("the dev mode requires the module basics of the application")
+		}
+
+		err := dev.InitGenesis(clientCtx, cfg, opts.ModuleBasics, dev.GenesisOptions{
+			ChainID:  ctx.Viper.GetString(srvflags.DevChainID),
+			Mnemonic: mnemonic,
+			Accounts: accounts,
+			Balance:  sdk.TokensFromConsensusPower(int64(ctx.Viper.GetUint64(srvflags.DevBalance)), ethermint.PowerReduction),
+		})
+		if err != nil {
+			return clientCtx, fmt.Errorf("failed to create the dev chain: %w", err)
+		}
+	} else if err != nil {
+		return clientCtx, err
+	}
+
+	genDoc, err := tmtypes.GenesisDocFromFile(cfg.GenesisFile())
+	if err != nil {
+		return clientCtx, err
+	}
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, cfg.RootDir, nil, clientCtx.Codec, hd.EthSecp256k1Option())
+	if err != nil {
+		return clientCtx, err
+	}
+
+	if err := dev.ImportAccounts(kb, mnemonic, accounts); err != nil {
+		return clientCtx, err
+	}
+
+	blockTime := ctx.Viper.GetDuration(srvflags.DevBlockTime)
+	cfg.Consensus.CreateEmptyBlocks = blockTime > 0
+	cfg.Consensus.TimeoutCommit = blockTime
+	ctx.Viper.Set(server.FlagPruning, pruningtypes.PruningOptionNothing)
+
+	ctx.Logger.Info("starting dev chain", "chain-id", genDoc.ChainID, "block-time", blockTime)
+	for i, account := range accounts {
+		ctx.Logger.Info(
+			"dev account",
+			"index", i,
+			"address", account.Address().Hex(),
+			"private-key", hexutil.Encode(account.PrivKey.Bytes()),
+		)
+	}
+
+	return clientCtx.WithChainID(genDoc.ChainID).WithKeyring(kb), nil
+}
+
+// setupFork makes the EVM of the dev chain load the missing state from the remote chain, at the
+// fork height recorded in the home directory when the dev chain was forked.
+func setupFork(ctx *server.Context, clientCtx client.Context, cfg serverconfig.GRPCConfig, app dev.Application) error {
+	forkURL := ctx.Viper.GetString(srvflags.DevForkURL)
+	height := ctx.Viper.GetInt64(srvflags.DevForkHeight)
+	heightFile := filepath.Join(ctx.Config.RootDir, "data", "dev-fork-height")
+
+	if bz, err := os.ReadFile(heightFile); err == nil {
+		recorded, err := strconv.ParseInt(strings.TrimSpace(string(bz)), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid fork height in %s: %w", heightFile, err)
+		}
+		if height != 0 && height != recorded {
+			return fmt.Errorf("the dev chain was forked at height %d, not %d", recorded, height)
+		}
+		height = recorded
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	conn, err := DialGRPC(clientCtx, forkURL, insecure.NewCredentials(), cfg)
+	if err != nil {
+		return fmt.Errorf("failed to dial the forked node %s: %w", forkURL, err)
+	}
+
+	source, err := dev.NewForkSource(context.Background(), evmtypes.NewQueryClient(conn), height)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(heightFile, []byte(strconv.FormatInt(source.Height(), 10)), 0o600); err != nil {
+		return err
+	}
+
+	keeper := app.GetEVMKeeper()
+	keeper.SetStateKeeper(dev.NewForkKeeper(keeper, app.GetKey(evmtypes.StoreKey), source, ctx.Logger.With("module", "fork")))
+
+	ctx.Logger.Info("forking the EVM state", "node", forkURL, "height", source.Height())
+	return nil
+}
+
+// enableDevAPIs registers the namespaces controlling the dev node and enables them.
+func enableDevAPIs(cfg *config.JSONRPCConfig, node *dev.Node) error {
+	if err := rpc.RegisterDevAPIs(node, node.Impersonator()); err != nil {
+		return err
+	}
+
+	for _, ns := range []string{rpc.EVMNamespace, rpc.AnvilNamespace} {
+		if !tmstrings.StringInSlice(ns, cfg.API) {
+			cfg.API = append(cfg.API, ns)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+
+// Package dev runs a single validator chain for the local development of contracts: the accounts
+// derived from a known mnemonic are funded at genesis, the blocks are sealed on demand or at a
+// fixed interval, and the state and the block time can be overridden through the JSON-RPC server.
+package dev
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/crypto/hd"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+const (
+	// DefaultMnemonic is the mnemonic of the accounts of Hardhat and Anvil, so that the test suites
+	// written for them find the same accounts.
+	DefaultMnemonic = "test test test test test test test test test test test junk"
+	// DefaultAccounts is the default number of funded accounts
+	DefaultAccounts = 10
+	// DefaultBalance is the default balance of each account, in tokens of 10^18 base units
+	DefaultBalance = 10000
+	// DefaultChainID is the default chain ID of the dev chain
+	DefaultChainID = "ethermint_9000-1"
+	// BlockGasLimit is the block gas limit of the dev chain, the default of Hardhat and Anvil
+	BlockGasLimit = 30_000_000
+
+	// validatorHDPath is the HD path of the validator operator key, apart from the funded accounts
+	// so that their nonces start at zero
+	validatorHDPath = "m/44'/60'/1'/0/0"
+)
+
+// Account is an account derived from the mnemonic of the dev chain.
+type Account struct {
+	// Name is the name of the key in the node keyring
+	Name    string
+	HDPath  string
+	PrivKey cryptotypes.PrivKey
+}
+
+// Address returns the Ethereum address of the account.
+func (a Account) Address() common.Address {
+	return common.BytesToAddress(a.PrivKey.PubKey().Address())
+}
+
+// DeriveAccounts derives the given number of accounts from the mnemonic on the Ethereum HD path
+// m/44'/60'/0'/0/i.
+func DeriveAccounts(mnemonic string, count int) ([]Account, error) {
+	iterator, err := ethermint.NewHDPathIterator(ethermint.BIP44HDPath, false)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]Account, count)
+	for i := range accounts {
+		path := iterator().String()
+		accounts[i], err = deriveAccount(fmt.Sprintf("dev%03d", i), mnemonic, path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return accounts, nil
+}
+
+// validatorAccount derives the operator account of the validator from the mnemonic.
+func validatorAccount(mnemonic string) (Account, error) {
+	return deriveAccount("validator", mnemonic, validatorHDPath)
+}
+
+func deriveAccount(name, mnemonic, path string) (Account, error) {
+	bz, err := hd.EthSecp256k1.Derive()(mnemonic, "", path)
+	if err != nil {
+		return Account{}, fmt.Errorf("failed to derive the key %s: %w", path, err)
+	}
+	return Account{Name: name, HDPath: path, PrivKey: hd.EthSecp256k1.Generate()(bz)}, nil
+}
+
+// ImportAccounts adds the accounts missing from the keyring, so that the node can sign their
+// transactions.
+func ImportAccounts(kb keyring.Keyring, mnemonic string, accounts []Account) error {
+	for _, account := range accounts {
+		if _, err := kb.KeyByAddress(sdk.AccAddress(account.Address().Bytes())); err == nil {
+			continue
+		}
+		if _, err := kb.NewAccount(account.Name, mnemonic, "", account.HDPath, hd.EthSecp256k1); err != nil {
+			return fmt.Errorf("failed to import the account %s: %w", account.Name, err)
+		}
+	}
+	return nil
+}
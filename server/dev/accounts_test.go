@@ -0,0 +1,55 @@
+package dev
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/crypto/hd"
+	"github.com/evmos/ethermint/encoding"
+)
+
+func TestDeriveAccounts(t *testing.T) {
+	accounts, err := DeriveAccounts(DefaultMnemonic, 3)
+	require.NoError(t, err)
+	require.Len(t, accounts, 3)
+
+	// the accounts of Hardhat and Anvil
+	expected := []string{
+		"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		"0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+		"0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC",
+	}
+	for i, account := range accounts {
+		require.Equal(t, common.HexToAddress(expected[i]), account.Address())
+	}
+	require.Equal(t, "dev000", accounts[0].Name)
+	require.Equal(t, "m/44'/60'/0'/0/2", accounts[2].HDPath)
+
+	validator, err := validatorAccount(DefaultMnemonic)
+	require.NoError(t, err)
+	for _, account := range accounts {
+		require.NotEqual(t, account.Address(), validator.Address())
+	}
+}
+
+func TestImportAccounts(t *testing.T) {
+	encCfg := encoding.MakeConfig(nil)
+	kb := keyring.NewInMemory(encCfg.Codec, hd.EthSecp256k1Option())
+
+	accounts, err := DeriveAccounts(DefaultMnemonic, 2)
+	require.NoError(t, err)
+
+	require.NoError(t, ImportAccounts(kb, DefaultMnemonic, accounts))
+	// the accounts already imported are skipped
+	require.NoError(t, ImportAccounts(kb, DefaultMnemonic, accounts))
+
+	for _, account := range accounts {
+		record, err := kb.KeyByAddress(sdk.AccAddress(account.Address().Bytes()))
+		require.NoError(t, err)
+		require.Equal(t, account.Name, record.Name)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package dev
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// restoredStores are the stores restored when reverting to a snapshot
+var restoredStores = []string{authtypes.StoreKey, banktypes.StoreKey, evmtypes.StoreKey}
+
+// emptyDataHash is the data hash of the blocks without transactions
+var emptyDataHash = tmtypes.Txs(nil).Hash()
+
+// Application is the application run by the dev node.
+type Application interface {
+	servertypes.Application
+
+	NewContext(isCheckTx bool, header tmproto.Header) sdk.Context
+	LastBlockHeight() int64
+	GetKey(storeKey string) *storetypes.KVStoreKey
+	GetEVMKeeper() *evmkeeper.Keeper
+	SkipModuleBlockers(skip bool)
+}
+
+var _ abci.Application = (*App)(nil)
+
+// App wraps the application of the dev node to accept its mine transactions, to override the block
+// time and to apply the overrides of the state.
+type App struct {
+	Application
+	node *Node
+}
+
+// CheckTx implements abci.Application
+func (app *App) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	if app.node.isMineTx(req.Tx) {
+		return abci.ResponseCheckTx{}
+	}
+	return app.Application.CheckTx(req)
+}
+
+// BeginBlock implements abci.Application. The EVM sees the block time of the node, which is
+// reported in the events of the block, and the overrides are applied after the modules.
+//
+// Tendermint seals a new block as long as the app hash changes, and the modules change the state
+// in every block, so the module blockers are skipped in the empty blocks when sealing on demand.
+func (app *App) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	req.Header.Time = app.node.blockTime(req.Header.Time)
+
+	skip := app.node.onDemand && bytes.Equal(req.Header.DataHash, emptyDataHash)
+	app.SkipModuleBlockers(skip)
+
+	res := app.Application.BeginBlock(req)
+	ctx := app.NewContext(false, req.Header).WithHeaderHash(req.Hash)
+	if skip {
+		// set by the EVM begin blocker otherwise
+		app.GetEVMKeeper().WithChainID(ctx)
+	}
+	app.node.applyOverrides(ctx)
+
+	res.Events = append(res.Events, abci.Event{
+		Type: evmtypes.EventTypeDevBlock,
+		Attributes: []abci.EventAttribute{{
+			Key:   []byte(evmtypes.AttributeKeyTimestamp),
+			Value: []byte(strconv.FormatInt(req.Header.Time.Unix(), 10)),
+		}},
+	})
+	return res
+}
+
+// DeliverTx implements abci.Application
+func (app *App) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	if app.node.deliverMineTx(req.Tx) {
+		return abci.ResponseDeliverTx{}
+	}
+	return app.Application.DeliverTx(req)
+}
+
+// Commit implements abci.Application
+func (app *App) Commit() abci.ResponseCommit {
+	res := app.Application.Commit()
+	app.node.commit(app.LastBlockHeight())
+	return res
+}
+
+// RegisterNodeService implements servertypes.ApplicationQueryService
+func (app *App) RegisterNodeService(clientCtx client.Context) {
+	if a, ok := app.Application.(servertypes.ApplicationQueryService); ok {
+		a.RegisterNodeService(clientCtx)
+	}
+}
+
+// restore sets the stores of the snapshot state to the state at the given height.
+func restore(ctx sdk.Context, app Application, height int64) error {
+	snapshot, err := app.CommitMultiStore().CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range restoredStores {
+		key := app.GetKey(name)
+		restoreStore(ctx.KVStore(key), snapshot.GetKVStore(key))
+	}
+	return nil
+}
+
+// restoreStore sets the content of the store to the content of the snapshot.
+func restoreStore(store, snapshot sdk.KVStore) {
+	var deleted [][]byte
+	it := store.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		if !snapshot.Has(it.Key()) {
+			deleted = append(deleted, append([]byte{}, it.Key()...))
+		}
+	}
+	it.Close()
+
+	for _, key := range deleted {
+		store.Delete(key)
+	}
+
+	it = snapshot.Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if !bytes.Equal(store.Get(it.Key()), it.Value()) {
+			store.Set(it.Key(), it.Value())
+		}
+	}
+}
@@ -0,0 +1,338 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package dev
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// forkQueryTimeout is the timeout of a query to the forked node
+const forkQueryTimeout = 30 * time.Second
+
+// prefix bytes of the EVM store recording the state loaded from the forked chain, apart from the
+// prefixes of the EVM module
+const (
+	prefixForkAccount = 0xf0
+	prefixForkStorage = 0xf1
+)
+
+// values of the records of the loaded state
+var (
+	forkLoaded = []byte{1}
+	// forkDeleted records the accounts deleted locally, whose storage isn't loaded anymore
+	forkDeleted = []byte{2}
+)
+
+// ForkSource queries the state of a remote chain at the fork height, and caches the responses.
+type ForkSource struct {
+	client evmtypes.QueryClient
+	height int64
+
+	mu       sync.Mutex
+	accounts map[common.Address]forkAccount
+	storage  map[common.Address]map[common.Hash]common.Hash
+}
+
+// forkAccount is an account of the forked chain with its code.
+type forkAccount struct {
+	account statedb.Account
+	code    []byte
+}
+
+// NewForkSource returns the state of the remote chain at the given height, or at its latest height
+// if the height is zero.
+func NewForkSource(ctx context.Context, client evmtypes.QueryClient, height int64) (*ForkSource, error) {
+	if height < 0 {
+		return nil, fmt.Errorf("invalid fork height %d", height)
+	}
+
+	if height == 0 {
+		var header metadata.MD
+		if _, err := client.Params(ctx, &evmtypes.QueryParamsRequest{}, grpc.Header(&header)); err != nil {
+			return nil, fmt.Errorf("failed to query the forked node: %w", err)
+		}
+
+		heights := header.Get(grpctypes.GRPCBlockHeightHeader)
+		if len(heights) != 1 {
+			return nil, fmt.Errorf("the forked node didn't return the height of the query")
+		}
+
+		var err error
+		if height, err = strconv.ParseInt(heights[0], 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid height returned by the forked node: %w", err)
+		}
+	}
+
+	return &ForkSource{
+		client:   client,
+		height:   height,
+		accounts: make(map[common.Address]forkAccount),
+		storage:  make(map[common.Address]map[common.Hash]common.Hash),
+	}, nil
+}
+
+// Height returns the fork height.
+func (s *ForkSource) Height() int64 {
+	return s.height
+}
+
+// account returns the account of the forked chain with its code.
+func (s *ForkSource) account(addr common.Address) (forkAccount, error) {
+	s.mu.Lock()
+	acct, ok := s.accounts[addr]
+	s.mu.Unlock()
+	if ok {
+		return acct, nil
+	}
+
+	ctx, cancel := s.queryContext()
+	defer cancel()
+
+	res, err := s.client.Account(ctx, &evmtypes.QueryAccountRequest{Address: addr.Hex()})
+	if err != nil {
+		return forkAccount{}, err
+	}
+
+	balance, ok := new(big.Int).SetString(res.Balance, 10)
+	if !ok {
+		return forkAccount{}, fmt.Errorf("invalid balance %q", res.Balance)
+	}
+
+	acct.account = statedb.Account{
+		Nonce:    res.Nonce,
+		Balance:  balance,
+		CodeHash: common.HexToHash(res.CodeHash).Bytes(),
+	}
+
+	if acct.account.IsContract() {
+		code, err := s.client.Code(ctx, &evmtypes.QueryCodeRequest{Address: addr.Hex()})
+		if err != nil {
+			return forkAccount{}, err
+		}
+		acct.code = code.Code
+	}
+
+	s.mu.Lock()
+	s.accounts[addr] = acct
+	s.mu.Unlock()
+	return acct, nil
+}
+
+// state returns a storage slot of the account in the forked chain.
+func (s *ForkSource) state(addr common.Address, key common.Hash) (common.Hash, error) {
+	s.mu.Lock()
+	value, ok := s.storage[addr][key]
+	s.mu.Unlock()
+	if ok {
+		return value, nil
+	}
+
+	ctx, cancel := s.queryContext()
+	defer cancel()
+
+	res, err := s.client.Storage(ctx, &evmtypes.QueryStorageRequest{Address: addr.Hex(), Key: key.Hex()})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	value = common.HexToHash(res.Value)
+
+	s.mu.Lock()
+	if s.storage[addr] == nil {
+		s.storage[addr] = make(map[common.Hash]common.Hash)
+	}
+	s.storage[addr][key] = value
+	s.mu.Unlock()
+	return value, nil
+}
+
+func (s *ForkSource) queryContext() (context.Context, context.CancelFunc) {
+	ctx := metadata.AppendToOutgoingContext(
+		context.Background(), grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(s.height, 10),
+	)
+	return context.WithTimeout(ctx, forkQueryTimeout)
+}
+
+// ForkError is the error of a query to the forked node. The ForkKeeper panics with it when the
+// state can't be loaded, so that the transaction or the query accessing the state fails instead of
+// running against an empty state, and the state isn't recorded as loaded.
+type ForkError struct {
+	Err error
+}
+
+func (e *ForkError) Error() string {
+	return fmt.Sprintf("failed to load the state of the forked chain: %s", e.Err)
+}
+
+func (e *ForkError) Unwrap() error {
+	return e.Err
+}
+
+// recoverForkError recovers a panic of the ForkKeeper into the error, any other panic is
+// propagated.
+func recoverForkError(err *error) {
+	if r := recover(); r != nil {
+		forkErr, ok := r.(*ForkError)
+		if !ok {
+			panic(r)
+		}
+		*err = forkErr
+	}
+}
+
+var _ statedb.Keeper = (*ForkKeeper)(nil)
+
+// ForkKeeper is the storage of the StateDB of a dev chain forked from a remote chain: the accounts
+// and the storage slots are loaded from the forked chain on first access, unless they exist in the
+// local genesis, and written to the local state. The storage of the forked accounts can't be
+// iterated, only the slots already accessed are. The keeper panics with a ForkError if the forked
+// node can't be queried, which the transactions and the queries recover from as a failure.
+type ForkKeeper struct {
+	statedb.Keeper
+
+	storeKey storetypes.StoreKey
+	source   *ForkSource
+	logger   log.Logger
+}
+
+// NewForkKeeper returns the storage of the StateDB loading the missing state from the source, and
+// recording the loaded state in the given store.
+func NewForkKeeper(keeper statedb.Keeper, storeKey storetypes.StoreKey, source *ForkSource, logger log.Logger) *ForkKeeper {
+	return &ForkKeeper{
+		Keeper:   keeper,
+		storeKey: storeKey,
+		source:   source,
+		logger:   logger,
+	}
+}
+
+// GetAccount implements statedb.Keeper
+func (k *ForkKeeper) GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account {
+	k.loadAccount(ctx, addr)
+	return k.Keeper.GetAccount(ctx, addr)
+}
+
+// GetState implements statedb.Keeper
+func (k *ForkKeeper) GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash {
+	k.loadState(ctx, addr, key)
+	return k.Keeper.GetState(ctx, addr, key)
+}
+
+// SetAccount implements statedb.Keeper
+func (k *ForkKeeper) SetAccount(ctx sdk.Context, addr common.Address, account statedb.Account) error {
+	accounts := k.accountStore(ctx)
+	if !accounts.Has(addr.Bytes()) {
+		accounts.Set(addr.Bytes(), forkLoaded)
+	}
+	return k.Keeper.SetAccount(ctx, addr, account)
+}
+
+// SetState implements statedb.Keeper
+func (k *ForkKeeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte) {
+	k.stateStore(ctx, addr).Set(key.Bytes(), forkLoaded)
+	k.Keeper.SetState(ctx, addr, key, value)
+}
+
+// DeleteAccount implements statedb.Keeper. The storage of the account isn't loaded anymore.
+func (k *ForkKeeper) DeleteAccount(ctx sdk.Context, addr common.Address) error {
+	k.accountStore(ctx).Set(addr.Bytes(), forkDeleted)
+	return k.Keeper.DeleteAccount(ctx, addr)
+}
+
+// loadAccount writes the account of the forked chain to the local state, if it wasn't loaded yet
+// and it doesn't exist locally. It panics with a ForkError if the account can't be loaded.
+func (k *ForkKeeper) loadAccount(ctx sdk.Context, addr common.Address) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	accounts := k.accountStore(ctx)
+	if accounts.Has(addr.Bytes()) {
+		return
+	}
+
+	if k.Keeper.GetAccount(ctx, addr) != nil {
+		accounts.Set(addr.Bytes(), forkLoaded)
+		return
+	}
+
+	acct, err := k.source.account(addr)
+	if err != nil {
+		k.logger.Error("failed to load the account of the forked chain", "address", addr, "error", err.Error())
+		panic(&ForkError{Err: err})
+	}
+
+	accounts.Set(addr.Bytes(), forkLoaded)
+	if acct.account.IsContract() {
+		k.Keeper.SetCode(ctx, acct.account.CodeHash, acct.code)
+	}
+	if acct.account.Nonce == 0 && acct.account.Balance.Sign() == 0 && !acct.account.IsContract() {
+		return
+	}
+	if err := k.Keeper.SetAccount(ctx, addr, acct.account); err != nil {
+		panic(&ForkError{Err: err})
+	}
+}
+
+// loadState writes the storage slot of the forked chain to the local state, if it wasn't loaded
+// yet and the account wasn't deleted. It panics with a ForkError if the slot can't be loaded.
+func (k *ForkKeeper) loadState(ctx sdk.Context, addr common.Address, key common.Hash) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	slots := k.stateStore(ctx, addr)
+	if slots.Has(key.Bytes()) {
+		return
+	}
+
+	if bytes.Equal(k.accountStore(ctx).Get(addr.Bytes()), forkDeleted) {
+		slots.Set(key.Bytes(), forkLoaded)
+		return
+	}
+
+	value, err := k.source.state(addr, key)
+	if err != nil {
+		k.logger.Error("failed to load the storage of the forked chain", "address", addr, "key", key, "error", err.Error())
+		panic(&ForkError{Err: err})
+	}
+
+	slots.Set(key.Bytes(), forkLoaded)
+	if value != (common.Hash{}) && k.Keeper.GetState(ctx, addr, key) == (common.Hash{}) {
+		k.Keeper.SetState(ctx, addr, key, value.Bytes())
+	}
+}
+
+func (k *ForkKeeper) accountStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte{prefixForkAccount})
+}
+
+func (k *ForkKeeper) stateStore(ctx sdk.Context, addr common.Address) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{prefixForkStorage}, addr.Bytes()...))
+}
@@ -0,0 +1,231 @@
+package dev
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/evmos/ethermint/app"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// forkServer stands in for the EVM queries of the forked node.
+type forkServer struct {
+	evmtypes.UnimplementedQueryServer
+
+	mu       sync.Mutex
+	err      error
+	queries  int
+	heights  []string
+	accounts map[common.Address]*evmtypes.QueryAccountResponse
+	code     map[common.Address][]byte
+	storage  map[common.Hash]common.Hash
+}
+
+// record records the query and returns the error set for the queries.
+func (s *forkServer) record(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.queries++
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.heights = append(s.heights, md.Get(grpctypes.GRPCBlockHeightHeader)...)
+	return s.err
+}
+
+func (s *forkServer) setError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+func (s *forkServer) Account(ctx context.Context, req *evmtypes.QueryAccountRequest) (*evmtypes.QueryAccountResponse, error) {
+	if err := s.record(ctx); err != nil {
+		return nil, err
+	}
+	if res, ok := s.accounts[common.HexToAddress(req.Address)]; ok {
+		return res, nil
+	}
+	return &evmtypes.QueryAccountResponse{Balance: "0", CodeHash: common.BytesToHash(evmtypes.EmptyCodeHash).Hex()}, nil
+}
+
+func (s *forkServer) Code(ctx context.Context, req *evmtypes.QueryCodeRequest) (*evmtypes.QueryCodeResponse, error) {
+	if err := s.record(ctx); err != nil {
+		return nil, err
+	}
+	return &evmtypes.QueryCodeResponse{Code: s.code[common.HexToAddress(req.Address)]}, nil
+}
+
+func (s *forkServer) Storage(ctx context.Context, req *evmtypes.QueryStorageRequest) (*evmtypes.QueryStorageResponse, error) {
+	if err := s.record(ctx); err != nil {
+		return nil, err
+	}
+	return &evmtypes.QueryStorageResponse{Value: s.storage[common.HexToHash(req.Key)].Hex()}, nil
+}
+
+func (s *forkServer) Params(ctx context.Context, _ *evmtypes.QueryParamsRequest) (*evmtypes.QueryParamsResponse, error) {
+	if err := grpc.SetHeader(ctx, metadata.Pairs(grpctypes.GRPCBlockHeightHeader, "42")); err != nil {
+		return nil, err
+	}
+	return &evmtypes.QueryParamsResponse{Params: evmtypes.DefaultParams()}, nil
+}
+
+func (s *forkServer) numQueries() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.queries
+}
+
+// startForkServer starts the stand-in of the forked node and returns a client of its queries.
+func startForkServer(t *testing.T, srv *forkServer) evmtypes.QueryClient {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	grpcSrv := grpc.NewServer()
+	evmtypes.RegisterQueryServer(grpcSrv, srv)
+	go func() {
+		_ = grpcSrv.Serve(ln)
+	}()
+	t.Cleanup(grpcSrv.Stop)
+
+	conn, err := grpc.Dial(ln.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return evmtypes.NewQueryClient(conn)
+}
+
+func TestForkKeeper(t *testing.T) {
+	code := []byte{0x60, 0x00, 0x54}
+	whale := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	contract := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	slot := common.BigToHash(common.Big1)
+
+	srv := &forkServer{
+		accounts: map[common.Address]*evmtypes.QueryAccountResponse{
+			whale:    {Balance: "1000", Nonce: 3, CodeHash: common.BytesToHash(evmtypes.EmptyCodeHash).Hex()},
+			contract: {Balance: "0", Nonce: 1, CodeHash: crypto.Keccak256Hash(code).Hex()},
+		},
+		code:    map[common.Address][]byte{contract: code},
+		storage: map[common.Hash]common.Hash{slot: common.BigToHash(common.Big2)},
+	}
+	client := startForkServer(t, srv)
+
+	source, err := NewForkSource(context.Background(), client, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), source.Height())
+
+	ethermintApp := app.Setup(false, nil)
+	ctx := ethermintApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "ethermint_9000-1"})
+	evmKeeper := ethermintApp.EvmKeeper
+	k := NewForkKeeper(evmKeeper, ethermintApp.GetKey(evmtypes.StoreKey), source, log.NewNopLogger())
+	evmKeeper.SetStateKeeper(k)
+
+	acct := k.GetAccount(ctx, whale)
+	require.NotNil(t, acct)
+	require.Equal(t, int64(1000), acct.Balance.Int64())
+	require.Equal(t, uint64(3), acct.Nonce)
+	// the account is written to the local state
+	require.Equal(t, int64(1000), evmKeeper.GetBalance(ctx, whale).Int64())
+
+	acct = k.GetAccount(ctx, contract)
+	require.NotNil(t, acct)
+	require.True(t, acct.IsContract())
+	require.Equal(t, code, k.GetCode(ctx, common.BytesToHash(acct.CodeHash)))
+	require.Equal(t, common.BigToHash(common.Big2), k.GetState(ctx, contract, slot))
+
+	// the state queries read the forked state
+	res, err := evmKeeper.Storage(ctx, &evmtypes.QueryStorageRequest{Address: contract.Hex(), Key: slot.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, common.BigToHash(common.Big2).Hex(), res.Value)
+
+	// the loaded state isn't queried again
+	queries := srv.numQueries()
+	k.GetAccount(ctx, whale)
+	k.GetState(ctx, contract, slot)
+	require.Equal(t, queries, srv.numQueries())
+
+	// the local changes are kept
+	k.SetState(ctx, contract, slot, common.BigToHash(common.Big3).Bytes())
+	require.Equal(t, common.BigToHash(common.Big3), k.GetState(ctx, contract, slot))
+
+	// the storage of the deleted accounts isn't loaded anymore
+	require.NoError(t, k.DeleteAccount(ctx, contract))
+	require.Nil(t, k.GetAccount(ctx, contract))
+	require.Equal(t, common.Hash{}, k.GetState(ctx, contract, common.BigToHash(common.Big2)))
+
+	// the accounts missing from the forked chain stay empty
+	require.Nil(t, k.GetAccount(ctx, common.HexToAddress("0x5555555555555555555555555555555555555555")))
+
+	for _, height := range srv.heights {
+		require.Equal(t, "42", height)
+	}
+}
+
+func TestForkKeeperQueryError(t *testing.T) {
+	whale := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	slot := common.BigToHash(common.Big1)
+
+	srv := &forkServer{
+		accounts: map[common.Address]*evmtypes.QueryAccountResponse{
+			whale: {Balance: "1000", Nonce: 3, CodeHash: common.BytesToHash(evmtypes.EmptyCodeHash).Hex()},
+		},
+		storage: map[common.Hash]common.Hash{slot: common.BigToHash(common.Big2)},
+	}
+	client := startForkServer(t, srv)
+
+	source, err := NewForkSource(context.Background(), client, 0)
+	require.NoError(t, err)
+
+	ethermintApp := app.Setup(false, nil)
+	ctx := ethermintApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "ethermint_9000-1"})
+	evmKeeper := ethermintApp.EvmKeeper
+	k := NewForkKeeper(evmKeeper, ethermintApp.GetKey(evmtypes.StoreKey), source, log.NewNopLogger())
+	evmKeeper.SetStateKeeper(k)
+
+	srv.setError(status.Error(codes.Unavailable, "connection refused"))
+
+	// the state isn't read as empty when the forked node fails
+	requireForkError := func(access func()) {
+		t.Helper()
+		defer func() {
+			r := recover()
+			require.NotNil(t, r)
+			forkErr, ok := r.(*ForkError)
+			require.True(t, ok, "unexpected panic %v", r)
+			require.Equal(t, codes.Unavailable, status.Code(forkErr.Err))
+		}()
+		access()
+	}
+	requireForkError(func() { k.GetAccount(ctx, whale) })
+	requireForkError(func() { k.GetState(ctx, whale, slot) })
+
+	// the failure is returned by the state overrides
+	err = func() (err error) {
+		defer recoverForkError(&err)
+		k.GetAccount(ctx, whale)
+		return nil
+	}()
+	var forkErr *ForkError
+	require.ErrorAs(t, err, &forkErr)
+
+	// the state isn't recorded as loaded, and it is loaded once the forked node is back
+	srv.setError(nil)
+	acct := k.GetAccount(ctx, whale)
+	require.NotNil(t, acct)
+	require.Equal(t, int64(1000), acct.Balance.Int64())
+	require.Equal(t, common.BigToHash(common.Big2), k.GetState(ctx, whale, slot))
+}
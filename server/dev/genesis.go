@@ -0,0 +1,211 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package dev
+
+import (
+	"encoding/json"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	mintypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	tmconfig "github.com/tendermint/tendermint/config"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/evmos/ethermint/crypto/hd"
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// GenesisOptions defines the chain created by InitGenesis.
+type GenesisOptions struct {
+	ChainID  string
+	Mnemonic string
+	Accounts []Account
+	// Balance is the balance of each account, in the base denomination
+	Balance sdkmath.Int
+}
+
+// InitGenesis writes the genesis file of a single validator chain, where the accounts are funded
+// and the validator is created from the key of the node. The validator operator is derived from
+// the mnemonic.
+func InitGenesis(clientCtx client.Context, cfg *tmconfig.Config, mbm module.BasicManager, opts GenesisOptions) error {
+	_, valPubKey, err := genutil.InitializeNodeValidatorFiles(cfg)
+	if err != nil {
+		return err
+	}
+
+	operator, err := validatorAccount(opts.Mnemonic)
+	if err != nil {
+		return err
+	}
+
+	var (
+		denom       = ethermint.AttoPhoton
+		genAccounts []authtypes.GenesisAccount
+		genBalances []banktypes.Balance
+	)
+
+	addAccount := func(addr sdk.AccAddress, amount sdkmath.Int) {
+		genAccounts = append(genAccounts, &ethermint.EthAccount{
+			BaseAccount: authtypes.NewBaseAccount(addr, nil, 0, 0),
+			CodeHash:    common.BytesToHash(evmtypes.EmptyCodeHash).Hex(),
+		})
+		genBalances = append(genBalances, banktypes.Balance{
+			Address: addr.String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(denom, amount)),
+		})
+	}
+
+	for _, account := range opts.Accounts {
+		addAccount(account.Address().Bytes(), opts.Balance)
+	}
+	operatorAddr := sdk.AccAddress(operator.Address().Bytes())
+	addAccount(operatorAddr, sdk.TokensFromConsensusPower(5000, ethermint.PowerReduction))
+
+	createValMsg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(operatorAddr),
+		valPubKey,
+		sdk.NewCoin(denom, sdk.TokensFromConsensusPower(100, ethermint.PowerReduction)),
+		stakingtypes.NewDescription(cfg.Moniker, "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.OneDec(), sdk.OneDec(), sdk.OneDec()),
+		sdk.OneInt(),
+	)
+	if err != nil {
+		return err
+	}
+
+	kb := keyring.NewInMemory(clientCtx.Codec, hd.EthSecp256k1Option())
+	if _, err := kb.NewAccount(operator.Name, opts.Mnemonic, "", operator.HDPath, hd.EthSecp256k1); err != nil {
+		return err
+	}
+
+	txBuilder := clientCtx.TxConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(createValMsg); err != nil {
+		return err
+	}
+
+	txFactory := tx.Factory{}.
+		WithChainID(opts.ChainID).
+		WithKeybase(kb).
+		WithTxConfig(clientCtx.TxConfig)
+
+	if err := tx.Sign(txFactory, operator.Name, txBuilder, true); err != nil {
+		return err
+	}
+
+	appGenState, err := genesisState(clientCtx, mbm, denom, genAccounts, genBalances)
+	if err != nil {
+		return err
+	}
+
+	appGenState, err = genutil.SetGenTxsInAppGenesisState(
+		clientCtx.Codec, clientCtx.TxConfig.TxJSONEncoder(), appGenState, []sdk.Tx{txBuilder.GetTx()},
+	)
+	if err != nil {
+		return err
+	}
+
+	appGenStateJSON, err := json.MarshalIndent(appGenState, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	genDoc := &tmtypes.GenesisDoc{
+		GenesisTime:     tmtime.Now(),
+		ChainID:         opts.ChainID,
+		ConsensusParams: tmtypes.DefaultConsensusParams(),
+		AppState:        appGenStateJSON,
+	}
+	// the EVM requires a block gas limit
+	genDoc.ConsensusParams.Block.MaxGas = BlockGasLimit
+
+	return genutil.ExportGenesisFile(genDoc, cfg.GenesisFile())
+}
+
+// genesisState returns the default genesis state of the modules with the accounts and the
+// denomination of the dev chain.
+func genesisState(
+	clientCtx client.Context,
+	mbm module.BasicManager,
+	denom string,
+	genAccounts []authtypes.GenesisAccount,
+	genBalances []banktypes.Balance,
+) (map[string]json.RawMessage, error) {
+	cdc := clientCtx.Codec
+	appGenState := mbm.DefaultGenesis(cdc)
+
+	var authGenState authtypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[authtypes.ModuleName], &authGenState)
+
+	accounts, err := authtypes.PackAccounts(genAccounts)
+	if err != nil {
+		return nil, err
+	}
+
+	authGenState.Accounts = accounts
+	appGenState[authtypes.ModuleName] = cdc.MustMarshalJSON(&authGenState)
+
+	var bankGenState banktypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[banktypes.ModuleName], &bankGenState)
+
+	bankGenState.Balances = genBalances
+	appGenState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenState)
+
+	var stakingGenState stakingtypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[stakingtypes.ModuleName], &stakingGenState)
+
+	stakingGenState.Params.BondDenom = denom
+	appGenState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(&stakingGenState)
+
+	var govGenState govv1.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[govtypes.ModuleName], &govGenState)
+
+	govGenState.DepositParams.MinDeposit[0].Denom = denom
+	appGenState[govtypes.ModuleName] = cdc.MustMarshalJSON(&govGenState)
+
+	var mintGenState mintypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[mintypes.ModuleName], &mintGenState)
+
+	mintGenState.Params.MintDenom = denom
+	appGenState[mintypes.ModuleName] = cdc.MustMarshalJSON(&mintGenState)
+
+	var crisisGenState crisistypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[crisistypes.ModuleName], &crisisGenState)
+
+	crisisGenState.ConstantFee.Denom = denom
+	appGenState[crisistypes.ModuleName] = cdc.MustMarshalJSON(&crisisGenState)
+
+	var evmGenState evmtypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[evmtypes.ModuleName], &evmGenState)
+
+	evmGenState.Params.EvmDenom = denom
+	appGenState[evmtypes.ModuleName] = cdc.MustMarshalJSON(&evmGenState)
+
+	return appGenState, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package dev
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/rpc/signer"
+)
+
+// Impersonator holds the accounts impersonated on the dev node, which can send transactions
+// without a valid signature.
+type Impersonator struct {
+	mu       sync.RWMutex
+	accounts map[common.Address]bool
+}
+
+// NewImpersonator returns an impersonator without impersonated accounts.
+func NewImpersonator() *Impersonator {
+	return &Impersonator{accounts: make(map[common.Address]bool)}
+}
+
+// Impersonate allows the account to send transactions without signing them.
+func (i *Impersonator) Impersonate(addr common.Address) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.accounts[addr] = true
+}
+
+// StopImpersonating requires the transactions of the account to be signed again.
+func (i *Impersonator) StopImpersonating(addr common.Address) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	delete(i.accounts, addr)
+}
+
+// IsImpersonated returns true if the account can send transactions without signing them.
+func (i *Impersonator) IsImpersonated(addr common.Address) bool {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.accounts[addr]
+}
+
+// Signature returns the signature of the transactions sent on behalf of an impersonated account:
+// the R value is the address of the account and the S value is zero, which a valid signature never
+// has.
+func (i *Impersonator) Signature(addr common.Address) []byte {
+	sig := make([]byte, 65)
+	copy(sig[12:32], addr.Bytes())
+	return sig
+}
+
+// Signer wraps the signer to also recover the sender of the transactions of the impersonated
+// accounts.
+func (i *Impersonator) Signer(signer ethtypes.Signer) ethtypes.Signer {
+	return impersonationSigner{Signer: signer, impersonator: i}
+}
+
+// impersonationSigner wraps a signer to return the impersonated account of the transactions
+// carrying an impersonated signature.
+type impersonationSigner struct {
+	ethtypes.Signer
+	impersonator *Impersonator
+}
+
+// Sender implements ethtypes.Signer
+func (s impersonationSigner) Sender(tx *ethtypes.Transaction) (common.Address, error) {
+	_, r, sv := tx.RawSignatureValues()
+	if sv == nil || sv.Sign() != 0 || r == nil || r.Sign() == 0 || r.BitLen() > common.AddressLength*8 {
+		return s.Signer.Sender(tx)
+	}
+
+	if tx.Protected() && tx.ChainId().Cmp(s.ChainID()) != 0 {
+		return common.Address{}, ethtypes.ErrInvalidChainId
+	}
+
+	addr := common.BigToAddress(r)
+	if !s.impersonator.IsImpersonated(addr) {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+	return addr, nil
+}
+
+// Equal implements ethtypes.Signer
+func (s impersonationSigner) Equal(other ethtypes.Signer) bool {
+	o, ok := other.(impersonationSigner)
+	return ok && s.impersonator == o.impersonator && s.Signer.Equal(o.Signer)
+}
+
+// TxSigner wraps the signer of the JSON-RPC server to send the transactions of the impersonated
+// accounts with their impersonated signature.
+func (i *Impersonator) TxSigner(s signer.Signer) signer.Signer {
+	return impersonationTxSigner{Signer: s, impersonator: i}
+}
+
+// impersonationTxSigner wraps a signer to also sign the transactions of the impersonated accounts.
+type impersonationTxSigner struct {
+	signer.Signer
+	impersonator *Impersonator
+}
+
+// CheckAccount implements signer.Signer
+func (s impersonationTxSigner) CheckAccount(address common.Address) error {
+	if s.impersonator.IsImpersonated(address) {
+		return nil
+	}
+	return s.Signer.CheckAccount(address)
+}
+
+// SignTx implements signer.Signer
+func (s impersonationTxSigner) SignTx(from common.Address, tx *ethtypes.Transaction, ethSigner ethtypes.Signer) (*ethtypes.Transaction, error) {
+	if s.impersonator.IsImpersonated(from) {
+		return tx.WithSignature(ethSigner, s.impersonator.Signature(from))
+	}
+	return s.Signer.SignTx(from, tx, ethSigner)
+}
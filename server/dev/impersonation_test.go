@@ -0,0 +1,106 @@
+package dev
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/rpc/signer"
+)
+
+func TestImpersonationSigner(t *testing.T) {
+	chainID := big.NewInt(9000)
+	addr := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	newTx := func(chainID *big.Int) *ethtypes.Transaction {
+		return ethtypes.NewTx(&ethtypes.DynamicFeeTx{ChainID: chainID, Nonce: 1, Gas: 21000, To: &to})
+	}
+
+	impersonator := NewImpersonator()
+	impersonator.Impersonate(addr)
+	require.True(t, impersonator.IsImpersonated(addr))
+
+	signer := impersonator.Signer(ethtypes.LatestSignerForChainID(chainID))
+	require.True(t, signer.Equal(impersonator.Signer(ethtypes.LatestSignerForChainID(chainID))))
+	require.False(t, signer.Equal(ethtypes.LatestSignerForChainID(chainID)))
+	require.False(t, signer.Equal(NewImpersonator().Signer(ethtypes.LatestSignerForChainID(chainID))))
+
+	tx, err := newTx(chainID).WithSignature(signer, impersonator.Signature(addr))
+	require.NoError(t, err)
+
+	sender, err := signer.Sender(tx)
+	require.NoError(t, err)
+	require.Equal(t, addr, sender)
+
+	// the standard signers don't recover the sender
+	_, err = ethtypes.LatestSignerForChainID(chainID).Sender(tx)
+	require.Error(t, err)
+
+	// the other chains reject the transaction
+	_, err = impersonator.Signer(ethtypes.LatestSignerForChainID(big.NewInt(1))).Sender(tx)
+	require.ErrorIs(t, err, ethtypes.ErrInvalidChainId)
+
+	// the accounts not impersonated can't use the signature
+	other, err := newTx(chainID).WithSignature(signer, impersonator.Signature(to))
+	require.NoError(t, err)
+	_, err = signer.Sender(other)
+	require.ErrorIs(t, err, ethtypes.ErrInvalidSig)
+
+	// the signed transactions are still recovered
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signed, err := ethtypes.SignTx(newTx(chainID), signer, key)
+	require.NoError(t, err)
+	sender, err = signer.Sender(signed)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), sender)
+
+	impersonator.StopImpersonating(addr)
+	require.False(t, impersonator.IsImpersonated(addr))
+	_, err = signer.Sender(tx)
+	require.Error(t, err)
+}
+
+// noAccountSigner is a signer without accounts.
+type noAccountSigner struct {
+	signer.Signer
+}
+
+func (noAccountSigner) CheckAccount(common.Address) error {
+	return errors.New("no account")
+}
+
+func (noAccountSigner) SignTx(common.Address, *ethtypes.Transaction, ethtypes.Signer) (*ethtypes.Transaction, error) {
+	return nil, errors.New("no account")
+}
+
+func TestImpersonationTxSigner(t *testing.T) {
+	chainID := big.NewInt(9000)
+	addr := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	tx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{ChainID: chainID, Nonce: 1, Gas: 21000, To: &to})
+	ethSigner := ethtypes.LatestSignerForChainID(chainID)
+
+	impersonator := NewImpersonator()
+	txSigner := impersonator.TxSigner(noAccountSigner{})
+
+	// the other accounts are signed by the wrapped signer
+	require.Error(t, txSigner.CheckAccount(addr))
+	_, err := txSigner.SignTx(addr, tx, ethSigner)
+	require.Error(t, err)
+
+	impersonator.Impersonate(addr)
+	require.NoError(t, txSigner.CheckAccount(addr))
+	signed, err := txSigner.SignTx(addr, tx, ethSigner)
+	require.NoError(t, err)
+
+	sender, err := impersonator.Signer(ethSigner).Sender(signed)
+	require.NoError(t, err)
+	require.Equal(t, addr, sender)
+}
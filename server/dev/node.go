@@ -0,0 +1,372 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package dev
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// TxBroadcaster broadcasts the transactions to the mempool of the node.
+type TxBroadcaster interface {
+	BroadcastTxSync(ctx context.Context, tx tmtypes.Tx) (*coretypes.ResultBroadcastTx, error)
+}
+
+// snapshot is a state recorded by evm_snapshot.
+type snapshot struct {
+	height     int64
+	timeOffset time.Duration
+}
+
+// override is a change of the state applied at the beginning of the next block.
+type override struct {
+	apply func(ctx sdk.Context) error
+	err   chan error
+}
+
+// Node controls the blocks of the dev chain: it seals a block on demand with a transaction that
+// only it accepts, overrides the block time seen by the EVM and applies the changes of the state
+// at the beginning of the next block.
+type Node struct {
+	app      Application
+	txConfig client.TxConfig
+	logger   log.Logger
+	client   TxBroadcaster
+	// onDemand is true if the blocks are only sealed for the transactions
+	onDemand bool
+
+	mu sync.Mutex
+
+	// height is the last committed height
+	height int64
+	// committed is closed and replaced at each commit
+	committed chan struct{}
+
+	// mineTxs maps the pending mine transactions to their sequence
+	mineTxs   map[string]uint64
+	mineSeq   uint64
+	delivered uint64
+	mined     uint64
+
+	// timeOffset is added to the time of the block headers
+	timeOffset    time.Duration
+	nextTimestamp *time.Time
+	lastBlockTime time.Time
+
+	overrides      []override
+	snapshots      map[uint64]snapshot
+	lastSnapshotID uint64
+
+	impersonator *Impersonator
+}
+
+// NewNode returns the controller of the dev chain run by the application. The blocks are sealed on
+// demand when onDemand is true, otherwise Tendermint seals them at a fixed interval.
+func NewNode(app Application, txConfig client.TxConfig, chainID string, onDemand bool, logger log.Logger) *Node {
+	// the EVM chain ID is otherwise set by the first block after the start, and the transactions
+	// checked until then, which the blocks are sealed for, would fail to recover their sender
+	app.GetEVMKeeper().WithChainID(app.NewContext(true, tmproto.Header{ChainID: chainID}))
+
+	// the senders of the transactions of the impersonated accounts are only recovered on the dev node
+	impersonator := NewImpersonator()
+	app.GetEVMKeeper().SetSignerWrapper(impersonator.Signer)
+
+	return &Node{
+		app:       app,
+		txConfig:  txConfig,
+		logger:    logger,
+		onDemand:  onDemand,
+		height:    app.LastBlockHeight(),
+		committed: make(chan struct{}),
+		mineTxs:   make(map[string]uint64),
+		snapshots: make(map[uint64]snapshot),
+
+		impersonator: impersonator,
+	}
+}
+
+// SetClient sets the client broadcasting the mine transactions, once the node is started.
+func (n *Node) SetClient(client TxBroadcaster) {
+	n.client = client
+}
+
+// Impersonator returns the accounts impersonated on the node.
+func (n *Node) Impersonator() *Impersonator {
+	return n.impersonator
+}
+
+// ImpersonateAccount allows the account to send transactions without signing them.
+func (n *Node) ImpersonateAccount(addr common.Address) {
+	n.impersonator.Impersonate(addr)
+}
+
+// StopImpersonatingAccount requires the transactions of the account to be signed again.
+func (n *Node) StopImpersonatingAccount(addr common.Address) {
+	n.impersonator.StopImpersonating(addr)
+}
+
+// App returns the ABCI application to run instead of the application of the node.
+func (n *Node) App() *App {
+	return &App{Application: n.app, node: n}
+}
+
+// Mine seals a new block and waits until it is committed.
+func (n *Node) Mine(ctx context.Context) error {
+	if n.client == nil {
+		return errors.New("the dev node isn't started")
+	}
+
+	n.mu.Lock()
+	n.mineSeq++
+	seq := n.mineSeq
+	tx, err := n.mineTx(seq)
+	if err != nil {
+		n.mu.Unlock()
+		return err
+	}
+	n.mineTxs[string(tx)] = seq
+	n.mu.Unlock()
+
+	if _, err := n.client.BroadcastTxSync(ctx, tx); err != nil {
+		n.mu.Lock()
+		delete(n.mineTxs, string(tx))
+		n.mu.Unlock()
+		return fmt.Errorf("failed to broadcast the mine transaction: %w", err)
+	}
+
+	for {
+		n.mu.Lock()
+		mined, committed := n.mined >= seq, n.committed
+		n.mu.Unlock()
+
+		if mined {
+			return nil
+		}
+
+		select {
+		case <-committed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// mineTx returns a transaction without messages, unique to the sequence.
+func (n *Node) mineTx(seq uint64) (tmtypes.Tx, error) {
+	builder := n.txConfig.NewTxBuilder()
+	builder.SetMemo(fmt.Sprintf("dev mine %d-%d", time.Now().UnixNano(), seq))
+	return n.txConfig.TxEncoder()(builder.GetTx())
+}
+
+// IncreaseTime moves the time of the next blocks forward, and returns the total offset in seconds.
+func (n *Node) IncreaseTime(seconds uint64) int64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.timeOffset += time.Duration(seconds) * time.Second
+	return int64(n.timeOffset / time.Second)
+}
+
+// SetNextBlockTimestamp sets the time of the next block, which must be after the time of the last
+// block. The following blocks keep the offset to the block header time.
+func (n *Node) SetNextBlockTimestamp(timestamp uint64) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	last := n.lastBlockTime.Unix()
+	if !n.lastBlockTime.IsZero() && int64(timestamp) <= last {
+		return fmt.Errorf("timestamp %d is lower than or equal to the timestamp %d of the previous block", timestamp, last)
+	}
+
+	next := time.Unix(int64(timestamp), 0).UTC()
+	n.nextTimestamp = &next
+	return nil
+}
+
+// Snapshot records the current state and returns its ID.
+func (n *Node) Snapshot() uint64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.lastSnapshotID++
+	n.snapshots[n.lastSnapshotID] = snapshot{height: n.height, timeOffset: n.timeOffset}
+	return n.lastSnapshotID
+}
+
+// Revert restores the accounts, the balances, the EVM state and the time offset of the snapshot in
+// a new block, and discards the snapshot and the later ones. It returns false if the snapshot
+// doesn't exist.
+func (n *Node) Revert(ctx context.Context, id uint64) (bool, error) {
+	n.mu.Lock()
+	snap, ok := n.snapshots[id]
+	for snapshotID := range n.snapshots {
+		if snapshotID >= id {
+			delete(n.snapshots, snapshotID)
+		}
+	}
+	if ok {
+		n.timeOffset = snap.timeOffset
+		n.nextTimestamp = nil
+	}
+	n.mu.Unlock()
+
+	if !ok {
+		return false, nil
+	}
+
+	if err := n.override(ctx, func(ctx sdk.Context) error {
+		return restore(ctx, n.app, snap.height)
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SetBalance sets the balance of the account in a new block.
+func (n *Node) SetBalance(ctx context.Context, addr common.Address, balance *big.Int) error {
+	return n.overrideState(ctx, func(db *statedb.StateDB) {
+		db.SubBalance(addr, db.GetBalance(addr))
+		db.AddBalance(addr, balance)
+	})
+}
+
+// SetCode sets the code of the account in a new block.
+func (n *Node) SetCode(ctx context.Context, addr common.Address, code []byte) error {
+	return n.overrideState(ctx, func(db *statedb.StateDB) {
+		db.SetCode(addr, code)
+	})
+}
+
+// SetStorageAt sets a storage slot of the account in a new block.
+func (n *Node) SetStorageAt(ctx context.Context, addr common.Address, slot, value common.Hash) error {
+	return n.overrideState(ctx, func(db *statedb.StateDB) {
+		db.SetState(addr, slot, value)
+	})
+}
+
+// overrideState changes the EVM state in a new block, it fails if the state of the forked chain
+// can't be loaded.
+func (n *Node) overrideState(ctx context.Context, change func(db *statedb.StateDB)) error {
+	return n.override(ctx, func(ctx sdk.Context) (err error) {
+		defer recoverForkError(&err)
+
+		db := statedb.New(ctx, n.app.GetEVMKeeper().StateKeeper(), statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+		change(db)
+		return db.Commit()
+	})
+}
+
+// override applies the change of the state in a new block, and waits until it is committed.
+func (n *Node) override(ctx context.Context, apply func(ctx sdk.Context) error) error {
+	o := override{apply: apply, err: make(chan error, 1)}
+
+	n.mu.Lock()
+	n.overrides = append(n.overrides, o)
+	n.mu.Unlock()
+
+	// the overrides are applied at the beginning of the block of the mine transaction at the latest
+	if err := n.Mine(ctx); err != nil {
+		return err
+	}
+	return <-o.err
+}
+
+// blockTime returns the block time of the EVM for the time of the block header.
+func (n *Node) blockTime(headerTime time.Time) time.Time {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	blockTime := headerTime.Add(n.timeOffset)
+	if n.nextTimestamp != nil {
+		blockTime = *n.nextTimestamp
+		n.timeOffset = blockTime.Sub(headerTime)
+		n.nextTimestamp = nil
+	}
+
+	n.lastBlockTime = blockTime
+	return blockTime
+}
+
+// applyOverrides applies the pending overrides, each one is discarded if it fails.
+func (n *Node) applyOverrides(ctx sdk.Context) {
+	n.mu.Lock()
+	overrides := n.overrides
+	n.overrides = nil
+	n.mu.Unlock()
+
+	for _, o := range overrides {
+		cacheCtx, write := ctx.CacheContext()
+		err := o.apply(cacheCtx)
+		if err == nil {
+			write()
+		} else {
+			n.logger.Error("failed to override the state", "height", ctx.BlockHeight(), "error", err.Error())
+		}
+		o.err <- err
+	}
+}
+
+// isMineTx returns true if the transaction is a mine transaction of the node.
+func (n *Node) isMineTx(tx []byte) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	_, ok := n.mineTxs[string(tx)]
+	return ok
+}
+
+// deliverMineTx records the delivery of the transaction and returns true if it is a mine
+// transaction of the node.
+func (n *Node) deliverMineTx(tx []byte) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	seq, ok := n.mineTxs[string(tx)]
+	if !ok {
+		return false
+	}
+
+	delete(n.mineTxs, string(tx))
+	if seq > n.delivered {
+		n.delivered = seq
+	}
+	return true
+}
+
+// commit records the commit of the block and wakes up the callers waiting for it.
+func (n *Node) commit(height int64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.height = height
+	n.mined = n.delivered
+	close(n.committed)
+	n.committed = make(chan struct{})
+}
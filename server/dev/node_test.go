@@ -0,0 +1,83 @@
+package dev
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// newTestNode returns a node without application, which isn't started.
+func newTestNode() *Node {
+	return &Node{
+		logger:    log.NewNopLogger(),
+		onDemand:  true,
+		committed: make(chan struct{}),
+		mineTxs:   make(map[string]uint64),
+		snapshots: make(map[uint64]snapshot),
+	}
+}
+
+func TestBlockTime(t *testing.T) {
+	n := newTestNode()
+	header := time.Unix(1000, 0)
+
+	require.Equal(t, header, n.blockTime(header))
+
+	require.Equal(t, int64(60), n.IncreaseTime(60))
+	require.Equal(t, int64(90), n.IncreaseTime(30))
+	require.Equal(t, time.Unix(1095, 0), n.blockTime(header.Add(5*time.Second)))
+
+	require.Error(t, n.SetNextBlockTimestamp(1095))
+	require.NoError(t, n.SetNextBlockTimestamp(2000))
+	require.Equal(t, int64(2000), n.blockTime(time.Unix(1010, 0)).Unix())
+
+	// the following blocks keep the offset of the timestamp
+	require.Equal(t, int64(2003), n.blockTime(time.Unix(1013, 0)).Unix())
+}
+
+func TestSnapshots(t *testing.T) {
+	n := newTestNode()
+	n.IncreaseTime(10)
+
+	require.Equal(t, uint64(1), n.Snapshot())
+	n.IncreaseTime(10)
+	require.Equal(t, uint64(2), n.Snapshot())
+	n.IncreaseTime(10)
+	require.Equal(t, uint64(3), n.Snapshot())
+
+	// an unknown snapshot is reverted without a new block
+	ok, err := n.Revert(context.Background(), 4)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Len(t, n.snapshots, 3)
+
+	// the node isn't started, but the snapshot and the later ones are discarded
+	_, err = n.Revert(context.Background(), 2)
+	require.Error(t, err)
+	require.Len(t, n.snapshots, 1)
+	require.Equal(t, 20*time.Second, n.timeOffset)
+	require.Equal(t, uint64(4), n.Snapshot())
+}
+
+func TestRestoreStore(t *testing.T) {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	snapshot := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	snapshot.Set([]byte("a"), []byte("1"))
+	snapshot.Set([]byte("b"), []byte("2"))
+
+	store.Set([]byte("a"), []byte("1"))
+	store.Set([]byte("b"), []byte("3"))
+	store.Set([]byte("c"), []byte("4"))
+
+	restoreStore(store, snapshot)
+
+	require.Equal(t, []byte("1"), store.Get([]byte("a")))
+	require.Equal(t, []byte("2"), store.Get([]byte("b")))
+	require.False(t, store.Has([]byte("c")))
+}
@@ -70,6 +70,27 @@ const (
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
 	JSONRPCEnableMetrics            = "metrics"
 	JSONRPCFixRevertGasRefundHeight = "json-rpc.fix-revert-gas-refund-height"
+	JSONRPCJWTSecret                = "json-rpc.jwt-secret"
+	JSONRPCAuthAPI                  = "json-rpc.auth-api"
+	JSONRPCAllowMethods             = "json-rpc.allow-methods"
+	JSONRPCDenyMethods              = "json-rpc.deny-methods"
+	JSONRPCRateLimit                = "json-rpc.rate-limit"
+	JSONRPCRateLimitBurst           = "json-rpc.rate-limit-burst"
+	JSONRPCMethodRateLimits         = "json-rpc.method-rate-limits"
+	JSONRPCBatchRequestLimit        = "json-rpc.batch-request-limit"
+	JSONRPCBatchResponseMaxSize     = "json-rpc.batch-response-max-size"
+	JSONRPCIPCPath                  = "json-rpc.ipc-path"
+	JSONRPCResponseCacheSize        = "json-rpc.response-cache-size"
+	JSONRPCSigner                   = "json-rpc.signer"
+)
+
+// JSON-RPC gateway flags
+const (
+	GatewayNodes               = "nodes"
+	GatewayGRPCAddresses       = "grpc-addresses"
+	GatewayGRPCInsecure        = "grpc-insecure"
+	GatewayHealthCheckInterval = "health-check-interval"
+	GatewayMaxHeightLag        = "max-height-lag"
 )
 
 // EVM flags
@@ -78,6 +99,29 @@ const (
 	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
 )
 
+// Tracing flags
+const (
+	TracingEnable      = "tracing.enable"
+	TracingExporter    = "tracing.exporter"
+	TracingEndpoint    = "tracing.endpoint"
+	TracingInsecure    = "tracing.insecure"
+	TracingFilePath    = "tracing.file-path"
+	TracingSampleRatio = "tracing.sample-ratio"
+	TracingServiceName = "tracing.service-name"
+)
+
+// Dev node flags
+const (
+	Dev           = "dev"
+	DevMnemonic   = "dev.mnemonic"
+	DevAccounts   = "dev.accounts"
+	DevBalance    = "dev.balance"
+	DevBlockTime  = "dev.block-time"
+	DevChainID    = "dev.chain-id"
+	DevForkURL    = "dev.fork-url"
+	DevForkHeight = "dev.fork-height"
+)
+
 // TLS flags
 const (
 	TLSCertPath = "tls.certificate-path"
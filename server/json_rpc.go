@@ -16,7 +16,11 @@
 package server
 
 import (
+	"context"
+	"errors"
+	"net"
 	"net/http"
+	"path/filepath"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -28,6 +32,9 @@ import (
 	ethlog "github.com/ethereum/go-ethereum/log"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/evmos/ethermint/rpc"
+	"github.com/evmos/ethermint/rpc/upstream"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
 
 	"github.com/evmos/ethermint/server/config"
 	ethermint "github.com/evmos/ethermint/types"
@@ -41,7 +48,14 @@ func StartJSONRPC(ctx *server.Context,
 	config *config.Config,
 	indexer ethermint.EVMTxIndexer,
 ) (*http.Server, chan struct{}, error) {
-	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
+	var wsOpts []func(*rpcclient.WSClient)
+	if upstreamClient, ok := clientCtx.Client.(*upstream.Client); ok {
+		// the event subscriptions follow the failover of the upstream nodes
+		wsOpts = append(wsOpts, func(c *rpcclient.WSClient) {
+			c.Dialer = upstreamClient.Dial
+		})
+	}
+	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger, wsOpts...)
 
 	logger := ctx.Logger.With("module", "geth")
 	ethlog.Root().SetHandler(ethlog.FuncHandler(func(r *ethlog.Record) error {
@@ -63,7 +77,30 @@ func StartJSONRPC(ctx *server.Context,
 
 	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
 
+	// the authenticated server serves all the namespaces while the public one only serves the
+	// namespaces that don't require a JWT token
+	var authServer *ethrpc.Server
+	if config.JSONRPC.JWTSecret != "" {
+		authServer = ethrpc.NewServer()
+	}
+
+	hasPublicAPI := false
 	for _, api := range apis {
+		if authServer != nil {
+			if err := authServer.RegisterName(api.Namespace, api.Service); err != nil {
+				ctx.Logger.Error(
+					"failed to register service in authenticated JSON RPC namespace",
+					"namespace", api.Namespace,
+					"service", api.Service,
+				)
+				return nil, nil, err
+			}
+		}
+
+		if config.JSONRPC.RequiresAuth(api.Namespace) {
+			continue
+		}
+
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
 			ctx.Logger.Error(
 				"failed to register service in JSON RPC namespace",
@@ -72,10 +109,50 @@ func StartJSONRPC(ctx *server.Context,
 			)
 			return nil, nil, err
 		}
+		hasPublicAPI = true
+	}
+
+	var (
+		handler   http.Handler = rpcServer
+		jwtSecret []byte
+		err       error
+	)
+	if authServer != nil {
+		jwtSecret, err = rpc.ObtainJWTSecret(config.JSONRPC.JWTSecret)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var publicHandler http.Handler
+		if hasPublicAPI {
+			publicHandler = rpcServer
+		}
+		handler = rpc.NewJWTHandler(jwtSecret, publicHandler, authServer)
+	}
+
+	handler = rpc.InstrumentHandler(rpcAPIArr, handler)
+
+	limiter, err := rpc.NewRequestLimiter(config.JSONRPC)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if limiter != nil {
+		handler = limiter.Handler(handler)
+	}
+
+	// the batch requests are always split, so that each call is instrumented and limited
+	handler = rpc.NewBatchLimits(config.JSONRPC).Handler(handler)
+
+	// the requests forwarded by the WebSocket server are served as the requests of its clients
+	forwarder, err := rpc.NewClientForwarder()
+	if err != nil {
+		return nil, nil, err
 	}
+	handler = forwarder.Handler(handler)
 
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", handler).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -118,11 +195,55 @@ func StartJSONRPC(ctx *server.Context,
 	case <-time.After(types.ServerStartTime): // assume JSON RPC server started successfully
 	}
 
+	if config.JSONRPC.IPCPath != "" {
+		// the IPC server serves all the namespaces, the access is restricted by the socket permissions
+		ipcServer := rpcServer
+		if authServer != nil {
+			ipcServer = authServer
+		}
+
+		ipcPath := config.JSONRPC.IPCPath
+		if !filepath.IsAbs(ipcPath) {
+			ipcPath = filepath.Join(ctx.Config.RootDir, ipcPath)
+		}
+
+		ipcLn, err := ListenIPC(ipcPath)
+		if err != nil {
+			ctx.Logger.Error("failed to start JSON-RPC IPC server", "path", ipcPath, "error", err.Error())
+			return nil, nil, err
+		}
+
+		ctx.Logger.Info("Starting JSON-RPC IPC server", "path", ipcPath)
+		go func() {
+			if err := ipcServer.ServeListener(ipcLn); err != nil && !errors.Is(err, net.ErrClosed) {
+				ctx.Logger.Error("JSON-RPC IPC server stopped", "error", err.Error())
+			}
+		}()
+		httpSrv.RegisterOnShutdown(func() {
+			_ = ipcLn.Close()
+		})
+	}
+
 	ctx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
 
-	// allocate separate WS connection to Tendermint
-	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
+	// the WebSocket server shares the Tendermint connection, and thus the event subscriptions, of
+	// the filter API
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config, jwtSecret, limiter, forwarder)
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
+
+// shutdownJSONRPC gracefully shuts down the JSON-RPC server started by StartJSONRPC.
+func shutdownJSONRPC(httpSrv *http.Server, httpSrvDone chan struct{}, logger tmlog.Logger) {
+	shutdownCtx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelFn()
+	if err := httpSrv.Shutdown(shutdownCtx); err != nil {
+		logger.Error("HTTP server shutdown produced a warning", "error", err.Error())
+	} else {
+		logger.Info("HTTP server shut down, waiting 5 sec")
+		select {
+		case <-time.Tick(5 * time.Second):
+		case <-httpSrvDone:
+		}
+	}
+}
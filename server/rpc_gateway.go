@@ -0,0 +1,250 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+
+	"github.com/evmos/ethermint/indexer"
+	rpcmetrics "github.com/evmos/ethermint/rpc/metrics"
+	"github.com/evmos/ethermint/rpc/upstream"
+	"github.com/evmos/ethermint/server/config"
+	srvflags "github.com/evmos/ethermint/server/flags"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// NewRPCGatewayCmd returns the command running the JSON-RPC server against a remote node.
+func NewRPCGatewayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rpc-gateway",
+		Short: "Run the JSON-RPC server against remote nodes",
+		Long: `Run the JSON-RPC server, including the WebSocket server, the filters and optionally the custom tx
+indexer, against the Tendermint RPC and gRPC endpoints of remote nodes, without running a full node.
+
+The Tendermint RPC endpoint is set with the '--node' flag and serves the blocks, the transactions and
+the event subscriptions. The queries are sent to the gRPC endpoint set with the '--grpc-addresses' flag,
+or to the Tendermint RPC endpoint if it is empty. The chain ID is the one of the remote node.
+
+Several upstream nodes can be set with the '--nodes' flag, along with their gRPC endpoints in the same
+order. The nodes are health-checked periodically and each call is sent to the first healthy node that
+has the state of the requested height, failing over to the next nodes if it can't be reached. A node
+is unhealthy if it is unreachable, catching up or lagging more than '--max-height-lag' blocks behind
+the highest node. The event subscriptions are served by the first reachable node and, when its
+connection is lost, they are made again on the next reachable node. The events emitted during the
+failover are missed.
+
+The JSON-RPC server is configured by the [json-rpc], [tls] and [tracing] sections of the app.toml file in the
+home directory, and the indexer db is stored in the home directory.
+`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			// Bind flags to the Context's Viper so that the JSON-RPC configuration can be
+			// overridden.
+			return serverCtx.Viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			err = startRPCGateway(serverCtx, clientCtx)
+			errCode, ok := err.(server.ErrorCode)
+			if !ok {
+				return err
+			}
+
+			serverCtx.Logger.Debug(fmt.Sprintf("received quit signal: %d", errCode.Code))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSlice(srvflags.GatewayNodes, []string{}, "the Tendermint RPC endpoints of the upstream nodes, in order of preference (empty=the --node endpoint)")
+	cmd.Flags().StringSlice(srvflags.GatewayGRPCAddresses, []string{}, "the gRPC endpoints of the upstream nodes, in the same order as the nodes (empty=queries are sent to the Tendermint RPC endpoints)") //nolint:lll
+	cmd.Flags().Bool(srvflags.GatewayGRPCInsecure, false, "allow gRPC over insecure channels, if not TLS the server must use TLS")
+	cmd.Flags().Duration(srvflags.GatewayHealthCheckInterval, upstream.DefaultHealthCheckInterval, "the interval between the health checks of the upstream nodes")
+	cmd.Flags().Int64(srvflags.GatewayMaxHeightLag, upstream.DefaultMaxHeightLag, "the number of blocks an upstream node can lag behind the highest node and stay healthy")
+	cmd.Flags().String(srvflags.AppDBBackend, "", "The type of database for the indexer database")
+	addJSONRPCFlags(cmd)
+	addTracingFlags(cmd)
+
+	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
+	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
+
+	return cmd
+}
+
+// startRPCGateway starts the JSON-RPC server against the upstream nodes, or the remote node of the
+// client context, and blocks until a quit signal is received.
+func startRPCGateway(ctx *server.Context, clientCtx client.Context) error {
+	home := ctx.Config.RootDir
+	logger := ctx.Logger
+
+	config, err := config.GetConfig(ctx.Viper)
+	if err != nil {
+		logger.Error("failed to get server config", "error", err.Error())
+		return err
+	}
+
+	if err := config.ValidateBasic(); err != nil {
+		logger.Error("invalid server config", "error", err.Error())
+		return err
+	}
+
+	stopTracing, err := startTracing(config.Tracing, home, logger)
+	if err != nil {
+		logger.Error("failed to start tracing", "error", err.Error())
+		return err
+	}
+	defer stopTracing()
+
+	var upstreamClient *upstream.Client
+	if nodes := ctx.Viper.GetStringSlice(srvflags.GatewayNodes); len(nodes) > 0 {
+		upstreamCfg := upstream.Config{
+			HealthCheckInterval: ctx.Viper.GetDuration(srvflags.GatewayHealthCheckInterval),
+			MaxHeightLag:        ctx.Viper.GetInt64(srvflags.GatewayMaxHeightLag),
+		}
+
+		upstreamClient, err = upstream.NewClient(upstreamCfg, nodes)
+		if err != nil {
+			return err
+		}
+
+		upstreamClient.SetLogger(logger.With("module", "upstream"))
+		if err := upstreamClient.Start(); err != nil {
+			logger.Error("failed to start the upstream client", "error", err.Error())
+			return err
+		}
+		defer func() {
+			_ = upstreamClient.Stop()
+		}()
+
+		clientCtx = clientCtx.
+			WithClient(upstreamClient).
+			WithNodeURI(nodes[0])
+	}
+
+	if clientCtx.Client == nil {
+		return fmt.Errorf("the --%s flag is required", flags.FlagNode)
+	}
+
+	status, err := clientCtx.Client.Status(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to get the status of the node %s: %w", clientCtx.NodeURI, err)
+	}
+
+	clientCtx = clientCtx.
+		WithHomeDir(home).
+		WithChainID(status.NodeInfo.Network)
+
+	if grpcAddresses := ctx.Viper.GetStringSlice(srvflags.GatewayGRPCAddresses); len(grpcAddresses) > 0 {
+		creds := insecure.NewCredentials()
+		if !ctx.Viper.GetBool(srvflags.GatewayGRPCInsecure) {
+			creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+		}
+
+		var (
+			target = grpcAddresses[0]
+			opts   []grpc.DialOption
+		)
+		if upstreamClient != nil {
+			target, opts, err = upstreamClient.GRPCDialOptions(grpcAddresses)
+			if err != nil {
+				return err
+			}
+		} else if len(grpcAddresses) > 1 {
+			return fmt.Errorf("the --%s flag is required with several gRPC addresses", srvflags.GatewayNodes)
+		}
+
+		grpcClient, err := DialGRPC(clientCtx, target, creds, config.GRPC, opts...)
+		if err != nil {
+			return err
+		}
+		defer grpcClient.Close()
+
+		clientCtx = clientCtx.WithGRPCClient(grpcClient)
+		logger.Debug("gRPC client assigned to client context", "addresses", grpcAddresses)
+	}
+
+	// Flag not added in config to avoid user enabling in config without passing in CLI
+	if ctx.Viper.GetBool(srvflags.JSONRPCEnableMetrics) {
+		rpcmetrics.StartServer(config.JSONRPC.MetricsAddress, logger)
+	}
+
+	var idxer ethermint.EVMTxIndexer
+	if config.JSONRPC.EnableIndexer {
+		// the indexer subscribes to the new blocks over the websocket of the remote node, the
+		// upstream client is already started
+		if upstreamClient == nil {
+			if err := clientCtx.Client.Start(); err != nil {
+				logger.Error("failed to start the Tendermint RPC client", "error", err.Error())
+				return err
+			}
+			defer func() {
+				_ = clientCtx.Client.Stop()
+			}()
+		}
+
+		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(ctx.Viper))
+		if err != nil {
+			logger.Error("failed to open evm indexer DB", "error", err.Error())
+			return err
+		}
+
+		idxLogger := logger.With("indexer", "evm")
+		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		indexerService := NewEVMIndexerService(idxer, clientCtx.Client)
+		indexerService.SetLogger(idxLogger)
+
+		errCh := make(chan error)
+		go func() {
+			if err := indexerService.Start(); err != nil {
+				errCh <- err
+			}
+		}()
+
+		select {
+		case err := <-errCh:
+			return err
+		case <-time.After(types.ServerStartTime): // assume server started successfully
+		}
+	}
+
+	httpSrv, httpSrvDone, err := StartJSONRPC(ctx, clientCtx, clientCtx.NodeURI, "/websocket", &config, idxer)
+	if err != nil {
+		return err
+	}
+	defer shutdownJSONRPC(httpSrv, httpSrvDone, logger)
+
+	// Wait for SIGINT or SIGTERM signal
+	return server.WaitForQuitSignals()
+}
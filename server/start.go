@@ -17,6 +17,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -37,6 +38,7 @@ import (
 
 	abciserver "github.com/tendermint/tendermint/abci/server"
 	tcmd "github.com/tendermint/tendermint/cmd/cometbft/commands"
+	tmlog "github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	"github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/p2p"
@@ -48,8 +50,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/rosetta"
 	crgserver "github.com/cosmos/cosmos-sdk/server/rosetta/lib/server"
 
-	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
-
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -60,11 +60,15 @@ import (
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 
 	"github.com/evmos/ethermint/indexer"
+	rpcmetrics "github.com/evmos/ethermint/rpc/metrics"
 	ethdebug "github.com/evmos/ethermint/rpc/namespaces/ethereum/debug"
 	"github.com/evmos/ethermint/server/config"
+	"github.com/evmos/ethermint/server/dev"
 	srvflags "github.com/evmos/ethermint/server/flags"
+	"github.com/evmos/ethermint/tracing"
 	ethermint "github.com/evmos/ethermint/types"
 )
 
@@ -76,6 +80,8 @@ type StartOptions struct {
 	AppCreator      types.AppCreator
 	DefaultNodeHome string
 	DBOpener        DBOpener
+	// ModuleBasics creates the genesis of the dev chain, the dev mode is not available when nil
+	ModuleBasics module.BasicManager
 }
 
 // NewDefaultStartOptions use the default db opener provided in tm-db.
@@ -114,6 +120,19 @@ will not be able to commit subsequent blocks.
 
 For profiling and benchmarking purposes, CPU profiling can be enabled via the '--cpu-profile' flag
 which accepts a path for the resulting pprof file.
+
+For the local development of contracts, the '--dev' flag runs a single validator chain, created in
+the home directory if it has no genesis, where the accounts derived from the '--dev.mnemonic' flag
+are funded and can send transactions through eth_sendTransaction. The blocks are sealed for each
+transaction, or every '--dev.block-time' if set, and the 'evm' and 'anvil' JSON-RPC namespaces
+control the chain: evm_mine, evm_increaseTime, evm_setNextBlockTimestamp, evm_snapshot, evm_revert,
+anvil_setBalance, anvil_setCode, anvil_setStorageAt and anvil_impersonateAccount. The state changes
+and the reverts are applied in a new block. As Tendermint seals a block until the app hash stops
+changing, an empty block follows each block sealed on demand.
+
+With '--dev.fork-url', the dev chain forks the EVM state of a remote ethermint node at
+'--dev.fork-height': the accounts, the code and the storage missing from the dev chain are queried
+from the node on first access, and kept in the local state.
 `,
 		PreRunE: func(cmd *cobra.Command, _ []string) error {
 			serverCtx := server.GetServerContextFromCmd(cmd)
@@ -136,6 +155,18 @@ which accepts a path for the resulting pprof file.
 			}
 
 			withTM, _ := cmd.Flags().GetBool(srvflags.WithTendermint)
+			devMode, _ := cmd.Flags().GetBool(srvflags.Dev)
+			if devMode {
+				if !withTM || serverCtx.Viper.GetBool(srvflags.GRPCOnly) {
+					return errors.New("the dev mode requires Tendermint in process")
+				}
+
+				clientCtx, err = setupDevMode(serverCtx, clientCtx, opts)
+				if err != nil {
+					return err
+				}
+			}
+
 			if !withTM {
 				serverCtx.Logger.Info("starting ABCI without Tendermint")
 				return startStandAlone(serverCtx, opts)
@@ -145,7 +176,7 @@ which accepts a path for the resulting pprof file.
 
 			// fire unlock precess for keyring
 			keyringBackend, _ := cmd.Flags().GetString(flags.FlagKeyringBackend)
-			if keyringBackend == keyring.BackendFile {
+			if keyringBackend == keyring.BackendFile && !devMode {
 				_, err = clientCtx.Keyring.List()
 				if err != nil {
 					return err
@@ -195,12 +226,34 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(srvflags.EnabledUnsafeCors, false, "Defines if CORS should be enabled (unsafe - use it at your own risk)")
 
 	cmd.Flags().Bool(srvflags.JSONRPCEnable, true, "Define if the JSON-RPC server should be enabled")
+	addJSONRPCFlags(cmd)
+
+	addTracingFlags(cmd)
+
+	addDevFlags(cmd)
+
+	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+
+	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
+	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
+
+	cmd.Flags().Uint64(server.FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
+	cmd.Flags().Uint32(server.FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+
+	// add support for all Tendermint-specific command line options
+	tcmd.AddNodeFlags(cmd)
+	return cmd
+}
+
+// addJSONRPCFlags adds the JSON-RPC server flags shared by the start and rpc-gateway commands.
+func addJSONRPCFlags(cmd *cobra.Command) {
 	cmd.Flags().StringSlice(srvflags.JSONRPCAPI, config.GetDefaultAPINamespaces(), "Defines a list of JSON-RPC namespaces that should be enabled")
 	cmd.Flags().String(srvflags.JSONRPCAddress, config.DefaultJSONRPCAddress, "the JSON-RPC server address to listen on")
 	cmd.Flags().String(srvflags.JSONWsAddress, config.DefaultJSONRPCWsAddress, "the JSON-RPC WS server address to listen on")
 	cmd.Flags().Uint64(srvflags.JSONRPCGasCap, config.DefaultGasCap, "Sets a cap on gas that can be used in eth_call/estimateGas unit is aphoton (0=infinite)")     //nolint:lll
 	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, config.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 photon)") //nolint:lll
-	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, config.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
+	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, config.DefaultFilterCap, "Sets the cap for the number of filters and subscriptions that each client can create")
 	cmd.Flags().Duration(srvflags.JSONRPCEVMTimeout, config.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPTimeout, config.DefaultHTTPTimeout, "Sets a read/write timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPIdleTimeout, config.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
@@ -210,19 +263,29 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
+	cmd.Flags().String(srvflags.JSONRPCJWTSecret, "", "Path to the hex encoded secret used to authenticate JSON-RPC requests with JWT tokens (empty=disabled)")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAuthAPI, []string{}, "Defines a list of JSON-RPC namespaces that require a JWT token (empty=all namespaces)")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAllowMethods, []string{}, "Defines a list of JSON-RPC methods that can be called (empty=all methods)")
+	cmd.Flags().StringSlice(srvflags.JSONRPCDenyMethods, []string{}, "Defines a list of JSON-RPC methods that cannot be called")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimit, config.DefaultRateLimit, "Sets the maximum number of JSON-RPC requests per second per client IP (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitBurst, config.DefaultRateLimitBurst, "Sets the maximum number of JSON-RPC requests per client IP allowed in a burst (0=rate limit rounded up)") //nolint:lll
+	cmd.Flags().StringSlice(srvflags.JSONRPCMethodRateLimits, []string{}, "Sets the rate limits per client IP of JSON-RPC methods, formatted as 'method:rate:burst'")
+	cmd.Flags().Int(srvflags.JSONRPCBatchRequestLimit, config.DefaultBatchRequestLimit, "Sets the maximum number of calls in a JSON-RPC batch request (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCBatchResponseMaxSize, config.DefaultBatchResponseMaxSize, "Sets the maximum size in bytes of a JSON-RPC batch response (0=unlimited)")
+	cmd.Flags().String(srvflags.JSONRPCIPCPath, "", "the JSON-RPC IPC socket path, relative to the node home directory if not absolute (empty=disabled)")
+	cmd.Flags().Int(srvflags.JSONRPCResponseCacheSize, config.DefaultResponseCacheSize, "Sets the number of immutable JSON-RPC responses cached in memory (0=disabled)")
+	cmd.Flags().String(srvflags.JSONRPCSigner, "", "the endpoint of a Clef-compatible external signer, a unix socket path or an HTTP URL (empty=node keyring)")
+}
 
-	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
-	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
-
-	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
-	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
-
-	cmd.Flags().Uint64(server.FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
-	cmd.Flags().Uint32(server.FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
-
-	// add support for all Tendermint-specific command line options
-	tcmd.AddNodeFlags(cmd)
-	return cmd
+// addTracingFlags adds the tracing flags shared by the start and rpc-gateway commands.
+func addTracingFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(srvflags.TracingEnable, false, "Define if the OpenTelemetry spans should be recorded and exported")
+	cmd.Flags().String(srvflags.TracingExporter, config.DefaultTracingExporter, "the span exporter (otlp|file)")
+	cmd.Flags().String(srvflags.TracingEndpoint, config.DefaultTracingEndpoint, "the address of the OTLP gRPC collector")
+	cmd.Flags().Bool(srvflags.TracingInsecure, false, "Disable the TLS of the connection to the OTLP collector")
+	cmd.Flags().String(srvflags.TracingFilePath, config.DefaultTracingFilePath, "the file the spans are appended to with the file exporter, relative to the node home directory if not absolute") //nolint:lll
+	cmd.Flags().Float64(srvflags.TracingSampleRatio, config.DefaultTracingSampleRatio, "the ratio of the traces sampled, unless the parent span is sampled")
+	cmd.Flags().String(srvflags.TracingServiceName, config.DefaultTracingServiceName, "the service name reported with the spans")
 }
 
 func startStandAlone(ctx *server.Context, opts StartOptions) error {
@@ -349,8 +412,37 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 		return err
 	}
 
+	stopTracing, err := startTracing(config.Tracing, home, logger)
+	if err != nil {
+		logger.Error("failed to start tracing", "error", err.Error())
+		return err
+	}
+	defer stopTracing()
+
 	app := opts.AppCreator(ctx.Logger, db, traceWriter, ctx.Viper)
 
+	var devNode *dev.Node
+	if ctx.Viper.GetBool(srvflags.Dev) {
+		devApp, ok := app.(dev.Application)
+		if !ok {
+			return fmt.Errorf("the application %T doesn't support the dev mode", app)
+		}
+
+		if ctx.Viper.GetString(srvflags.DevForkURL) != "" {
+			if err := setupFork(ctx, clientCtx, config.GRPC, devApp); err != nil {
+				return err
+			}
+		}
+
+		onDemand := ctx.Viper.GetDuration(srvflags.DevBlockTime) == 0
+		devNode = dev.NewNode(devApp, clientCtx.TxConfig, clientCtx.ChainID, onDemand, logger.With("module", "dev"))
+		app = devNode.App()
+
+		if err := enableDevAPIs(&config.JSONRPC, devNode); err != nil {
+			return err
+		}
+	}
+
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
 	if err != nil {
 		logger.Error("failed load or gen node key", "error", err.Error())
@@ -412,6 +504,10 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 		}
 	}
 
+	if devNode != nil {
+		devNode.SetClient(local.New(tmNode))
+	}
+
 	metrics, err := startTelemetry(config)
 	if err != nil {
 		return err
@@ -420,7 +516,7 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 	// Enable metrics if JSONRPC is enabled and --metrics is passed
 	// Flag not added in config to avoid user enabling in config without passing in CLI
 	if config.JSONRPC.Enable && ctx.Viper.GetBool(srvflags.JSONRPCEnableMetrics) {
-		ethmetricsexp.Setup(config.JSONRPC.MetricsAddress)
+		rpcmetrics.StartServer(config.JSONRPC.MetricsAddress, ctx.Logger)
 	}
 
 	var idxer ethermint.EVMTxIndexer
@@ -468,28 +564,10 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 				return errorsmod.Wrapf(err, "invalid grpc address %s", config.GRPC.Address)
 			}
 
-			maxSendMsgSize := config.GRPC.MaxSendMsgSize
-			if maxSendMsgSize == 0 {
-				maxSendMsgSize = serverconfig.DefaultGRPCMaxSendMsgSize
-			}
-
-			maxRecvMsgSize := config.GRPC.MaxRecvMsgSize
-			if maxRecvMsgSize == 0 {
-				maxRecvMsgSize = serverconfig.DefaultGRPCMaxRecvMsgSize
-			}
-
 			grpcAddress := fmt.Sprintf("127.0.0.1:%s", port)
 
 			// If grpc is enabled, configure grpc client for grpc gateway and json-rpc.
-			grpcClient, err := grpc.Dial(
-				grpcAddress,
-				grpc.WithTransportCredentials(insecure.NewCredentials()),
-				grpc.WithDefaultCallOptions(
-					grpc.ForceCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()),
-					grpc.MaxCallRecvMsgSize(maxRecvMsgSize),
-					grpc.MaxCallSendMsgSize(maxSendMsgSize),
-				),
-			)
+			grpcClient, err := DialGRPC(clientCtx, grpcAddress, insecure.NewCredentials(), config.GRPC)
 			if err != nil {
 				return err
 			}
@@ -569,19 +647,7 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 		if err != nil {
 			return err
 		}
-		defer func() {
-			shutdownCtx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
-			defer cancelFn()
-			if err := httpSrv.Shutdown(shutdownCtx); err != nil {
-				logger.Error("HTTP server shutdown produced a warning", "error", err.Error())
-			} else {
-				logger.Info("HTTP server shut down, waiting 5 sec")
-				select {
-				case <-time.Tick(5 * time.Second):
-				case <-httpSrvDone:
-				}
-			}
-		}()
+		defer shutdownJSONRPC(httpSrv, httpSrvDone, logger)
 	}
 
 	// At this point it is safe to block the process if we're in query only mode as
@@ -674,3 +740,37 @@ func startTelemetry(cfg config.Config) (*telemetry.Metrics, error) {
 	}
 	return telemetry.New(cfg.Telemetry)
 }
+
+// startTracing installs the OpenTelemetry tracer provider if tracing is enabled. It returns the
+// function flushing the remaining spans and stopping the provider.
+func startTracing(cfg config.TracingConfig, home string, logger tmlog.Logger) (func(), error) {
+	if !cfg.Enable {
+		return func() {}, nil
+	}
+
+	filePath := cfg.FilePath
+	if !filepath.IsAbs(filePath) {
+		filePath = filepath.Join(home, filePath)
+	}
+
+	shutdown, err := tracing.Setup(tracing.Options{
+		ServiceName: cfg.ServiceName,
+		Exporter:    cfg.Exporter,
+		Endpoint:    cfg.Endpoint,
+		Insecure:    cfg.Insecure,
+		FilePath:    filePath,
+		SampleRatio: cfg.SampleRatio,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Starting tracing", "exporter", cfg.Exporter)
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdown(ctx); err != nil {
+			logger.Error("failed to stop tracing", "error", err.Error())
+		}
+	}, nil
+}
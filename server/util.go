@@ -16,17 +16,27 @@
 package server
 
 import (
+	"fmt"
 	"net"
 	"net/http"
+	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
 	"github.com/evmos/ethermint/server/config"
+	"github.com/evmos/ethermint/tracing"
 	"github.com/gorilla/mux"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/netutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdkserver "github.com/cosmos/cosmos-sdk/server"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
@@ -68,19 +78,30 @@ func AddCommands(
 
 		// custom tx indexer command
 		NewIndexTxCmd(),
+		// standalone JSON-RPC server
+		NewRPCGatewayCmd(),
 	)
 }
 
-func ConnectTmWS(tmRPCAddr, tmEndpoint string, logger tmlog.Logger) *rpcclient.WSClient {
-	tmWsClient, err := rpcclient.NewWS(tmRPCAddr, tmEndpoint,
+func ConnectTmWS(tmRPCAddr, tmEndpoint string, logger tmlog.Logger, opts ...func(*rpcclient.WSClient)) *rpcclient.WSClient {
+	var (
+		tmWsClient *rpcclient.WSClient
+		err        error
+	)
+
+	opts = append([]func(*rpcclient.WSClient){
 		rpcclient.MaxReconnectAttempts(256),
-		rpcclient.ReadWait(120*time.Second),
-		rpcclient.WriteWait(120*time.Second),
-		rpcclient.PingPeriod(50*time.Second),
+		rpcclient.ReadWait(120 * time.Second),
+		rpcclient.WriteWait(120 * time.Second),
+		rpcclient.PingPeriod(50 * time.Second),
 		rpcclient.OnReconnect(func() {
 			logger.Debug("EVM RPC reconnects to Tendermint WS", "address", tmRPCAddr+tmEndpoint)
+			// the new connection has none of the subscriptions of the previous one
+			filters.ResubscribeSharedEventSystem(tmWsClient)
 		}),
-	)
+	}, opts...)
+
+	tmWsClient, err = rpcclient.NewWS(tmRPCAddr, tmEndpoint, opts...)
 
 	if err != nil {
 		logger.Error(
@@ -138,3 +159,97 @@ func Listen(addr string, config *config.Config) (net.Listener, error) {
 	}
 	return ln, err
 }
+
+// ListenIPC starts a net.Listener on the unix domain socket at the given path. A stale socket
+// file is removed, any other file at the path is left untouched and reported as an error. The
+// permissions of the new socket are restricted to the current user.
+func ListenIPC(path string) (net.Listener, error) {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	info, err := os.Lstat(path)
+	switch {
+	case err == nil && info.Mode()&os.ModeSocket == 0:
+		return nil, fmt.Errorf("IPC path %s already exists and is not a socket", path)
+	case err == nil:
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("failed to remove stale IPC socket %s: %w", path, err)
+		}
+	case !os.IsNotExist(err):
+		return nil, err
+	}
+
+	// the socket is created in a private directory and only moved to its path once its
+	// permissions are restricted, so that other users can never connect to it
+	tmpDir, err := os.MkdirTemp(dir, ".ipc")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	tmpPath := filepath.Join(tmpDir, "s")
+	ln, err := net.Listen("unix", tmpPath)
+	if err != nil {
+		return nil, err
+	}
+	// the socket file is removed from its final path when the listener is closed
+	ln.(*net.UnixListener).SetUnlinkOnClose(false)
+
+	if err := os.Chmod(tmpPath, 0o600); err != nil {
+		_ = ln.Close()
+		return nil, err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = ln.Close()
+		return nil, err
+	}
+	return &ipcListener{Listener: ln, path: path}, nil
+}
+
+// ipcListener removes the socket file of the IPC server when it is closed.
+type ipcListener struct {
+	net.Listener
+	path string
+}
+
+func (l *ipcListener) Close() error {
+	err := l.Listener.Close()
+	if rmErr := os.Remove(l.path); rmErr != nil && !os.IsNotExist(rmErr) && err == nil {
+		err = rmErr
+	}
+	return err
+}
+
+// DialGRPC connects to the gRPC server at the given address, with the message size limits of the
+// gRPC configuration. The connection encodes the messages with the client context codec, so that
+// it can be assigned to the client context, and propagates the trace context of the calls.
+func DialGRPC(
+	clientCtx client.Context,
+	address string,
+	creds credentials.TransportCredentials,
+	cfg serverconfig.GRPCConfig,
+	opts ...grpc.DialOption,
+) (*grpc.ClientConn, error) {
+	maxSendMsgSize := cfg.MaxSendMsgSize
+	if maxSendMsgSize == 0 {
+		maxSendMsgSize = serverconfig.DefaultGRPCMaxSendMsgSize
+	}
+
+	maxRecvMsgSize := cfg.MaxRecvMsgSize
+	if maxRecvMsgSize == 0 {
+		maxRecvMsgSize = serverconfig.DefaultGRPCMaxRecvMsgSize
+	}
+
+	opts = append(opts,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithUnaryInterceptor(tracing.UnaryClientInterceptor()),
+		grpc.WithDefaultCallOptions(
+			grpc.ForceCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()),
+			grpc.MaxCallRecvMsgSize(maxRecvMsgSize),
+			grpc.MaxCallSendMsgSize(maxSendMsgSize),
+		),
+	)
+	return grpc.Dial(address, opts...)
+}
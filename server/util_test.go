@@ -0,0 +1,68 @@
+package server
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type testService struct{}
+
+func (testService) Echo(s string) string { return s }
+
+func TestListenIPC(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "test.ipc")
+
+	// other files are not removed
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+	require.NoError(t, os.WriteFile(path, []byte("data"), 0o600))
+	_, err := ListenIPC(path)
+	require.ErrorContains(t, err, "is not a socket")
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, []byte("data"), bz)
+	require.NoError(t, os.Remove(path))
+
+	// stale socket file
+	stale, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, stale.Close())
+
+	ln, err := ListenIPC(path)
+	require.NoError(t, err)
+	defer ln.Close()
+
+	info, err := os.Lstat(path)
+	require.NoError(t, err)
+	require.NotZero(t, info.Mode()&os.ModeSocket)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// the private directory the socket was created in is removed
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	srv := ethrpc.NewServer()
+	require.NoError(t, srv.RegisterName("test", testService{}))
+	go func() {
+		_ = srv.ServeListener(ln)
+	}()
+
+	client, err := ethrpc.Dial(path)
+	require.NoError(t, err)
+	defer client.Close()
+
+	var res string
+	require.NoError(t, client.Call(&res, "test_echo", "hello"))
+	require.Equal(t, "hello", res)
+
+	// the socket file is removed on close
+	require.NoError(t, ln.Close())
+	_, err = os.Lstat(path)
+	require.True(t, os.IsNotExist(err))
+}
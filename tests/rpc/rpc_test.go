@@ -53,6 +53,12 @@ func TestMain(m *testing.M) {
 		os.Exit(1)
 	}
 
+	// the accounts of the node are locked by default
+	if _, err := callWithError("personal_unlockAccount", []interface{}{hexutil.Bytes(from), "", 0}); err != nil {
+		fmt.Printf("failed to unlock account: %s\n", err)
+		os.Exit(1)
+	}
+
 	// Start all tests
 	code := m.Run()
 	os.Exit(code)
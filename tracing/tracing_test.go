@@ -0,0 +1,111 @@
+package tracing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func setupRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		require.NoError(t, provider.Shutdown(context.Background()))
+	})
+	return recorder
+}
+
+func TestStartSpan(t *testing.T) {
+	recorder := setupRecorder(t)
+	testTracer := otel.Tracer("test")
+
+	// untraced context
+	ctx := context.Background()
+	spanCtx, span := StartSpan(ctx, testTracer, "untraced")
+	span.End()
+	require.Equal(t, ctx, spanCtx)
+	require.False(t, span.IsRecording())
+	require.Empty(t, recorder.Ended())
+
+	// child of the span of the context
+	ctx, parent := testTracer.Start(ctx, "parent")
+	_, span = StartSpan(ctx, testTracer, "child")
+	span.End()
+	parent.End()
+
+	ended := recorder.Ended()
+	require.Len(t, ended, 2)
+	require.Equal(t, "child", ended[0].Name())
+	require.Equal(t, parent.SpanContext().SpanID(), ended[0].Parent().SpanID())
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	recorder := setupRecorder(t)
+	interceptor := UnaryClientInterceptor()
+
+	var outgoing metadata.MD
+	invoker := func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		outgoing, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	// untraced calls are passed through
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-cosmos-block-height", "1")
+	require.NoError(t, interceptor(ctx, "/ethermint.evm.v1.Query/EthCall", nil, nil, nil, invoker))
+	require.Empty(t, outgoing.Get("traceparent"))
+	require.Empty(t, recorder.Ended())
+
+	// traced calls are recorded and propagate the trace context
+	ctx, parent := otel.Tracer("test").Start(ctx, "eth_call")
+	defer parent.End()
+	require.NoError(t, interceptor(ctx, "/ethermint.evm.v1.Query/EthCall", nil, nil, nil, invoker))
+	require.Equal(t, []string{"1"}, outgoing.Get("x-cosmos-block-height"))
+	require.NotEmpty(t, outgoing.Get("traceparent"))
+
+	ended := recorder.Ended()
+	require.Len(t, ended, 1)
+	require.Equal(t, "ethermint.evm.v1.Query/EthCall", ended[0].Name())
+	require.Equal(t, trace.SpanKindClient, ended[0].SpanKind())
+
+	// the server continues the trace of the client span
+	serverCtx := ExtractGRPC(metadata.NewIncomingContext(context.Background(), outgoing))
+	remote := trace.SpanContextFromContext(serverCtx)
+	require.True(t, remote.IsRemote())
+	require.Equal(t, parent.SpanContext().TraceID(), remote.TraceID())
+	require.Equal(t, ended[0].SpanContext().SpanID(), remote.SpanID())
+}
+
+func TestSetupFileExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.json")
+	shutdown, err := Setup(Options{
+		ServiceName: "ethermint",
+		Exporter:    ExporterFile,
+		FilePath:    path,
+		SampleRatio: 1,
+	})
+	require.NoError(t, err)
+
+	_, span := otel.Tracer("test").Start(context.Background(), "eth_blockNumber")
+	span.End()
+	require.NoError(t, shutdown(context.Background()))
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), "eth_blockNumber")
+	require.Contains(t, string(bz), "ethermint")
+
+	_, err = Setup(Options{Exporter: "zipkin"})
+	require.Error(t, err)
+}
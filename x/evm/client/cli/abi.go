@@ -0,0 +1,291 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package cli
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// methodNameRegex matches the name of a contract method
+var methodNameRegex = regexp.MustCompile(`^[a-zA-Z_$][a-zA-Z0-9_$]*$`)
+
+// parseMethod parses a method signature in the form `name(inputs)` or `name(inputs)(outputs)`,
+// e.g. `balanceOf(address)(uint256)`. The types are separated by commas, tuples aren't supported.
+func parseMethod(signature string) (abi.Method, error) {
+	signature = strings.TrimSpace(signature)
+
+	start := strings.Index(signature, "(")
+	if start < 0 {
+		return abi.Method{}, fmt.Errorf("invalid method signature %q: missing argument list", signature)
+	}
+
+	name := signature[:start]
+	if !methodNameRegex.MatchString(name) {
+		return abi.Method{}, fmt.Errorf("invalid method name %q", name)
+	}
+
+	inputs, rest, err := parseArgumentList(signature[start:])
+	if err != nil {
+		return abi.Method{}, fmt.Errorf("invalid method signature %q: %w", signature, err)
+	}
+
+	var outputs abi.Arguments
+	if rest != "" {
+		if outputs, rest, err = parseArgumentList(rest); err != nil {
+			return abi.Method{}, fmt.Errorf("invalid method signature %q: %w", signature, err)
+		}
+		if rest != "" {
+			return abi.Method{}, fmt.Errorf("invalid method signature %q: unexpected %q", signature, rest)
+		}
+	}
+
+	return abi.NewMethod(name, name, abi.Function, "", false, false, inputs, outputs), nil
+}
+
+// parseArgumentList parses a parenthesized list of types and returns the remaining string.
+func parseArgumentList(list string) (abi.Arguments, string, error) {
+	if !strings.HasPrefix(list, "(") {
+		return nil, "", fmt.Errorf("expected '(' at %q", list)
+	}
+
+	end := strings.Index(list, ")")
+	if end < 0 {
+		return nil, "", fmt.Errorf("missing ')' in %q", list)
+	}
+
+	content := strings.TrimSpace(list[1:end])
+	rest := strings.TrimSpace(list[end+1:])
+	if strings.Contains(content, "(") {
+		return nil, "", fmt.Errorf("tuple types are not supported")
+	}
+	if content == "" {
+		return abi.Arguments{}, rest, nil
+	}
+
+	var args abi.Arguments
+	for _, typeName := range strings.Split(content, ",") {
+		// the parameter names are accepted and ignored, e.g. `transfer(address to, uint256 amount)`
+		fields := strings.Fields(typeName)
+		if len(fields) == 0 {
+			return nil, "", fmt.Errorf("empty type in %q", list)
+		}
+
+		typ, err := abi.NewType(fields[0], "", nil)
+		if err != nil {
+			return nil, "", err
+		}
+		args = append(args, abi.Argument{Type: typ})
+	}
+
+	return args, rest, nil
+}
+
+// packArguments ABI-encodes the values given as strings to the CLI. The arrays are written as
+// `[a,b,c]`.
+func packArguments(args abi.Arguments, values []string) ([]byte, error) {
+	if len(args) != len(values) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(args), len(values))
+	}
+
+	parsed := make([]interface{}, len(values))
+	for i, value := range values {
+		var err error
+		if parsed[i], err = parseValue(args[i].Type, value); err != nil {
+			return nil, fmt.Errorf("invalid argument %d: %w", i, err)
+		}
+	}
+
+	return args.Pack(parsed...)
+}
+
+// parseValue converts a string to the Go value of the ABI type.
+func parseValue(typ abi.Type, value string) (interface{}, error) {
+	value = strings.TrimSpace(value)
+
+	switch typ.T {
+	case abi.IntTy, abi.UintTy:
+		return parseInteger(typ, value)
+	case abi.BoolTy:
+		return strconv.ParseBool(value)
+	case abi.StringTy:
+		return value, nil
+	case abi.AddressTy:
+		addr, err := accountToHex(value)
+		if err != nil {
+			return nil, err
+		}
+		return common.HexToAddress(addr), nil
+	case abi.BytesTy:
+		return hexutil.Decode(value)
+	case abi.FixedBytesTy:
+		bz, err := hexutil.Decode(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) > typ.Size {
+			return nil, fmt.Errorf("%s exceeds %d bytes", value, typ.Size)
+		}
+		array := reflect.New(typ.GetType()).Elem()
+		reflect.Copy(array, reflect.ValueOf(bz))
+		return array.Interface(), nil
+	case abi.SliceTy, abi.ArrayTy:
+		elems, err := splitList(value)
+		if err != nil {
+			return nil, err
+		}
+
+		var list reflect.Value
+		if typ.T == abi.SliceTy {
+			list = reflect.MakeSlice(typ.GetType(), len(elems), len(elems))
+		} else {
+			if len(elems) != typ.Size {
+				return nil, fmt.Errorf("expected %d elements, got %d", typ.Size, len(elems))
+			}
+			list = reflect.New(typ.GetType()).Elem()
+		}
+
+		for i, elem := range elems {
+			v, err := parseValue(*typ.Elem, elem)
+			if err != nil {
+				return nil, err
+			}
+			list.Index(i).Set(reflect.ValueOf(v))
+		}
+		return list.Interface(), nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", typ)
+	}
+}
+
+// parseInteger parses a decimal or 0x-prefixed hexadecimal integer within the bounds of the type.
+func parseInteger(typ abi.Type, value string) (interface{}, error) {
+	n, ok := new(big.Int).SetString(value, 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %q", value)
+	}
+
+	if typ.T == abi.UintTy {
+		if n.Sign() < 0 || n.BitLen() > typ.Size {
+			return nil, fmt.Errorf("%s overflows %s", value, typ)
+		}
+	} else {
+		limit := new(big.Int).Lsh(common.Big1, uint(typ.Size-1))
+		if n.Cmp(limit) >= 0 || n.Cmp(new(big.Int).Neg(limit)) < 0 {
+			return nil, fmt.Errorf("%s overflows %s", value, typ)
+		}
+	}
+
+	goType := typ.GetType()
+	switch goType.Kind() {
+	case reflect.Ptr:
+		return n, nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.ValueOf(n.Int64()).Convert(goType).Interface(), nil
+	default:
+		return reflect.ValueOf(n.Uint64()).Convert(goType).Interface(), nil
+	}
+}
+
+// splitList splits a list written as `[a,b,c]`, the nested lists are kept whole.
+func splitList(value string) ([]string, error) {
+	if !strings.HasPrefix(value, "[") || !strings.HasSuffix(value, "]") {
+		return nil, fmt.Errorf("expected a list in brackets, got %q", value)
+	}
+
+	content := strings.TrimSpace(value[1 : len(value)-1])
+	if content == "" {
+		return nil, nil
+	}
+
+	var (
+		elems []string
+		depth int
+		start int
+	)
+	for i, c := range content {
+		switch c {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case ',':
+			if depth == 0 {
+				elems = append(elems, content[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced brackets in %q", value)
+	}
+
+	return append(elems, content[start:]), nil
+}
+
+// formatValues converts the values decoded from the ABI to values printable as JSON: the integers
+// are written in decimal strings, the addresses in checksummed hex and the bytes in 0x-prefixed hex.
+func formatValues(values []interface{}) []interface{} {
+	formatted := make([]interface{}, len(values))
+	for i, value := range values {
+		formatted[i] = formatValue(reflect.ValueOf(value))
+	}
+	return formatted
+}
+
+func formatValue(value reflect.Value) interface{} {
+	switch v := value.Interface().(type) {
+	case *big.Int:
+		return v.String()
+	case common.Address:
+		return v.Hex()
+	case []byte:
+		return hexutil.Encode(v)
+	}
+
+	switch value.Kind() {
+	case reflect.Bool:
+		return value.Bool()
+	case reflect.String:
+		return value.String()
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10)
+	case reflect.Array, reflect.Slice:
+		if value.Kind() == reflect.Array && value.Type().Elem().Kind() == reflect.Uint8 {
+			bz := make([]byte, value.Len())
+			reflect.Copy(reflect.ValueOf(bz), value)
+			return hexutil.Encode(bz)
+		}
+
+		list := make([]interface{}, value.Len())
+		for i := range list {
+			list[i] = formatValue(value.Index(i))
+		}
+		return list
+	default:
+		return fmt.Sprint(value.Interface())
+	}
+}
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMethod(t *testing.T) {
+	testCases := []struct {
+		name      string
+		signature string
+		sig       string
+		outputs   int
+		expectErr bool
+	}{
+		{"no arguments", "totalSupply()", "totalSupply()", 0, false},
+		{"with outputs", "balanceOf(address)(uint256)", "balanceOf(address)", 1, false},
+		{"parameter names", "transfer(address to, uint256 amount)(bool)", "transfer(address,uint256)", 1, false},
+		{"arrays", "f(uint8[2],bytes32[])", "f(uint8[2],bytes32[])", 0, false},
+		{"missing arguments", "totalSupply", "", 0, true},
+		{"invalid name", "1f()", "", 0, true},
+		{"invalid type", "f(foo)", "", 0, true},
+		{"tuple", "f((uint256,address))", "", 0, true},
+		{"trailing characters", "f()(uint256)x", "", 0, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			method, err := parseMethod(tc.signature)
+			require.Equal(t, tc.expectErr, err != nil, err)
+
+			if !tc.expectErr {
+				require.Equal(t, tc.sig, method.Sig)
+				require.Len(t, method.Outputs, tc.outputs)
+			}
+		})
+	}
+}
+
+func TestPackArguments(t *testing.T) {
+	method, err := parseMethod("transfer(address,uint256)")
+	require.NoError(t, err)
+
+	packed, err := packArguments(method.Inputs, []string{"0x70997970C51812dc3A010C7d01b50e0d17dc79C8", "0x64"})
+	require.NoError(t, err)
+	require.Equal(t, "0xa9059cbb", hexutil.Encode(method.ID))
+	require.Equal(t,
+		"0x00000000000000000000000070997970c51812dc3a010c7d01b50e0d17dc79c8"+
+			"0000000000000000000000000000000000000000000000000000000000000064",
+		hexutil.Encode(packed),
+	)
+
+	testCases := []struct {
+		name      string
+		signature string
+		args      []string
+		expectErr bool
+	}{
+		{"small integers", "f(uint8,int16,int256)", []string{"255", "-32768", "-1"}, false},
+		{"uint8 overflow", "f(uint8)", []string{"256"}, true},
+		{"int8 overflow", "f(int8)", []string{"128"}, true},
+		{"negative uint", "f(uint256)", []string{"-1"}, true},
+		{"bool and string", "f(bool,string)", []string{"true", "hello world"}, false},
+		{"bytes", "f(bytes,bytes4)", []string{"0x0102", "0x01020304"}, false},
+		{"fixed bytes too long", "f(bytes2)", []string{"0x010203"}, true},
+		{"arrays", "f(uint256[],address[2],uint8[][])", []string{"[1, 2]", "[0x70997970C51812dc3A010C7d01b50e0d17dc79C8,0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266]", "[[1],[]]"}, false}, //nolint:lll
+		{"wrong array length", "f(uint256[2])", []string{"[1]"}, true},
+		{"unbalanced brackets", "f(uint8[][])", []string{"[[1]"}, true},
+		{"missing argument", "f(uint256,bool)", []string{"1"}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			method, err := parseMethod(tc.signature)
+			require.NoError(t, err)
+
+			packed, err := packArguments(method.Inputs, tc.args)
+			require.Equal(t, tc.expectErr, err != nil, err)
+
+			if !tc.expectErr {
+				// the arguments are decoded back
+				_, err := method.Inputs.Unpack(packed)
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFormatValues(t *testing.T) {
+	method, err := parseMethod("f()(uint256,int8,address,bytes,bytes2,bool,string,uint16[])")
+	require.NoError(t, err)
+
+	addr := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	packed, err := method.Outputs.Pack(
+		big.NewInt(1000), int8(-1), addr, []byte{1, 2}, [2]byte{3, 4}, true, "hello", []uint16{1, 2},
+	)
+	require.NoError(t, err)
+
+	values, err := method.Outputs.Unpack(packed)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{
+		"1000", "-1", addr.Hex(), "0x0102", "0x0304", true, "hello", []interface{}{"1", "2"},
+	}, formatValues(values))
+}
@@ -0,0 +1,124 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package cli
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// contractArtifact is the JSON output of the contract compilation, in the formats of Hardhat
+// (`bytecode` string), Foundry (`bytecode.object`) and solc (`bin`).
+type contractArtifact struct {
+	ABI      json.RawMessage `json:"abi"`
+	Bytecode json.RawMessage `json:"bytecode"`
+	Bin      string          `json:"bin"`
+}
+
+// loadContract returns the contract of the compilation artifact at the given path, or the contract
+// without ABI of the given hex encoded bytecode. The boolean reports whether the source is an artifact.
+func loadContract(source string) (*types.CompiledContract, bool, error) {
+	if _, err := os.Stat(source); err != nil {
+		bin, err := decodeBytecode(source)
+		if err != nil {
+			return nil, false, fmt.Errorf("%s is neither an artifact file nor a bytecode: %w", source, err)
+		}
+		return &types.CompiledContract{Bin: bin}, false, nil
+	}
+
+	bz, err := os.ReadFile(source)
+	if err != nil {
+		return nil, false, err
+	}
+
+	contract, err := parseArtifact(bz)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid artifact %s: %w", source, err)
+	}
+	return contract, true, nil
+}
+
+func parseArtifact(bz []byte) (*types.CompiledContract, error) {
+	var artifact contractArtifact
+	if err := json.Unmarshal(bz, &artifact); err != nil {
+		return nil, err
+	}
+
+	contract := &types.CompiledContract{}
+
+	abiJSON := artifact.ABI
+	if bytes.HasPrefix(bytes.TrimSpace(abiJSON), []byte(`"`)) {
+		// the ABI is encoded in a string
+		var s string
+		if err := json.Unmarshal(abiJSON, &s); err != nil {
+			return nil, err
+		}
+		abiJSON = json.RawMessage(s)
+	}
+	if len(abiJSON) > 0 {
+		parsed, err := abi.JSON(bytes.NewReader(abiJSON))
+		if err != nil {
+			return nil, fmt.Errorf("invalid ABI: %w", err)
+		}
+		contract.ABI = parsed
+	}
+
+	bytecode := artifact.Bin
+	if len(artifact.Bytecode) > 0 {
+		var object struct {
+			Object string `json:"object"`
+		}
+		if err := json.Unmarshal(artifact.Bytecode, &bytecode); err != nil {
+			if err := json.Unmarshal(artifact.Bytecode, &object); err != nil {
+				return nil, fmt.Errorf("invalid bytecode: %w", err)
+			}
+			bytecode = object.Object
+		}
+	}
+
+	bin, err := decodeBytecode(bytecode)
+	if err != nil {
+		return nil, err
+	}
+	contract.Bin = bin
+
+	return contract, nil
+}
+
+// decodeBytecode decodes a hex encoded bytecode, with or without the 0x prefix.
+func decodeBytecode(bytecode string) ([]byte, error) {
+	bytecode = strings.TrimPrefix(strings.TrimSpace(bytecode), "0x")
+	if strings.Contains(bytecode, "__") {
+		return nil, fmt.Errorf("the bytecode references unlinked libraries")
+	}
+
+	bin, err := hex.DecodeString(bytecode)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bytecode: %w", err)
+	}
+	if len(bin) == 0 {
+		return nil, fmt.Errorf("empty bytecode")
+	}
+	return bin, nil
+}
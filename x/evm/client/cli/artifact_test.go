@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testABI = `[{"inputs":[{"name":"supply","type":"uint256"}],"stateMutability":"nonpayable","type":"constructor"}]`
+
+func TestParseArtifact(t *testing.T) {
+	testCases := []struct {
+		name      string
+		artifact  string
+		expectErr bool
+	}{
+		{"hardhat", `{"contractName":"Token","abi":` + testABI + `,"bytecode":"0x6080"}`, false},
+		{"foundry", `{"abi":` + testABI + `,"bytecode":{"object":"0x6080","linkReferences":{}}}`, false},
+		{"solc", `{"abi":` + testABI + `,"bin":"6080"}`, false},
+		{"abi in a string", `{"abi":"[{\"inputs\":[{\"name\":\"supply\",\"type\":\"uint256\"}],\"type\":\"constructor\"}]","bin":"6080"}`, false}, //nolint:lll
+		{"unlinked library", `{"abi":[],"bytecode":"0x6080__$1234$__"}`, true},
+		{"missing bytecode", `{"abi":` + testABI + `}`, true},
+		{"invalid abi", `{"abi":{},"bytecode":"0x6080"}`, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			contract, err := parseArtifact([]byte(tc.artifact))
+			require.Equal(t, tc.expectErr, err != nil, err)
+
+			if !tc.expectErr {
+				require.Equal(t, []byte{0x60, 0x80}, []byte(contract.Bin))
+				require.Len(t, contract.ABI.Constructor.Inputs, 1)
+			}
+		})
+	}
+}
+
+func TestLoadContract(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "Token.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"abi":`+testABI+`,"bytecode":"0x6080"}`), 0o600))
+
+	contract, isArtifact, err := loadContract(path)
+	require.NoError(t, err)
+	require.True(t, isArtifact)
+	require.Len(t, contract.ABI.Constructor.Inputs, 1)
+
+	contract, isArtifact, err = loadContract("0x6080")
+	require.NoError(t, err)
+	require.False(t, isArtifact)
+	require.Equal(t, []byte{0x60, 0x80}, []byte(contract.Bin))
+
+	_, _, err = loadContract("missing.json")
+	require.Error(t, err)
+}
@@ -16,15 +16,49 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
 	"github.com/spf13/cobra"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 
+	"github.com/evmos/ethermint/server/config"
+	ethermint "github.com/evmos/ethermint/types"
 	"github.com/evmos/ethermint/x/evm/types"
 )
 
+// flags of the call, estimate-gas and trace commands
+const (
+	flagFrom             = "from"
+	flagTo               = "to"
+	flagData             = "data"
+	flagValue            = "value"
+	flagGas              = "gas"
+	flagGasCap           = "gas-cap"
+	flagTracer           = "tracer"
+	flagTracerConfig     = "tracer-config"
+	flagTraceTimeout     = "trace-timeout"
+	flagDisableStack     = "disable-stack"
+	flagDisableStorage   = "disable-storage"
+	flagEnableMemory     = "enable-memory"
+	flagEnableReturnData = "enable-return-data"
+	flagTraceLimit       = "trace-limit"
+)
+
 // GetQueryCmd returns the parent command for all x/bank CLi query commands.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -39,6 +73,10 @@ func GetQueryCmd() *cobra.Command {
 		GetStorageCmd(),
 		GetCodeCmd(),
 		GetParamsCmd(),
+		GetCallCmd(),
+		GetEstimateGasCmd(),
+		GetTraceTxCmd(),
+		GetTraceBlockCmd(),
 	)
 	return cmd
 }
@@ -147,3 +185,472 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCallCmd executes a message call without creating a transaction
+func GetCallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call [METHOD [ARGS...]]",
+		Short: "Executes a message call against the state of a block",
+		Long: `Executes a message call against the state of a block, without creating a transaction. If the height is not provided, it will use the latest height from context.
+
+The call data is either given with --data, or built from a method signature and its arguments. The values returned are decoded when the signature lists the output types, the arrays are written as [a,b,c].`, //nolint:lll
+		Example: fmt.Sprintf(`%[1]s query evm call --to 0x5FbDB2315678afecb367f032d93F642f64180aa3 "balanceOf(address)(uint256)" 0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266
+%[1]s query evm call --to 0x5FbDB2315678afecb367f032d93F642f64180aa3 --data 0x18160ddd`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txArgs, method, err := txArgsFromFlags(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			req, err := newEthCallRequest(cmd, clientCtx, txArgs)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EthCall(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			if res.Failed() {
+				if res.VmError != vm.ErrExecutionReverted.Error() {
+					return errors.New(res.VmError)
+				}
+				return types.NewExecErrorWithReason(res.Ret)
+			}
+
+			result := callResult{Return: res.Ret}
+			if method != nil && len(method.Outputs) > 0 {
+				values, err := method.Outputs.Unpack(res.Ret)
+				if err != nil {
+					return fmt.Errorf("failed to decode the returned values: %w", err)
+				}
+				result.Decoded = formatValues(values)
+			}
+
+			bz, err := json.Marshal(result)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	addCallFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetEstimateGasCmd estimates the gas needed by a transaction
+func GetEstimateGasCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-gas [METHOD [ARGS...]]",
+		Short: "Estimates the gas needed by a transaction",
+		Long: `Estimates the gas needed by a transaction against the state of a block. If the height is not provided, it will use the latest height from context.
+
+The call data is either given with --data, or built from a method signature and its arguments. A contract creation is estimated when --to isn't provided.`, //nolint:lll
+		Example: fmt.Sprintf(`%[1]s query evm estimate-gas --from 0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266 --to 0x5FbDB2315678afecb367f032d93F642f64180aa3 "transfer(address,uint256)" 0x70997970C51812dc3A010C7d01b50e0d17dc79C8 100
+%[1]s query evm estimate-gas --from 0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266 --to 0x70997970C51812dc3A010C7d01b50e0d17dc79C8 --value 1000`, version.AppName), //nolint:lll
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txArgs, _, err := txArgsFromFlags(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			req, err := newEthCallRequest(cmd, clientCtx, txArgs)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateGas(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addCallFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTraceTxCmd traces the execution of a transaction
+func GetTraceTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace TX_HASH",
+		Short: "Traces the execution of a transaction",
+		Long:  "Traces the execution of an Ethereum transaction, replaying the transactions preceding it in its block. The struct logger is used unless a tracer is provided.", //nolint:lll
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			hashBz, err := hexutil.Decode(args[0])
+			if err != nil || len(hashBz) != common.HashLength {
+				return fmt.Errorf("invalid transaction hash %s", args[0])
+			}
+			hash := common.BytesToHash(hashBz)
+
+			traceConfig, err := traceConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			query := fmt.Sprintf("%s.%s='%s'", types.TypeMsgEthereumTx, types.AttributeKeyEthereumTxHash, hash.Hex())
+			resTxs, err := node.TxSearch(ctx, query, false, nil, nil, "")
+			if err != nil {
+				return err
+			}
+			if len(resTxs.Txs) == 0 {
+				return fmt.Errorf("transaction %s not found", hash.Hex())
+			}
+			resTx := resTxs.Txs[0]
+
+			block, err := node.Block(ctx, &resTx.Height)
+			if err != nil {
+				return err
+			}
+			if int(resTx.Index) >= len(block.Block.Txs) {
+				return fmt.Errorf("transaction not included in block %d", block.Block.Height)
+			}
+
+			blockRes, err := node.BlockResults(ctx, &resTx.Height)
+			if err != nil {
+				return err
+			}
+
+			// the failed transactions didn't change the state, unless they exceeded the block gas limit
+			var predecessors []*types.MsgEthereumTx
+			for i, txBz := range block.Block.Txs[:resTx.Index] {
+				if !rpctypes.TxSuccessOrExceedsBlockGasLimit(blockRes.TxsResults[i]) {
+					continue
+				}
+				msgs, err := rpctypes.RawTxToEthTx(clientCtx, txBz)
+				if err != nil {
+					// not an Ethereum transaction
+					continue
+				}
+				predecessors = append(predecessors, msgs...)
+			}
+
+			msgs, err := rpctypes.RawTxToEthTx(clientCtx, block.Block.Txs[resTx.Index])
+			if err != nil {
+				return err
+			}
+
+			var msg *types.MsgEthereumTx
+			for _, m := range msgs {
+				if common.HexToHash(m.Hash) == hash {
+					msg = m
+					break
+				}
+				predecessors = append(predecessors, m)
+			}
+			if msg == nil {
+				return fmt.Errorf("transaction %s not found in block %d", hash.Hex(), block.Block.Height)
+			}
+
+			// the chain ID is set in the request, see newEthCallRequest
+			chainID, err := ethermint.ParseChainID(block.Block.ChainID)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTraceTxRequest{
+				Msg:             msg,
+				TraceConfig:     traceConfig,
+				Predecessors:    predecessors,
+				BlockNumber:     block.Block.Height,
+				BlockHash:       common.Bytes2Hex(block.BlockID.Hash),
+				BlockTime:       block.Block.Time,
+				ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
+				ChainId:         chainID.Int64(),
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TraceTx(rpctypes.ContextWithHeight(traceContextHeight(block.Block.Height)), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(res.Data)
+		},
+	}
+
+	addTraceFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTraceBlockCmd traces the execution of the transactions of a block
+func GetTraceBlockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace-block HEIGHT",
+		Short: "Traces the execution of the transactions of a block",
+		Long:  "Traces the execution of the Ethereum transactions of a block, returning one result per transaction. The struct logger is used unless a tracer is provided.", //nolint:lll
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil || height <= 0 {
+				return fmt.Errorf("invalid block height %s", args[0])
+			}
+
+			traceConfig, err := traceConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			block, err := node.Block(ctx, &height)
+			if err != nil {
+				return err
+			}
+
+			blockRes, err := node.BlockResults(ctx, &height)
+			if err != nil {
+				return err
+			}
+
+			var txs []*types.MsgEthereumTx
+			for i, txBz := range block.Block.Txs {
+				if !rpctypes.TxSuccessOrExceedsBlockGasLimit(blockRes.TxsResults[i]) {
+					continue
+				}
+				msgs, err := rpctypes.RawTxToEthTx(clientCtx, txBz)
+				if err != nil {
+					// not an Ethereum transaction
+					continue
+				}
+				txs = append(txs, msgs...)
+			}
+
+			// the chain ID is set in the request, see newEthCallRequest
+			chainID, err := ethermint.ParseChainID(block.Block.ChainID)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTraceBlockRequest{
+				Txs:             txs,
+				TraceConfig:     traceConfig,
+				BlockNumber:     block.Block.Height,
+				BlockHash:       common.Bytes2Hex(block.BlockID.Hash),
+				BlockTime:       block.Block.Time,
+				ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
+				ChainId:         chainID.Int64(),
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TraceBlock(rpctypes.ContextWithHeight(traceContextHeight(height)), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(res.Data)
+		},
+	}
+
+	addTraceFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// callResult is the output of the call command
+type callResult struct {
+	Return  hexutil.Bytes `json:"return"`
+	Decoded []interface{} `json:"decoded,omitempty"`
+}
+
+func addCallFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagFrom, "", "Address of the sender (hex or bech32)")
+	cmd.Flags().String(flagTo, "", "Address of the contract (hex or bech32)")
+	cmd.Flags().String(flagData, "", "Hex encoded call data, can't be used with a method signature")
+	cmd.Flags().String(flagValue, "0", "Amount of wei transferred, in decimal or 0x-prefixed hex")
+	cmd.Flags().Uint64(flagGas, 0, "Gas limit of the call, the gas cap if not provided")
+	cmd.Flags().Uint64(flagGasCap, config.DefaultGasCap, "Maximum gas of the call")
+}
+
+// txArgsFromFlags returns the arguments of the call and the method built from the positional
+// arguments, if any.
+func txArgsFromFlags(cmd *cobra.Command, args []string) (*types.TransactionArgs, *abi.Method, error) {
+	txArgs := &types.TransactionArgs{}
+
+	from, _ := cmd.Flags().GetString(flagFrom)
+	if from != "" {
+		addr, err := accountToHex(from)
+		if err != nil {
+			return nil, nil, err
+		}
+		sender := common.HexToAddress(addr)
+		txArgs.From = &sender
+	}
+
+	to, _ := cmd.Flags().GetString(flagTo)
+	if to != "" {
+		addr, err := accountToHex(to)
+		if err != nil {
+			return nil, nil, err
+		}
+		recipient := common.HexToAddress(addr)
+		txArgs.To = &recipient
+	}
+
+	value, _ := cmd.Flags().GetString(flagValue)
+	amount, ok := new(big.Int).SetString(value, 0)
+	if !ok || amount.Sign() < 0 {
+		return nil, nil, fmt.Errorf("invalid value %s", value)
+	}
+	txArgs.Value = (*hexutil.Big)(amount)
+
+	if gas, _ := cmd.Flags().GetUint64(flagGas); gas != 0 {
+		txArgs.Gas = (*hexutil.Uint64)(&gas)
+	}
+
+	data, _ := cmd.Flags().GetString(flagData)
+	var method *abi.Method
+	switch {
+	case len(args) > 0 && data != "":
+		return nil, nil, fmt.Errorf("--%s can't be used with a method signature", flagData)
+	case len(args) > 0:
+		m, err := parseMethod(args[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		packed, err := packArguments(m.Inputs, args[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+		input := hexutil.Bytes(append(m.ID, packed...))
+		txArgs.Input = &input
+		method = &m
+	case data != "":
+		input, err := hexutil.Decode(data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid call data: %w", err)
+		}
+		txArgs.Input = (*hexutil.Bytes)(&input)
+	}
+
+	return txArgs, method, nil
+}
+
+// newEthCallRequest returns the request of the call with the chain ID and the proposer of the
+// queried block, as the context of the queries doesn't have them until the node commits a block
+// after a restart.
+func newEthCallRequest(cmd *cobra.Command, clientCtx client.Context, txArgs *types.TransactionArgs) (*types.EthCallRequest, error) {
+	bz, err := json.Marshal(txArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := queryBlock(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := ethermint.ParseChainID(block.Block.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	gasCap, _ := cmd.Flags().GetUint64(flagGasCap)
+
+	return &types.EthCallRequest{
+		Args:            bz,
+		GasCap:          gasCap,
+		ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
+		ChainId:         chainID.Int64(),
+	}, nil
+}
+
+// queryBlock returns the block at the height of the client, or the latest block if the height is
+// not provided.
+func queryBlock(clientCtx client.Context) (*coretypes.ResultBlock, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	var height *int64
+	if clientCtx.Height > 0 {
+		height = &clientCtx.Height
+	}
+	return node.Block(context.Background(), height)
+}
+
+func addTraceFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagTracer, "", "Name of a built-in tracer (e.g. callTracer) or JavaScript tracer code")
+	cmd.Flags().String(flagTracerConfig, "", "JSON configuration of the tracer")
+	cmd.Flags().String(flagTraceTimeout, "", "Timeout of the JavaScript tracers (e.g. 10s)")
+	cmd.Flags().Bool(flagDisableStack, false, "Disable the stack capture of the struct logger")
+	cmd.Flags().Bool(flagDisableStorage, false, "Disable the storage capture of the struct logger")
+	cmd.Flags().Bool(flagEnableMemory, false, "Enable the memory capture of the struct logger")
+	cmd.Flags().Bool(flagEnableReturnData, false, "Enable the return data capture of the struct logger")
+	cmd.Flags().Int32(flagTraceLimit, 0, "Maximum number of logs captured by the struct logger, unlimited if zero")
+}
+
+func traceConfigFromFlags(cmd *cobra.Command) (*types.TraceConfig, error) {
+	traceConfig := &types.TraceConfig{}
+	traceConfig.Tracer, _ = cmd.Flags().GetString(flagTracer)
+	traceConfig.TracerJsonConfig, _ = cmd.Flags().GetString(flagTracerConfig)
+	traceConfig.Timeout, _ = cmd.Flags().GetString(flagTraceTimeout)
+	traceConfig.DisableStack, _ = cmd.Flags().GetBool(flagDisableStack)
+	traceConfig.DisableStorage, _ = cmd.Flags().GetBool(flagDisableStorage)
+	traceConfig.EnableMemory, _ = cmd.Flags().GetBool(flagEnableMemory)
+	traceConfig.EnableReturnData, _ = cmd.Flags().GetBool(flagEnableReturnData)
+	traceConfig.Limit, _ = cmd.Flags().GetInt32(flagTraceLimit)
+
+	if traceConfig.TracerJsonConfig != "" && !json.Valid([]byte(traceConfig.TracerJsonConfig)) {
+		return nil, fmt.Errorf("invalid tracer configuration %s", traceConfig.TracerJsonConfig)
+	}
+	if traceConfig.Limit < 0 {
+		return nil, fmt.Errorf("invalid trace limit %d", traceConfig.Limit)
+	}
+
+	return traceConfig, nil
+}
+
+// traceContextHeight returns the height of the state at the beginning of the given block.
+func traceContextHeight(height int64) int64 {
+	if height <= 1 {
+		// 0 is a special value in `ContextWithHeight`
+		return 1
+	}
+	return height - 1
+}
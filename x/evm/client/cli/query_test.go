@@ -0,0 +1,77 @@
+//go:build norace
+// +build norace
+
+package cli_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/suite"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/evmos/ethermint/testutil/network"
+	"github.com/evmos/ethermint/x/evm/client/cli"
+)
+
+type QueryTestSuite struct {
+	suite.Suite
+
+	network *network.Network
+}
+
+func (s *QueryTestSuite) SetupSuite() {
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+
+	var err error
+	s.network, err = network.New(s.T(), s.T().TempDir(), cfg)
+	s.Require().NoError(err)
+
+	_, err = s.network.WaitForHeight(2)
+	s.Require().NoError(err)
+}
+
+func (s *QueryTestSuite) TearDownSuite() {
+	s.network.Cleanup()
+}
+
+func (s *QueryTestSuite) TestCallAtHeight() {
+	val := s.network.Validators[0]
+	from := common.BytesToAddress(val.Address)
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	for _, height := range []string{"1", "0"} {
+		s.Run(fmt.Sprintf("height %s", height), func() {
+			args := []string{
+				fmt.Sprintf("--from=%s", from.Hex()),
+				fmt.Sprintf("--to=%s", to.Hex()),
+				"--value=1",
+				fmt.Sprintf("--%s=%s", flags.FlagHeight, height),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			}
+
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCallCmd(), args)
+			s.Require().NoError(err)
+
+			var res struct {
+				Return hexutil.Bytes `json:"return"`
+			}
+			s.Require().NoError(json.Unmarshal(out.Bytes(), &res), out.String())
+			s.Require().Empty(res.Return)
+
+			out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetEstimateGasCmd(), args)
+			s.Require().NoError(err)
+			s.Require().Contains(out.String(), `"gas":"21000"`)
+		})
+	}
+}
+
+func TestQueryTestSuite(t *testing.T) {
+	suite.Run(t, new(QueryTestSuite))
+}
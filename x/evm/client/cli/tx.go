@@ -17,18 +17,35 @@ package cli
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"os"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
+	"github.com/evmos/ethermint/server/config"
+	ethermint "github.com/evmos/ethermint/types"
 	"github.com/evmos/ethermint/x/evm/types"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
+)
+
+// flags of the deploy and send commands
+const (
+	flagMaxFeePerGas         = "max-fee-per-gas"
+	flagMaxPriorityFeePerGas = "max-priority-fee-per-gas"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -40,7 +57,11 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	cmd.AddCommand(NewRawTxCmd())
+	cmd.AddCommand(
+		NewRawTxCmd(),
+		NewDeployCmd(),
+		NewSendCmd(),
+	)
 	return cmd
 }
 
@@ -75,52 +96,374 @@ func NewRawTxCmd() *cobra.Command {
 				return err
 			}
 
-			tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), rsp.Params.EvmDenom)
+			return buildAndBroadcastTx(clientCtx, msg, rsp.Params.EvmDenom)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewDeployCmd command deploys a contract from its bytecode or its compilation artifact
+func NewDeployCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deploy BYTECODE|ARTIFACT [ARGS...]",
+		Short: "Deploy a contract from its bytecode or its compilation artifact",
+		Long: `Deploy a contract from its hex encoded bytecode or from the JSON artifact produced by Hardhat or Foundry, signing the Ethereum transaction with an eth_secp256k1 key of the keyring.
+
+The constructor arguments are encoded with the ABI of the artifact. With a bytecode, the constructor signature must precede the arguments, e.g. "constructor(address,uint256)". The arrays are written as [a,b,c].
+
+The gas limit is estimated unless --gas is provided, and the fees follow the base fee of the fee market unless --max-fee-per-gas and --max-priority-fee-per-gas are provided.`, //nolint:lll
+		Example: fmt.Sprintf(`%[1]s tx evm deploy artifacts/contracts/Token.sol/Token.json 1000000 --from mykey
+%[1]s tx evm deploy 0x6080... "constructor(uint256)" 1000000 --from mykey`, version.AppName),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			if clientCtx.GenerateOnly {
-				json, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+			contract, isArtifact, err := loadContract(args[0])
+			if err != nil {
+				return err
+			}
+
+			ctorArgs := args[1:]
+			inputs := contract.ABI.Constructor.Inputs
+			if !isArtifact && len(ctorArgs) > 0 {
+				// the bytecode comes without ABI, the signature of the constructor is the first argument
+				ctor, err := parseMethod(ctorArgs[0])
 				if err != nil {
 					return err
 				}
+				inputs, ctorArgs = ctor.Inputs, ctorArgs[1:]
+			}
 
-				return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+			packed, err := packArguments(inputs, ctorArgs)
+			if err != nil {
+				return err
 			}
 
-			if !clientCtx.SkipConfirm {
-				out, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
-				if err != nil {
-					return err
-				}
+			data := append(contract.Bin, packed...)
+			msg, err := newEthereumTx(cmd, clientCtx, nil, data)
+			if err != nil {
+				return err
+			}
 
-				_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", out)
+			address := crypto.CreateAddress(common.BytesToAddress(clientCtx.FromAddress), msg.AsTransaction().Nonce())
+			_, _ = fmt.Fprintf(os.Stderr, "contract address: %s\n", address.Hex())
 
-				buf := bufio.NewReader(os.Stdin)
-				ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", buf, os.Stderr)
+			return signAndBroadcastTx(cmd, clientCtx, msg)
+		},
+	}
 
-				if err != nil || !ok {
-					_, _ = fmt.Fprintf(os.Stderr, "%s\n", "canceled transaction")
-					return err
-				}
+	addEthereumTxFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSendCmd command calls a contract method in a transaction
+func NewSendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send ADDRESS METHOD [ARGS...]",
+		Short: "Call a contract method in a transaction",
+		Long: `Call a contract method in a transaction, signing it with an eth_secp256k1 key of the keyring. The call data is built from the method signature and its arguments, the arrays are written as [a,b,c].
+
+The gas limit is estimated unless --gas is provided, and the fees follow the base fee of the fee market unless --max-fee-per-gas and --max-priority-fee-per-gas are provided.`, //nolint:lll
+		Example: fmt.Sprintf(`%s tx evm send 0x5FbDB2315678afecb367f032d93F642f64180aa3 "transfer(address,uint256)" 0x70997970C51812dc3A010C7d01b50e0d17dc79C8 100 --from mykey`, version.AppName), //nolint:lll
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := accountToHex(args[0])
+			if err != nil {
+				return err
 			}
+			to := common.HexToAddress(addr)
 
-			txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+			method, err := parseMethod(args[1])
 			if err != nil {
 				return err
 			}
 
-			// broadcast to a Tendermint node
-			res, err := clientCtx.BroadcastTx(txBytes)
+			packed, err := packArguments(method.Inputs, args[2:])
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			msg, err := newEthereumTx(cmd, clientCtx, &to, append(method.ID, packed...))
+			if err != nil {
+				return err
+			}
+
+			return signAndBroadcastTx(cmd, clientCtx, msg)
 		},
 	}
 
+	addEthereumTxFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func addEthereumTxFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagValue, "0", "Amount of wei transferred, in decimal or 0x-prefixed hex")
+	cmd.Flags().String(flagMaxFeePerGas, "", "Maximum fee per gas in wei, twice the base fee plus the priority fee if not provided")
+	cmd.Flags().String(flagMaxPriorityFeePerGas, "", "Maximum priority fee per gas in wei, the maximum base fee change of a block if not provided") //nolint:lll
+}
+
+// newEthereumTx returns the unsigned transaction of the sender with the nonce, the gas limit and the
+// fees taken from the flags, or queried from the chain.
+func newEthereumTx(cmd *cobra.Command, clientCtx client.Context, to *common.Address, data []byte) (*types.MsgEthereumTx, error) {
+	if clientCtx.FromAddress.Empty() {
+		return nil, fmt.Errorf("the sender must be provided with --%s", flags.FlagFrom)
+	}
+	from := common.BytesToAddress(clientCtx.FromAddress)
+
+	chainID, err := ethermint.ParseChainID(clientCtx.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	value, _ := cmd.Flags().GetString(flagValue)
+	amount, ok := new(big.Int).SetString(value, 0)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("invalid value %s", value)
+	}
+
+	queryClient := rpctypes.NewQueryClient(clientCtx)
+	ctx := cmd.Context()
+
+	var nonce uint64
+	if cmd.Flags().Changed(flags.FlagSequence) {
+		nonce, _ = cmd.Flags().GetUint64(flags.FlagSequence)
+	} else {
+		res, err := queryClient.Account(ctx, &types.QueryAccountRequest{Address: from.Hex()})
+		if err != nil {
+			return nil, err
+		}
+		nonce = res.Nonce
+	}
+
+	gasStr, _ := cmd.Flags().GetString(flags.FlagGas)
+	gasSetting, err := flags.ParseGasSetting(gasStr)
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit := gasSetting.Gas
+	if gasStr == "" || gasSetting.Simulate {
+		input := hexutil.Bytes(data)
+		txArgs, err := json.Marshal(&types.TransactionArgs{
+			From:  &from,
+			To:    to,
+			Value: (*hexutil.Big)(amount),
+			Input: &input,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// the proposer is taken from the latest block, as in the estimate-gas command
+		block, err := queryBlock(clientCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := queryClient.EstimateGas(ctx, &types.EthCallRequest{
+			Args:            txArgs,
+			GasCap:          config.DefaultGasCap,
+			ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
+			ChainId:         chainID.Int64(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate gas: %w", err)
+		}
+
+		gasAdjustment, _ := cmd.Flags().GetFloat64(flags.FlagGasAdjustment)
+		gasLimit = uint64(gasAdjustment * float64(res.Gas))
+	}
+
+	gasFeeCap, gasTipCap, err := feesFromFlags(cmd, queryClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var msg *types.MsgEthereumTx
+	if gasTipCap == nil {
+		// london hardfork not enabled or fee market not enabled, the fee cap is the gas price
+		msg = types.NewTx(chainID, nonce, to, amount, gasLimit, gasFeeCap, nil, nil, data, nil)
+	} else {
+		msg = types.NewTx(chainID, nonce, to, amount, gasLimit, nil, gasFeeCap, gasTipCap, data, &ethtypes.AccessList{})
+	}
+	msg.From = from.Hex()
+
+	return msg, nil
+}
+
+// feesFromFlags returns the fee caps of a dynamic fee transaction, or the gas price and a nil tip
+// cap if the base fee is disabled.
+func feesFromFlags(cmd *cobra.Command, queryClient *rpctypes.QueryClient) (*big.Int, *big.Int, error) {
+	parseWei := func(flag string) (*big.Int, error) {
+		value, _ := cmd.Flags().GetString(flag)
+		if value == "" {
+			return nil, nil
+		}
+		wei, ok := new(big.Int).SetString(value, 0)
+		if !ok || wei.Sign() < 0 {
+			return nil, fmt.Errorf("invalid --%s %s", flag, value)
+		}
+		return wei, nil
+	}
+
+	gasFeeCap, err := parseWei(flagMaxFeePerGas)
+	if err != nil {
+		return nil, nil, err
+	}
+	gasTipCap, err := parseWei(flagMaxPriorityFeePerGas)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := queryClient.BaseFee(cmd.Context(), &types.QueryBaseFeeRequest{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if res.BaseFee == nil {
+		if gasTipCap != nil {
+			return nil, nil, fmt.Errorf("--%s specified but the base fee is disabled", flagMaxPriorityFeePerGas)
+		}
+		if gasFeeCap == nil {
+			gasFeeCap = new(big.Int)
+		}
+		return gasFeeCap, nil, nil
+	}
+	baseFee := res.BaseFee.BigInt()
+
+	if gasTipCap == nil {
+		params, err := queryClient.FeeMarket.Params(cmd.Context(), &feemarkettypes.QueryParamsRequest{})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// maximum change of the base fee in a block, as suggested by eth_maxPriorityFeePerGas
+		gasTipCap = new(big.Int).Mul(baseFee, big.NewInt(int64(params.Params.ElasticityMultiplier)-1))
+		gasTipCap.Quo(gasTipCap, big.NewInt(int64(params.Params.BaseFeeChangeDenominator)))
+		if gasTipCap.Sign() < 0 {
+			gasTipCap = new(big.Int)
+		}
+	}
+
+	if gasFeeCap == nil {
+		gasFeeCap = new(big.Int).Add(gasTipCap, new(big.Int).Mul(baseFee, big.NewInt(2)))
+	}
+
+	if gasFeeCap.Cmp(gasTipCap) < 0 {
+		return nil, nil, fmt.Errorf("max fee per gas %s lower than max priority fee per gas %s", gasFeeCap, gasTipCap)
+	}
+
+	return gasFeeCap, gasTipCap, nil
+}
+
+// signAndBroadcastTx signs the transaction with the eth_secp256k1 key of the sender and broadcasts it,
+// after the confirmation of the user.
+func signAndBroadcastTx(cmd *cobra.Command, clientCtx client.Context, msg *types.MsgEthereumTx) error {
+	record, err := clientCtx.Keyring.KeyByAddress(clientCtx.FromAddress)
+	if err != nil {
+		return err
+	}
+
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return err
+	}
+	if _, ok := pubKey.(*ethsecp256k1.PubKey); !ok {
+		return fmt.Errorf("key %s is not an %s key", record.Name, ethsecp256k1.KeyType)
+	}
+
+	// the transaction is confirmed before it is signed
+	if !clientCtx.GenerateOnly && !clientCtx.SkipConfirm {
+		out, err := msg.AsTransaction().MarshalJSON()
+		if err != nil {
+			return err
+		}
+
+		ok, err := confirmTx(fmt.Sprintf("from: %s\n%s", msg.From, out))
+		if err != nil || !ok {
+			return err
+		}
+		clientCtx = clientCtx.WithSkipConfirmation(true)
+	}
+
+	chainID := msg.AsTransaction().ChainId()
+	if err := msg.Sign(ethtypes.LatestSignerForChainID(chainID), clientCtx.Keyring); err != nil {
+		return err
+	}
+
+	rsp, err := rpctypes.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+
+	return buildAndBroadcastTx(clientCtx, msg, rsp.Params.EvmDenom)
+}
+
+// buildAndBroadcastTx wraps the signed Ethereum transaction in a Cosmos transaction and broadcasts
+// it, after the confirmation of the user.
+func buildAndBroadcastTx(clientCtx client.Context, msg *types.MsgEthereumTx, evmDenom string) error {
+	tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), evmDenom)
+	if err != nil {
+		return err
+	}
+
+	if clientCtx.GenerateOnly {
+		json, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+	}
+
+	if !clientCtx.SkipConfirm {
+		out, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+		if err != nil {
+			return err
+		}
+
+		ok, err := confirmTx(string(out))
+		if err != nil || !ok {
+			return err
+		}
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+	if err != nil {
+		return err
+	}
+
+	// broadcast to a Tendermint node
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// confirmTx prints the transaction and returns true if the user confirms it.
+func confirmTx(tx string) (bool, error) {
+	_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", tx)
+
+	buf := bufio.NewReader(os.Stdin)
+	ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", buf, os.Stderr)
+
+	if err != nil || !ok {
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n", "canceled transaction")
+		return false, err
+	}
+	return true, nil
+}
@@ -19,6 +19,7 @@ import (
 	"bytes"
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -83,9 +84,35 @@ func InitGenesis(
 		}
 	}
 
+	initFractionalBalances(ctx, k, data)
+
 	return []abci.ValidatorUpdate{}
 }
 
+// initFractionalBalances sets the genesis fractional balances and the remainder of the
+// reserve that backs them, which is held by the evm module account.
+func initFractionalBalances(ctx sdk.Context, k *keeper.Keeper, data types.GenesisState) {
+	total := sdkmath.ZeroInt()
+	for _, balance := range data.FractionalBalances {
+		k.SetFractionalBalance(ctx, common.HexToAddress(balance.Address), balance.Amount)
+		total = total.Add(balance.Amount)
+	}
+
+	conversionFactor := data.Params.ConversionFactor()
+	reserve := k.GetFractionalReserve(ctx, data.Params.EvmDenom)
+
+	// the fractional balances are backed by whole units of the evm denom
+	units := total.Add(conversionFactor).SubRaw(1).Quo(conversionFactor)
+	if reserve.LT(units) {
+		panic(fmt.Errorf(
+			"the evm module reserve %s%s doesn't back the fractional balances total %s",
+			reserve, data.Params.EvmDenom, total,
+		))
+	}
+
+	k.SetFractionalRemainder(ctx, units.Mul(conversionFactor).Sub(total))
+}
+
 // ExportGenesis exports genesis state of the EVM module
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper, ak types.AccountKeeper) *types.GenesisState {
 	var ethGenAccounts []types.GenesisAccount
@@ -110,8 +137,18 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper, ak types.AccountKeeper) *t
 		return false
 	})
 
+	var fractionalBalances []types.FractionalBalance
+	k.IterateFractionalBalances(ctx, func(addr common.Address, amount sdkmath.Int) bool {
+		fractionalBalances = append(fractionalBalances, types.FractionalBalance{
+			Address: addr.String(),
+			Amount:  amount,
+		})
+		return false
+	})
+
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:           ethGenAccounts,
+		Params:             k.GetParams(ctx),
+		FractionalBalances: fractionalBalances,
 	}
 }
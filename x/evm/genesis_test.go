@@ -5,6 +5,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 	etherminttypes "github.com/evmos/ethermint/types"
@@ -21,6 +23,9 @@ func (suite *EvmTestSuite) TestInitGenesis() {
 
 	var vmdb *statedb.StateDB
 
+	sixDecimalsParams := types.DefaultParams()
+	sixDecimalsParams.EvmDenomDecimals = 6
+
 	testCases := []struct {
 		name     string
 		malleate func()
@@ -136,6 +141,31 @@ func (suite *EvmTestSuite) TestInitGenesis() {
 			},
 			false,
 		},
+		{
+			"fractional balances backed by the reserve",
+			func() {
+				coins := sdk.NewCoins(sdk.NewCoin(types.DefaultEVMDenom, sdkmath.NewInt(1)))
+				suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+			},
+			&types.GenesisState{
+				Params: sixDecimalsParams,
+				FractionalBalances: []types.FractionalBalance{
+					{Address: address.String(), Amount: sdkmath.NewInt(600_000_000_000)},
+				},
+			},
+			false,
+		},
+		{
+			"fractional balances not backed by the reserve",
+			func() {},
+			&types.GenesisState{
+				Params: sixDecimalsParams,
+				FractionalBalances: []types.FractionalBalance{
+					{Address: address.String(), Amount: sdkmath.NewInt(600_000_000_000)},
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,130 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// GetConversionRate returns the amount of the given fee denomination worth one unit of
+// the EVM denom. The fixed conversion rate defined in the params is used when set,
+// otherwise the rate is fetched from the fee denom oracle.
+func (k Keeper) GetConversionRate(ctx sdk.Context, feeDenom types.FeeDenom) (sdk.Dec, error) {
+	if feeDenom.ConversionRate.IsPositive() {
+		return feeDenom.ConversionRate, nil
+	}
+
+	if k.feeDenomOracle == nil {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidFeeDenom, "no conversion rate nor oracle set for %s", feeDenom.Denom)
+	}
+
+	rate, err := k.feeDenomOracle.GetConversionRate(ctx, feeDenom.Denom)
+	if err != nil {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidFeeDenom, "failed to fetch %s conversion rate: %s", feeDenom.Denom, err)
+	}
+
+	if rate.IsNil() || !rate.IsPositive() {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidFeeDenom, "invalid %s conversion rate %s", feeDenom.Denom, rate)
+	}
+
+	return rate, nil
+}
+
+// ConvertFee converts the given EVM denom amount to the given fee denomination. The
+// result is rounded up when charging fees and truncated when refunding them, so that
+// the conversion never favours the sender.
+func (k Keeper) ConvertFee(ctx sdk.Context, feeDenom types.FeeDenom, amount sdkmath.Int, roundUp bool) (sdkmath.Int, error) {
+	rate, err := k.GetConversionRate(ctx, feeDenom)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	converted := sdk.NewDecFromInt(amount).Mul(rate)
+	if roundUp {
+		converted = converted.Ceil()
+	}
+
+	return converted.TruncateInt(), nil
+}
+
+// SelectTxFees returns the fees to deduct from the sender of an Ethereum transaction,
+// converted from 18 decimals to the bank module amounts. The fees are paid in the EVM
+// denom when the sender balance covers them along with the transaction value.
+// Otherwise, they are converted to the first whitelisted fee denomination that the
+// sender can afford. The EVM denom fees are returned when no fee denomination can be
+// used, so that the deduction fails as usual.
+func (k *Keeper) SelectTxFees(ctx sdk.Context, fees sdk.Coins, from common.Address, value *big.Int) (sdk.Coins, error) {
+	if fees.IsZero() {
+		return fees, nil
+	}
+
+	params := k.GetParams(ctx)
+
+	weiAmount := fees.AmountOf(params.EvmDenom)
+	amount := toBankAmount(weiAmount.BigInt(), params.ConversionFactor(), true)
+	evmFees := sdk.Coins{sdk.NewCoin(params.EvmDenom, amount)}
+
+	if len(params.FeeDenoms) == 0 {
+		return evmFees, nil
+	}
+
+	cost := weiAmount
+	if value != nil {
+		cost = cost.Add(sdkmath.NewIntFromBigInt(value))
+	}
+
+	if cost.BigInt().Cmp(k.GetBalance(ctx, from)) <= 0 {
+		return evmFees, nil
+	}
+
+	for _, feeDenom := range params.FeeDenoms {
+		converted, err := k.ConvertFee(ctx, feeDenom, amount, true)
+		if err != nil {
+			// skip the fee denoms without a price
+			k.Logger(ctx).Debug("fee denom not available", "denom", feeDenom.Denom, "error", err.Error())
+			continue
+		}
+
+		if k.bankKeeper.GetBalance(ctx, from.Bytes(), feeDenom.Denom).Amount.GTE(converted) {
+			return sdk.Coins{sdk.NewCoin(feeDenom.Denom, converted)}, nil
+		}
+	}
+
+	return evmFees, nil
+}
+
+// GetTxFeeDenomTransient returns the fee denomination used to pay for the given
+// transaction. It returns an empty string if the fees were paid in the EVM denom.
+func (k Keeper) GetTxFeeDenomTransient(ctx sdk.Context, txHash common.Hash) string {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeeDenom)
+	return string(store.Get(txHash.Bytes()))
+}
+
+// SetTxFeeDenomTransient sets the fee denomination used to pay for the given transaction,
+// so that the leftover gas is refunded in the same denomination.
+func (k Keeper) SetTxFeeDenomTransient(ctx sdk.Context, txHash common.Hash, denom string) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeeDenom)
+	store.Set(txHash.Bytes(), []byte(denom))
+}
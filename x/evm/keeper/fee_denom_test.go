@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) setFeeDenoms(feeDenoms ...types.FeeDenom) {
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.FeeDenoms = feeDenoms
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+}
+
+func (suite *KeeperTestSuite) TestConvertFee() {
+	suite.SetupTest()
+
+	feeDenom := types.FeeDenom{Denom: "uusdc", ConversionRate: sdk.NewDecWithPrec(5, 1)}
+
+	amount, err := suite.app.EvmKeeper.ConvertFee(suite.ctx, feeDenom, sdkmath.NewInt(3), true)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(2), amount)
+
+	amount, err = suite.app.EvmKeeper.ConvertFee(suite.ctx, feeDenom, sdkmath.NewInt(3), false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(1), amount)
+
+	// no fixed rate and no oracle
+	_, err = suite.app.EvmKeeper.ConvertFee(suite.ctx, types.FeeDenom{Denom: "uatom", ConversionRate: sdk.ZeroDec()}, sdkmath.NewInt(3), true)
+	suite.Require().ErrorIs(err, types.ErrInvalidFeeDenom)
+}
+
+func (suite *KeeperTestSuite) TestSelectTxFees() {
+	var from common.Address
+
+	denom := types.DefaultEVMDenom
+	fees := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewInt(1000))}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expFees  sdk.Coins
+	}{
+		{
+			"no fee denoms",
+			func() {},
+			fees,
+		},
+		{
+			"enough evm denom balance",
+			func() {
+				suite.setFeeDenoms(types.FeeDenom{Denom: "uusdc", ConversionRate: sdk.NewDec(2)})
+				suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, from.Bytes(), fees))
+			},
+			fees,
+		},
+		{
+			"evm denom balance doesn't cover the value",
+			func() {
+				suite.setFeeDenoms(types.FeeDenom{Denom: "uusdc", ConversionRate: sdk.NewDec(2)})
+				suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, from.Bytes(), sdk.NewCoins(
+					sdk.NewCoin(denom, sdkmath.NewInt(1050)),
+					sdk.NewCoin("uusdc", sdkmath.NewInt(2000)),
+				)))
+			},
+			sdk.Coins{sdk.NewCoin("uusdc", sdkmath.NewInt(2000))},
+		},
+		{
+			"pays with the first affordable fee denom",
+			func() {
+				suite.setFeeDenoms(
+					types.FeeDenom{Denom: "uatom", ConversionRate: sdk.ZeroDec()},
+					types.FeeDenom{Denom: "ueur", ConversionRate: sdk.NewDec(3)},
+					types.FeeDenom{Denom: "uusdc", ConversionRate: sdk.NewDec(2)},
+				)
+				suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, from.Bytes(), sdk.NewCoins(
+					sdk.NewCoin("uatom", sdkmath.NewInt(10000)),
+					sdk.NewCoin("ueur", sdkmath.NewInt(2999)),
+					sdk.NewCoin("uusdc", sdkmath.NewInt(2000)),
+				)))
+			},
+			sdk.Coins{sdk.NewCoin("uusdc", sdkmath.NewInt(2000))},
+		},
+		{
+			"insufficient fee denom balance",
+			func() {
+				suite.setFeeDenoms(types.FeeDenom{Denom: "uusdc", ConversionRate: sdk.NewDec(2)})
+				suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, from.Bytes(), sdk.NewCoins(
+					sdk.NewCoin("uusdc", sdkmath.NewInt(1999)),
+				)))
+			},
+			fees,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			from = tests.GenerateAddress()
+			tc.malleate()
+
+			res, err := suite.app.EvmKeeper.SelectTxFees(suite.ctx, fees, from, big.NewInt(100))
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expFees, res)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundGasFeeDenom() {
+	suite.SetupTest()
+	suite.setFeeDenoms(types.FeeDenom{Denom: "uusdc", ConversionRate: sdk.NewDecWithPrec(15, 1)})
+
+	coins := sdk.NewCoins(sdk.NewCoin("uusdc", sdkmath.NewInt(1000)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, types.ModuleName, authtypes.FeeCollectorName, coins))
+
+	from := tests.GenerateAddress()
+	m := ethtypes.NewMessage(from, nil, 0, big.NewInt(0), 100, big.NewInt(3), big.NewInt(3), big.NewInt(3), nil, nil, false)
+
+	// 7 * 3 * 1.5 = 31.5, truncated on refund
+	suite.Require().NoError(suite.app.EvmKeeper.RefundGas(suite.ctx, m, 7, "uusdc"))
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, from.Bytes(), "uusdc")
+	suite.Require().Equal(sdkmath.NewInt(31), balance.Amount)
+}
+
+func (suite *KeeperTestSuite) TestTxFeeDenomTransient() {
+	suite.SetupTest()
+
+	txHash := common.BytesToHash([]byte("tx"))
+	suite.Require().Empty(suite.app.EvmKeeper.GetTxFeeDenomTransient(suite.ctx, txHash))
+
+	suite.app.EvmKeeper.SetTxFeeDenomTransient(suite.ctx, txHash, "uusdc")
+	suite.Require().Equal("uusdc", suite.app.EvmKeeper.GetTxFeeDenomTransient(suite.ctx, txHash))
+}
@@ -18,16 +18,18 @@ package keeper
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/params"
 
 	errorsmod "cosmossdk.io/errors"
-	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/evmos/ethermint/x/evm/types"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 )
 
 // GetEthIntrinsicGas returns the intrinsic gas cost for the transaction
@@ -42,7 +44,7 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 // RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
 // consumed in the transaction. Additionally, the function sets the total gas consumed to the value
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
-// AnteHandler.
+// AnteHandler. The leftover gas is converted when the fees were paid with a whitelisted fee denom.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice())
@@ -53,7 +55,22 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		return errorsmod.Wrapf(types.ErrInvalidRefund, "refunded amount value cannot be negative %d", remaining.Int64())
 	case 1:
 		// positive amount refund
-		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
+		params := k.GetParams(ctx)
+		amount := toBankAmount(remaining, params.ConversionFactor(), false)
+		if feeDenom, found := params.GetFeeDenom(denom); found {
+			converted, err := k.ConvertFee(ctx, feeDenom, amount, false)
+			if err != nil {
+				return errorsmod.Wrapf(err, "failed to convert %d leftover gas to %s", leftoverGas, denom)
+			}
+			amount = converted
+		}
+
+		if amount.IsZero() {
+			// the leftover is lower than the smallest bank module unit
+			return nil
+		}
+
+		refundedCoins := sdk.Coins{sdk.NewCoin(denom, amount)}
 
 		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
 
@@ -69,6 +86,69 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	return nil
 }
 
+// DistributeFees splits the fees paid for the gas used by the message, which are held by the fee
+// collector module account after the gas refund, into its base fee and priority tip portions. The
+// base fee is sent to the destination defined in the x/feemarket parameters and the tip is credited
+// to the block proposer when enabled. The fees are left untouched in the fee collector by default.
+func (k *Keeper) DistributeFees(
+	ctx sdk.Context,
+	msg core.Message,
+	gasUsed uint64,
+	baseFee *big.Int,
+	proposer common.Address,
+	denom string,
+) error {
+	params := k.feeMarketKeeper.GetParams(ctx)
+	if !params.DistributesFees() {
+		return nil
+	}
+
+	gas := new(big.Int).SetUint64(gasUsed)
+	total := new(big.Int).Mul(gas, msg.GasPrice())
+
+	base := new(big.Int)
+	if baseFee != nil {
+		base = base.Mul(gas, math.BigMin(baseFee, msg.GasPrice()))
+	}
+	tip := new(big.Int).Sub(total, base)
+
+	// convert the 18 decimals amounts to the bank module ones
+	conversionFactor := k.GetParams(ctx).ConversionFactor()
+	baseAmount := toBankAmount(base, conversionFactor, false)
+	tipAmount := toBankAmount(tip, conversionFactor, false)
+
+	if baseAmount.IsPositive() {
+		baseCoins := sdk.Coins{sdk.NewCoin(denom, baseAmount)}
+
+		switch params.BaseFeeDestination {
+		case feemarkettypes.BaseFeeDestinationBurn:
+			// the fee collector has no burner permission, so the base fee is burned from the evm module account
+			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, baseCoins); err != nil {
+				return errorsmod.Wrapf(err, "failed to send base fee (%s) to the evm module", baseCoins)
+			}
+			if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, baseCoins); err != nil {
+				return errorsmod.Wrapf(err, "failed to burn base fee (%s)", baseCoins)
+			}
+		case feemarkettypes.BaseFeeDestinationCommunityPool:
+			feeCollector := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			if err := k.distrKeeper.FundCommunityPool(ctx, baseCoins, feeCollector); err != nil {
+				return errorsmod.Wrapf(err, "failed to fund community pool with base fee (%s)", baseCoins)
+			}
+		default:
+			// the base fee is left in the fee collector
+		}
+	}
+
+	if params.TipToProposer && tipAmount.IsPositive() && proposer != (common.Address{}) {
+		tipCoins := sdk.Coins{sdk.NewCoin(denom, tipAmount)}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, proposer.Bytes(), tipCoins); err != nil {
+			return errorsmod.Wrapf(err, "failed to send priority tip (%s) to block proposer %s", tipCoins, proposer)
+		}
+	}
+
+	return nil
+}
+
 // ResetGasMeterAndConsumeGas reset first the gas meter consumed value to zero and set it back to the new value
 // 'gasUsed'
 func (k *Keeper) ResetGasMeterAndConsumeGas(ctx sdk.Context, gasUsed uint64) {
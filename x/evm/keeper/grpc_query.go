@@ -42,10 +42,18 @@ import (
 	ethermint "github.com/evmos/ethermint/types"
 	"github.com/evmos/ethermint/x/evm/statedb"
 	"github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/evmos/ethermint/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var _ types.QueryServer = Keeper{}
 
+// spanTracer records the spans of the EVM queries and executions, continuing the traces of the
+// JSON-RPC requests.
+var spanTracer = otel.Tracer("github.com/evmos/ethermint/x/evm/keeper")
+
 const (
 	defaultTraceTimeout = 5 * time.Second
 )
@@ -152,8 +160,14 @@ func (k Keeper) Balance(c context.Context, req *types.QueryBalanceRequest) (*typ
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
+	address := common.HexToAddress(req.Address)
 
-	balanceInt := k.GetBalance(ctx, common.HexToAddress(req.Address))
+	// load the account when the state comes from another source
+	if k.stateKeeper != nil {
+		k.stateKeeper.GetAccount(ctx, address)
+	}
+
+	balanceInt := k.GetBalance(ctx, address)
 
 	return &types.QueryBalanceResponse{
 		Balance: balanceInt.String(),
@@ -178,7 +192,7 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 	address := common.HexToAddress(req.Address)
 	key := common.HexToHash(req.Key)
 
-	state := k.GetState(ctx, address, key)
+	state := k.StateKeeper().GetState(ctx, address, key)
 	stateHex := state.Hex()
 
 	return &types.QueryStorageResponse{
@@ -202,11 +216,12 @@ func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.Que
 	ctx := sdk.UnwrapSDKContext(c)
 
 	address := common.HexToAddress(req.Address)
-	acct := k.GetAccountWithoutBalance(ctx, address)
+	stateKeeper := k.StateKeeper()
+	acct := stateKeeper.GetAccount(ctx, address)
 
 	var code []byte
 	if acct != nil && acct.IsContract() {
-		code = k.GetCode(ctx, common.BytesToHash(acct.CodeHash))
+		code = stateKeeper.GetCode(ctx, common.BytesToHash(acct.CodeHash))
 	}
 
 	return &types.QueryCodeResponse{
@@ -230,7 +245,8 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := startQuerySpan(c, "evm.Query/EthCall")
+	defer span.End()
 
 	var args types.TransactionArgs
 	err := json.Unmarshal(req.Args, &args)
@@ -272,7 +288,9 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := startQuerySpan(c, "evm.Query/EstimateGas")
+	defer span.End()
+
 	chainID, err := getChainID(ctx, req.ChainId)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -409,7 +427,9 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		contextHeight = 1
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := startQuerySpan(c, "evm.Query/TraceTx")
+	defer span.End()
+
 	ctx = ctx.WithBlockHeight(contextHeight)
 	ctx = ctx.WithBlockTime(req.BlockTime)
 	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
@@ -421,7 +441,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
 	}
-	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	signer := k.Signer(ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight())))
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
 	for i, tx := range req.Predecessors {
@@ -486,7 +506,9 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		contextHeight = 1
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := startQuerySpan(c, "evm.Query/TraceBlock")
+	defer span.End()
+
 	ctx = ctx.WithBlockHeight(contextHeight)
 	ctx = ctx.WithBlockTime(req.BlockTime)
 	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
@@ -499,7 +521,7 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
-	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	signer := k.Signer(ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight())))
 	txsLength := len(req.Txs)
 	results := make([]*types.TxTraceResult, 0, txsLength)
 
@@ -640,3 +662,10 @@ func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 	}
 	return big.NewInt(chainID), nil
 }
+
+// startQuerySpan continues the trace of the incoming gRPC query, if any, and returns the SDK context
+// carrying the span of the query, so that the EVM executions are recorded as its children.
+func startQuerySpan(c context.Context, name string) (sdk.Context, trace.Span) {
+	spanCtx, span := tracing.StartSpan(tracing.ExtractGRPC(c), spanTracer, name, trace.WithSpanKind(trace.SpanKindServer))
+	return sdk.UnwrapSDKContext(c).WithContext(spanCtx), span
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// RegisterInvariants registers the evm module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "fractional-balances", FractionalBalancesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "fractional-reserve", FractionalReserveInvariant(k))
+}
+
+// FractionalBalancesInvariant checks that all the fractional balances are positive and
+// lower than the conversion factor, i.e that they cannot be held by the bank module.
+func FractionalBalancesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		conversionFactor := k.GetParams(ctx).ConversionFactor()
+		k.IterateFractionalBalances(ctx, func(addr common.Address, amount sdkmath.Int) bool {
+			if !amount.IsPositive() || amount.GTE(conversionFactor) {
+				broken++
+				msg += fmt.Sprintf("\t%s has an invalid fractional balance %s\n", addr, amount)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "fractional-balances",
+			fmt.Sprintf("%d invalid fractional balances found\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// FractionalReserveInvariant checks that the evm denom held by the evm module account
+// backs the fractional balances and the remainder, so that the total supply presented
+// to the EVM is covered by the bank module one. The reserve can hold more than the
+// tracked amount, as the evm module account can receive funds from EVM transfers.
+func FractionalReserveInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params := k.GetParams(ctx)
+		conversionFactor := params.ConversionFactor()
+
+		total := sdkmath.ZeroInt()
+		k.IterateFractionalBalances(ctx, func(_ common.Address, amount sdkmath.Int) bool {
+			total = total.Add(amount)
+			return false
+		})
+
+		remainder := k.GetFractionalRemainder(ctx)
+		reserve := k.GetFractionalReserve(ctx, params.EvmDenom)
+
+		backed := total.Add(remainder)
+		broken := remainder.IsNegative() || remainder.GTE(conversionFactor) ||
+			!backed.Mod(conversionFactor).IsZero() || reserve.Mul(conversionFactor).LT(backed)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "fractional-reserve",
+			fmt.Sprintf(
+				"\treserve: %s%s\n\tfractional balances: %s\n\tremainder: %s\n\tconversion factor: %s\n",
+				reserve, params.EvmDenom, total, remainder, conversionFactor,
+			),
+		), broken
+	}
+}
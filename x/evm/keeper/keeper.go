@@ -59,6 +59,8 @@ type Keeper struct {
 	bankKeeper types.BankKeeper
 	// access historical headers for EVM state transition execution
 	stakingKeeper types.StakingKeeper
+	// fund the community pool with the base fees, when enabled on x/feemarket
+	distrKeeper types.DistributionKeeper
 	// fetch EIP1559 base fee and parameters
 	feeMarketKeeper types.FeeMarketKeeper
 
@@ -71,11 +73,19 @@ type Keeper struct {
 	// EVM Hooks for tx post-processing
 	hooks types.EvmHooks
 
+	// price source for the fee denominations without a fixed conversion rate
+	feeDenomOracle types.FeeDenomOracle
+
 	// custom stateless precompiled smart contracts
 	customPrecompiles evm.PrecompiledContracts
 
 	// evm constructor function
 	evmConstructor evm.Constructor
+
+	// storage of the StateDB, the keeper itself when nil
+	stateKeeper statedb.Keeper
+	// wraps the signers of the transactions, they are used as is when nil
+	signerWrapper func(ethtypes.Signer) ethtypes.Signer
 	// Legacy subspace
 	ss paramstypes.Subspace
 }
@@ -88,6 +98,7 @@ func NewKeeper(
 	ak types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	sk types.StakingKeeper,
+	dk types.DistributionKeeper,
 	fmk types.FeeMarketKeeper,
 	customPrecompiles evm.PrecompiledContracts,
 	evmConstructor evm.Constructor,
@@ -111,6 +122,7 @@ func NewKeeper(
 		accountKeeper:     ak,
 		bankKeeper:        bankKeeper,
 		stakingKeeper:     sk,
+		distrKeeper:       dk,
 		feeMarketKeeper:   fmk,
 		storeKey:          storeKey,
 		transientKey:      transientKey,
@@ -259,6 +271,61 @@ func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
 	return k
 }
 
+// SetFeeDenomOracle sets the price source used to convert the fees paid with the fee
+// denominations that have no fixed conversion rate.
+// It should be called only once during initialization, it panic if called more than once.
+func (k *Keeper) SetFeeDenomOracle(oracle types.FeeDenomOracle) *Keeper {
+	if k.feeDenomOracle != nil {
+		panic("cannot set fee denom oracle twice")
+	}
+
+	k.feeDenomOracle = oracle
+	return k
+}
+
+// SetStateKeeper replaces the storage of the StateDB, which the ante handlers and the state queries
+// also read, so that the state can be loaded from another source, like a remote chain forked by the
+// dev node. The storage should write to the keeper.
+// It should be called only once during initialization, it panic if called more than once.
+func (k *Keeper) SetStateKeeper(sk statedb.Keeper) *Keeper {
+	if k.stateKeeper != nil {
+		panic("cannot set state keeper twice")
+	}
+
+	k.stateKeeper = sk
+	return k
+}
+
+// StateKeeper returns the storage of the StateDB, the keeper itself unless it is replaced.
+func (k *Keeper) StateKeeper() statedb.Keeper {
+	if k.stateKeeper == nil {
+		return k
+	}
+	return k.stateKeeper
+}
+
+// SetSignerWrapper wraps the signers recovering the senders of the transactions, in the ante
+// handlers and the state transitions, so that the dev node can recover the senders of the
+// transactions of the impersonated accounts, which aren't signed.
+// It should be called only once during initialization, it panic if called more than once.
+func (k *Keeper) SetSignerWrapper(wrap func(ethtypes.Signer) ethtypes.Signer) *Keeper {
+	if k.signerWrapper != nil {
+		panic("cannot set signer wrapper twice")
+	}
+
+	k.signerWrapper = wrap
+	return k
+}
+
+// Signer returns the signer recovering the senders of the transactions, the given signer unless
+// it is wrapped.
+func (k *Keeper) Signer(signer ethtypes.Signer) ethtypes.Signer {
+	if k.signerWrapper == nil {
+		return signer
+	}
+	return k.signerWrapper(signer)
+}
+
 // PostTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
 func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	if k.hooks == nil {
@@ -293,9 +360,10 @@ func (k *Keeper) GetAccountWithoutBalance(ctx sdk.Context, addr common.Address)
 	}
 }
 
-// GetAccountOrEmpty returns empty account if not exist, returns error if it's not `EthAccount`
+// GetAccountOrEmpty returns empty account if not exist, returns error if it's not `EthAccount`.
+// The account is read from the storage of the StateDB.
 func (k *Keeper) GetAccountOrEmpty(ctx sdk.Context, addr common.Address) statedb.Account {
-	acct := k.GetAccount(ctx, addr)
+	acct := k.StateKeeper().GetAccount(ctx, addr)
 	if acct != nil {
 		return *acct
 	}
@@ -318,7 +386,7 @@ func (k *Keeper) GetNonce(ctx sdk.Context, addr common.Address) uint64 {
 	return acct.GetSequence()
 }
 
-// GetBalance load account's balance of gas token
+// GetBalance load account's balance of gas token, with 18 decimals
 func (k *Keeper) GetBalance(ctx sdk.Context, addr common.Address) *big.Int {
 	cosmosAddr := sdk.AccAddress(addr.Bytes())
 	evmParams := k.GetParams(ctx)
@@ -328,7 +396,13 @@ func (k *Keeper) GetBalance(ctx sdk.Context, addr common.Address) *big.Int {
 		return big.NewInt(-1)
 	}
 	coin := k.bankKeeper.GetBalance(ctx, cosmosAddr, evmDenom)
-	return coin.Amount.BigInt()
+	if evmParams.EvmDenomDecimals == types.DefaultEVMDenomDecimals {
+		return coin.Amount.BigInt()
+	}
+
+	// present the balance with 18 decimals to the EVM
+	balance := coin.Amount.Mul(evmParams.ConversionFactor())
+	return balance.Add(k.GetFractionalBalance(ctx, addr)).BigInt()
 }
 
 // GetBaseFee returns current base fee, return values:
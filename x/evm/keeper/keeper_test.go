@@ -534,3 +534,18 @@ func (suite *KeeperTestSuite) TestGetAccountOrEmpty() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSignerWrapper() {
+	signer := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+
+	// the signers are used as is unless they are wrapped
+	suite.Require().Equal(signer, suite.app.EvmKeeper.Signer(signer))
+
+	wrap := func(ethtypes.Signer) ethtypes.Signer { return ethtypes.HomesteadSigner{} }
+	suite.app.EvmKeeper.SetSignerWrapper(wrap)
+	suite.Require().Equal(ethtypes.HomesteadSigner{}, suite.app.EvmKeeper.Signer(signer))
+
+	suite.Require().Panics(func() {
+		suite.app.EvmKeeper.SetSignerWrapper(wrap)
+	})
+}
@@ -19,6 +19,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v4 "github.com/evmos/ethermint/x/evm/migrations/v4"
 	v5 "github.com/evmos/ethermint/x/evm/migrations/v5"
+	v6 "github.com/evmos/ethermint/x/evm/migrations/v6"
 	"github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -45,3 +46,8 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate5to6 migrates the store from consensus version 5 to 6
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/evmos/ethermint/x/evm/types"
 )
@@ -153,6 +154,12 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the fractional balances are tracked according to the evm denom decimals
+	if decimals := k.GetParams(ctx).EvmDenomDecimals; req.Params.EvmDenomDecimals != decimals {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "evm denom decimals cannot be changed from %d to %d", decimals, req.Params.EvmDenomDecimals)
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
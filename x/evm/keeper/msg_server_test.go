@@ -101,6 +101,18 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 			},
 			expectErr: false,
 		},
+		{
+			name: "fail - evm denom decimals changed",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.EvmDenomDecimals = 6
+					return params
+				}(),
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,177 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// The EVM always operates on balances with 18 decimals. When the evm denom has less
+// decimals on the bank module, the balance of an account is split into the integer
+// part, held by the bank module, and the fractional part, tracked by the evm module.
+//
+// The fractional balances are backed by the evm denom held by the evm module account
+// (i.e the reserve), such that:
+//
+//	reserve * conversion factor >= sum(fractional balances) + remainder
+//
+// where the remainder is lower than the conversion factor and the right-hand side is a
+// multiple of the conversion factor.
+
+// GetFractionalBalance returns the fractional part of the account balance that cannot
+// be held by the bank module.
+func (k Keeper) GetFractionalBalance(ctx sdk.Context, addr common.Address) sdkmath.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFractionalBalance)
+	bz := store.Get(addr.Bytes())
+	if len(bz) == 0 {
+		return sdkmath.ZeroInt()
+	}
+
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// SetFractionalBalance sets the fractional part of the account balance. It doesn't
+// update the reserve backing the fractional balances.
+func (k Keeper) SetFractionalBalance(ctx sdk.Context, addr common.Address, amount sdkmath.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFractionalBalance)
+	if amount.IsZero() {
+		store.Delete(addr.Bytes())
+		return
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(addr.Bytes(), bz)
+}
+
+// IterateFractionalBalances iterates over all the fractional balances, callback
+// returns true to stop the iteration.
+func (k Keeper) IterateFractionalBalances(ctx sdk.Context, cb func(addr common.Address, amount sdkmath.Int) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixFractionalBalance)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		addr := common.BytesToAddress(iterator.Key()[len(types.KeyPrefixFractionalBalance):])
+		if cb(addr, amount) {
+			break
+		}
+	}
+}
+
+// GetFractionalRemainder returns the amount held by the reserve that doesn't back
+// any fractional balance.
+func (k Keeper) GetFractionalRemainder(ctx sdk.Context) sdkmath.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefixFractionalRemainder)
+	if len(bz) == 0 {
+		return sdkmath.ZeroInt()
+	}
+
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// SetFractionalRemainder sets the amount held by the reserve that doesn't back any
+// fractional balance.
+func (k Keeper) SetFractionalRemainder(ctx sdk.Context, amount sdkmath.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if amount.IsZero() {
+		store.Delete(types.KeyPrefixFractionalRemainder)
+		return
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.KeyPrefixFractionalRemainder, bz)
+}
+
+// GetFractionalReserve returns the evm denom amount held by the evm module account,
+// which backs the fractional balances.
+func (k Keeper) GetFractionalReserve(ctx sdk.Context, evmDenom string) sdkmath.Int {
+	reserve := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	return k.bankKeeper.GetBalance(ctx, reserve, evmDenom).Amount
+}
+
+// setFractionalBalance updates the fractional part of the account balance, minting
+// or burning the evm denom held by the reserve so that it keeps backing all the
+// fractional balances.
+func (k *Keeper) setFractionalBalance(ctx sdk.Context, addr common.Address, amount sdkmath.Int, params types.Params) error {
+	delta := amount.Sub(k.GetFractionalBalance(ctx, addr))
+	if delta.IsZero() {
+		return nil
+	}
+
+	k.SetFractionalBalance(ctx, addr, amount)
+
+	conversionFactor := params.ConversionFactor()
+	remainder := k.GetFractionalRemainder(ctx).Sub(delta)
+
+	switch {
+	case remainder.IsNegative():
+		// mint the units missing to back the fractional balances
+		units := remainder.Neg().Add(conversionFactor).SubRaw(1).Quo(conversionFactor)
+		coins := sdk.NewCoins(sdk.NewCoin(params.EvmDenom, units))
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return errorsmod.Wrapf(err, "failed to mint %s to the fractional balances reserve", coins)
+		}
+		remainder = remainder.Add(units.Mul(conversionFactor))
+	case remainder.GTE(conversionFactor):
+		// burn the units that no longer back any fractional balance
+		units := remainder.Quo(conversionFactor)
+		coins := sdk.NewCoins(sdk.NewCoin(params.EvmDenom, units))
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return errorsmod.Wrapf(err, "failed to burn %s from the fractional balances reserve", coins)
+		}
+		remainder = remainder.Sub(units.Mul(conversionFactor))
+	}
+
+	k.SetFractionalRemainder(ctx, remainder)
+	return nil
+}
+
+// toBankAmount converts an 18 decimals EVM amount to the evm denom amount held by the
+// bank module, rounding it up or truncating it.
+func toBankAmount(amount *big.Int, conversionFactor sdkmath.Int, roundUp bool) sdkmath.Int {
+	quo, rem := new(big.Int).QuoRem(amount, conversionFactor.BigInt(), new(big.Int))
+	if roundUp && rem.Sign() > 0 {
+		quo.Add(quo, big.NewInt(1))
+	}
+	return sdkmath.NewIntFromBigInt(quo)
+}
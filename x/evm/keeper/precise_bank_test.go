@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/keeper"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) setEVMDenomDecimals(decimals uint32) types.Params {
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.EvmDenomDecimals = decimals
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+	return params
+}
+
+func (suite *KeeperTestSuite) requireFractionalInvariants() {
+	_, broken := keeper.FractionalBalancesInvariant(suite.app.EvmKeeper)(suite.ctx)
+	suite.Require().False(broken)
+	_, broken = keeper.FractionalReserveInvariant(suite.app.EvmKeeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestSetBalanceFractional() {
+	suite.SetupTest()
+	params := suite.setEVMDenomDecimals(6)
+	factor := params.ConversionFactor()
+
+	alice := tests.GenerateAddress()
+	bob := tests.GenerateAddress()
+
+	prevSupply := suite.app.BankKeeper.GetSupply(suite.ctx, params.EvmDenom).Amount
+
+	// 2.5 units and 1 wei
+	amount := big.NewInt(2_500_000_000_001)
+	suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, alice, amount))
+	suite.Require().Equal(amount, suite.app.EvmKeeper.GetBalance(suite.ctx, alice))
+	suite.Require().Equal(sdkmath.NewInt(2), suite.app.BankKeeper.GetBalance(suite.ctx, alice.Bytes(), params.EvmDenom).Amount)
+	suite.Require().Equal(sdkmath.NewInt(500_000_000_001), suite.app.EvmKeeper.GetFractionalBalance(suite.ctx, alice))
+	suite.requireFractionalInvariants()
+
+	// transfer 0.7 units from alice to bob
+	transfer := big.NewInt(700_000_000_000)
+	suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, alice, new(big.Int).Sub(amount, transfer)))
+	suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, bob, transfer))
+	suite.requireFractionalInvariants()
+
+	suite.Require().Equal(big.NewInt(1_800_000_000_001), suite.app.EvmKeeper.GetBalance(suite.ctx, alice))
+	suite.Require().Equal(transfer, suite.app.EvmKeeper.GetBalance(suite.ctx, bob))
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, bob.Bytes(), params.EvmDenom).IsZero())
+
+	// the total supply presented to the EVM matches the bank module one
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, params.EvmDenom).Amount.Sub(prevSupply)
+	total := new(big.Int).Add(suite.app.EvmKeeper.GetBalance(suite.ctx, alice), suite.app.EvmKeeper.GetBalance(suite.ctx, bob))
+	remainder := suite.app.EvmKeeper.GetFractionalRemainder(suite.ctx)
+	suite.Require().Equal(supply.Mul(factor), sdkmath.NewIntFromBigInt(total).Add(remainder))
+
+	// clearing the balances burns the reserve
+	suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, alice, big.NewInt(0)))
+	suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, bob, big.NewInt(0)))
+	suite.requireFractionalInvariants()
+	suite.Require().True(suite.app.EvmKeeper.GetFractionalRemainder(suite.ctx).IsZero())
+	suite.Require().True(suite.app.EvmKeeper.GetFractionalReserve(suite.ctx, params.EvmDenom).IsZero())
+	suite.Require().Equal(prevSupply, suite.app.BankKeeper.GetSupply(suite.ctx, params.EvmDenom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestFractionalReserveInvariant() {
+	suite.SetupTest()
+	suite.setEVMDenomDecimals(6)
+
+	addr := tests.GenerateAddress()
+	suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, addr, big.NewInt(1)))
+	suite.requireFractionalInvariants()
+
+	// fractional balance not backed by the reserve
+	suite.app.EvmKeeper.SetFractionalBalance(suite.ctx, addr, sdkmath.NewInt(2_000_000_000_000))
+	_, broken := keeper.FractionalBalancesInvariant(suite.app.EvmKeeper)(suite.ctx)
+	suite.Require().True(broken)
+	_, broken = keeper.FractionalReserveInvariant(suite.app.EvmKeeper)(suite.ctx)
+	suite.Require().True(broken)
+}
+
+func (suite *KeeperTestSuite) TestSelectTxFeesFractional() {
+	suite.SetupTest()
+	params := suite.setEVMDenomDecimals(6)
+
+	fees := sdk.Coins{sdk.NewCoin(params.EvmDenom, sdkmath.NewInt(1_500_000_000_000))}
+	res, err := suite.app.EvmKeeper.SelectTxFees(suite.ctx, fees, tests.GenerateAddress(), big.NewInt(0))
+	suite.Require().NoError(err)
+
+	// fees are rounded up to the bank module unit
+	suite.Require().Equal(sdk.Coins{sdk.NewCoin(params.EvmDenom, sdkmath.NewInt(2))}, res)
+}
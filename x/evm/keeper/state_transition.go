@@ -34,6 +34,10 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/evmos/ethermint/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
 // NewEVM generates a go-ethereum VM from the provided Message fields and the chain parameters
@@ -160,7 +164,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 	txConfig := k.TxConfig(ctx, ethTx.Hash())
 
 	// get the signer according to the chain rules from the config and block height
-	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	signer := k.Signer(ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight())))
 	msg, err := msgEth.AsMessage(signer, cfg.BaseFee)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
@@ -239,11 +243,28 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 		}
 	}
 
+	// the fees may have been paid with one of the whitelisted fee denoms
+	feeDenom := cfg.Params.EvmDenom
+	if denom := k.GetTxFeeDenomTransient(ctx, ethTx.Hash()); denom != "" {
+		feeDenom = denom
+	}
+
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
-	if err = k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, cfg.Params.EvmDenom); err != nil {
+	if err = k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, feeDenom); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
 	}
 
+	// distribute the base fee and priority tip of the gas used, if enabled on x/feemarket. Fees paid
+	// with other denoms are left in the fee collector.
+	if feeDenom == cfg.Params.EvmDenom {
+		if err = k.DistributeFees(ctx, msg, res.GasUsed, cfg.BaseFee, cfg.CoinBase, feeDenom); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to distribute transaction fees")
+		}
+	}
+
+	// track the effective gas tip paid by the transaction for the fee history
+	k.feeMarketKeeper.AddTransientTxReward(ctx, ethTx.EffectiveGasTipValue(cfg.BaseFee), res.GasUsed)
+
 	if len(receipt.Logs) > 0 {
 		// Update transient block bloom filter
 		k.SetBlockBloomTransient(ctx, receipt.Bloom.Big())
@@ -323,6 +344,10 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		vmErr error  // vm errors do not effect consensus and are therefore not assigned to err
 	)
 
+	spanCtx, span := tracing.StartSpan(ctx.Context(), spanTracer, "ApplyMessageWithConfig")
+	defer span.End()
+	ctx = ctx.WithContext(spanCtx)
+
 	// return error if contract creation or call are disabled through governance
 	if !cfg.Params.EnableCreate && msg.To() == nil {
 		return nil, errorsmod.Wrap(types.ErrCreateDisabled, "failed to create new contract")
@@ -330,7 +355,7 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
 
-	stateDB := statedb.New(ctx, k, txConfig)
+	stateDB := statedb.New(ctx, k.StateKeeper(), txConfig)
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
 	leftoverGas := msg.Gas()
@@ -421,6 +446,14 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 	// reset leftoverGas, to be used by the tracer
 	leftoverGas = msg.Gas() - gasUsed
 
+	span.SetAttributes(
+		attribute.Int64("evm.gas_used", int64(gasUsed)),
+		attribute.Bool("evm.contract_creation", contractCreation),
+	)
+	if vmErr != nil {
+		span.SetStatus(codes.Error, vmErr.Error())
+	}
+
 	return &types.MsgEthereumTxResponse{
 		GasUsed: gasUsed,
 		VmError: vmError,
@@ -7,6 +7,7 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -16,6 +17,7 @@ import (
 	"github.com/evmos/ethermint/x/evm/keeper"
 	"github.com/evmos/ethermint/x/evm/statedb"
 	"github.com/evmos/ethermint/x/evm/types"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -456,6 +458,104 @@ func (suite *KeeperTestSuite) TestRefundGas() {
 	suite.mintFeeCollector = false
 }
 
+func (suite *KeeperTestSuite) TestDistributeFees() {
+	var (
+		baseFee         *big.Int
+		feemarketParams feemarkettypes.Params
+	)
+
+	proposer := tests.GenerateAddress()
+	gasUsed := uint64(1000)
+	gasPrice := big.NewInt(10)
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expBurned    int64
+		expCommunity int64
+		expTip       int64
+	}{
+		{
+			"default params, fees left in the fee collector",
+			func() {},
+			0, 0, 0,
+		},
+		{
+			"burn base fee",
+			func() {
+				feemarketParams.BaseFeeDestination = feemarkettypes.BaseFeeDestinationBurn
+			},
+			6000, 0, 0,
+		},
+		{
+			"base fee to community pool",
+			func() {
+				feemarketParams.BaseFeeDestination = feemarkettypes.BaseFeeDestinationCommunityPool
+			},
+			0, 6000, 0,
+		},
+		{
+			"burn base fee and tip to proposer",
+			func() {
+				feemarketParams.BaseFeeDestination = feemarkettypes.BaseFeeDestinationBurn
+				feemarketParams.TipToProposer = true
+			},
+			6000, 0, 4000,
+		},
+		{
+			"base fee higher than gas price, no tip",
+			func() {
+				feemarketParams.BaseFeeDestination = feemarkettypes.BaseFeeDestinationBurn
+				feemarketParams.TipToProposer = true
+				baseFee = big.NewInt(20)
+			},
+			10000, 0, 0,
+		},
+		{
+			"nil base fee, whole fee is tip",
+			func() {
+				feemarketParams.BaseFeeDestination = feemarkettypes.BaseFeeDestinationBurn
+				feemarketParams.TipToProposer = true
+				baseFee = nil
+			},
+			0, 0, 10000,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest() // reset
+
+			baseFee = big.NewInt(6)
+			feemarketParams = suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			tc.malleate()
+			suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, feemarketParams))
+
+			denom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+			feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			prevCollector := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denom).Amount
+			prevSupply := suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount
+			prevCommunity := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(denom)
+
+			m := ethtypes.NewMessage(suite.address, &proposer, 0, big.NewInt(0), gasUsed, gasPrice, gasPrice, gasPrice, nil, nil, false)
+			err := suite.app.EvmKeeper.DistributeFees(suite.ctx, m, gasUsed, baseFee, proposer, denom)
+			suite.Require().NoError(err)
+
+			collector := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denom).Amount
+			supply := suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount
+			community := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(denom)
+			tip := suite.app.BankKeeper.GetBalance(suite.ctx, proposer.Bytes(), denom).Amount
+
+			suite.Require().Equal(tc.expBurned, prevSupply.Sub(supply).Int64())
+			suite.Require().Equal(tc.expCommunity, community.Sub(prevCommunity).TruncateInt64())
+			suite.Require().Equal(tc.expTip, tip.Int64())
+			suite.Require().Equal(tc.expBurned+tc.expCommunity+tc.expTip, prevCollector.Sub(collector).Int64())
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
 func (suite *KeeperTestSuite) TestResetGasMeterAndConsumeGas() {
 	testCases := []struct {
 		name        string
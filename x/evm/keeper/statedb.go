@@ -85,10 +85,19 @@ func (k *Keeper) ForEachStorage(ctx sdk.Context, addr common.Address, cb func(ke
 }
 
 // SetBalance update account's balance, compare with current balance first, then decide to mint or burn.
+// The amount has 18 decimals, its fractional part being tracked by the evm module when the evm denom
+// has less decimals.
 func (k *Keeper) SetBalance(ctx sdk.Context, addr common.Address, amount *big.Int) error {
 	cosmosAddr := sdk.AccAddress(addr.Bytes())
 
 	params := k.GetParams(ctx)
+
+	// split the 18 decimals amount between the bank module and the fractional balance
+	fractional := new(big.Int)
+	if params.EvmDenomDecimals != types.DefaultEVMDenomDecimals {
+		amount, fractional = new(big.Int).QuoRem(amount, params.ConversionFactor().BigInt(), fractional)
+	}
+
 	coin := k.bankKeeper.GetBalance(ctx, cosmosAddr, params.EvmDenom)
 	balance := coin.Amount.BigInt()
 	delta := new(big.Int).Sub(amount, balance)
@@ -114,7 +123,11 @@ func (k *Keeper) SetBalance(ctx sdk.Context, addr common.Address, amount *big.In
 	default:
 		// not changed
 	}
-	return nil
+
+	if params.EvmDenomDecimals == types.DefaultEVMDenomDecimals {
+		return nil
+	}
+	return k.setFractionalBalance(ctx, addr, sdkmath.NewIntFromBigInt(fractional), params)
 }
 
 // SetAccount updates nonce/balance/codeHash together.
@@ -45,6 +45,8 @@ func MigrateStore(
 	params.EnableCreate = store.Has(types.ParamStoreKeyEnableCreate)
 	params.EnableCall = store.Has(types.ParamStoreKeyEnableCall)
 	params.AllowUnprotectedTxs = store.Has(types.ParamStoreKeyAllowUnprotectedTxs)
+	// the EVM denom had the same precision as the EVM before the decimals were configurable
+	params.EvmDenomDecimals = types.DefaultEVMDenomDecimals
 
 	store.Delete(types.ParamStoreKeyChainConfig)
 	store.Delete(types.ParamStoreKeyExtraEIPs)
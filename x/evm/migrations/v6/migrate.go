@@ -0,0 +1,36 @@
+package v6
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// MigrateStore migrates the x/evm module state from the consensus version 5 to
+// version 6. Specifically, it sets the evm denom decimals parameter to 18, which
+// keeps the existing 1:1 mapping between the bank and the EVM balances.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	var (
+		store  = ctx.KVStore(storeKey)
+		params types.Params
+	)
+
+	paramsBz := store.Get(types.KeyPrefixParams)
+	cdc.MustUnmarshal(paramsBz, &params)
+
+	params.EvmDenomDecimals = types.DefaultEVMDenomDecimals
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz := cdc.MustMarshal(&params)
+
+	store.Set(types.KeyPrefixParams, bz)
+	return nil
+}
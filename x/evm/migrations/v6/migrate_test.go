@@ -0,0 +1,39 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/app"
+	"github.com/evmos/ethermint/encoding"
+	v6 "github.com/evmos/ethermint/x/evm/migrations/v6"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	// params stored before the evm denom decimals were added
+	params := types.DefaultParams()
+	params.EvmDenomDecimals = 0
+	kvStore.Set(types.KeyPrefixParams, cdc.MustMarshal(&params))
+
+	err := v6.MigrateStore(ctx, storeKey, cdc)
+	require.NoError(t, err)
+
+	var migrated types.Params
+	cdc.MustUnmarshal(kvStore.Get(types.KeyPrefixParams), &migrated)
+
+	require.Equal(t, types.DefaultEVMDenomDecimals, migrated.EvmDenomDecimals)
+	require.Equal(t, params.EvmDenom, migrated.EvmDenom)
+	require.Equal(t, params.ChainConfig, migrated.ChainConfig)
+}
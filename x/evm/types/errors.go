@@ -49,6 +49,7 @@ const (
 	codeErrGasOverflow
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
+	codeErrInvalidFeeDenom
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -116,6 +117,9 @@ var (
 
 	// ErrInvalidGasLimit returns an error if gas limit value is invalid
 	ErrInvalidGasLimit = errorsmod.Register(ModuleName, codeErrInvalidGasLimit, "invalid gas limit")
+
+	// ErrInvalidFeeDenom returns an error if the fees cannot be paid with the given denomination
+	ErrInvalidFeeDenom = errorsmod.Register(ModuleName, codeErrInvalidFeeDenom, "invalid fee denomination")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
@@ -40,6 +40,14 @@ type Params struct {
 	// allow_unprotected_txs defines if replay-protected (i.e non EIP155
 	// signed) transactions can be executed on the state machine.
 	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
+	// fee_denoms defines the whitelisted denominations, other than the evm_denom,
+	// that can be used to pay for the gas of Ethereum transactions
+	FeeDenoms []FeeDenom `protobuf:"bytes,7,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms" yaml:"fee_denoms"`
+	// evm_denom_decimals defines the number of decimals of the evm_denom on the
+	// bank module. Balances are always presented to the EVM with 18 decimals, the
+	// fractional part that cannot be held by the bank module being tracked by the
+	// evm module.
+	EvmDenomDecimals uint32 `protobuf:"varint,8,opt,name=evm_denom_decimals,json=evmDenomDecimals,proto3" json:"evm_denom_decimals,omitempty" yaml:"evm_denom_decimals"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -117,6 +125,70 @@ func (m *Params) GetAllowUnprotectedTxs() bool {
 	return false
 }
 
+func (m *Params) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetEvmDenomDecimals() uint32 {
+	if m != nil {
+		return m.EvmDenomDecimals
+	}
+	return 0
+}
+
+// FeeDenom defines an alternative denomination that can be used to pay for the
+// gas of Ethereum transactions, along with its conversion rate to the evm_denom.
+type FeeDenom struct {
+	// denom defines the fee denomination
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// conversion_rate defines the amount of denom paid per unit of evm_denom. A
+	// zero rate uses the rate reported by the fee denom oracle instead.
+	ConversionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=conversion_rate,json=conversionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"conversion_rate" yaml:"conversion_rate"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -165,7 +237,7 @@ func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+	return fileDescriptor_d21ecc92c8c8583e, []int{2}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,7 +292,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+	return fileDescriptor_d21ecc92c8c8583e, []int{3}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,7 +349,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+	return fileDescriptor_d21ecc92c8c8583e, []int{4}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,7 +425,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+	return fileDescriptor_d21ecc92c8c8583e, []int{5}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,7 +540,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+	return fileDescriptor_d21ecc92c8c8583e, []int{6}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -509,7 +581,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{6}
+	return fileDescriptor_d21ecc92c8c8583e, []int{7}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,7 +641,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{7}
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -677,6 +749,7 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
+	proto.RegisterType((*FeeDenom)(nil), "ethermint.evm.v1.FeeDenom")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
 	proto.RegisterType((*State)(nil), "ethermint.evm.v1.State")
 	proto.RegisterType((*TransactionLogs)(nil), "ethermint.evm.v1.TransactionLogs")
@@ -689,108 +762,115 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x6e, 0xe3, 0xc6,
-	0x15, 0xb6, 0x2d, 0xda, 0xa6, 0x46, 0xb2, 0x44, 0x8f, 0xb5, 0x8e, 0xb2, 0x8b, 0x9a, 0x2e, 0x2f,
-	0x0a, 0x17, 0x48, 0xec, 0xd8, 0x81, 0xd1, 0x45, 0x82, 0x16, 0xb5, 0x76, 0x9d, 0xc4, 0xee, 0x36,
-	0x35, 0xc6, 0x0e, 0x0a, 0x14, 0x28, 0x88, 0x11, 0x39, 0xa1, 0x18, 0x93, 0x1c, 0x61, 0x66, 0xa8,
-	0x95, 0xda, 0x3e, 0x40, 0x8b, 0xde, 0xf4, 0x09, 0x8a, 0x3c, 0x4e, 0xd0, 0xab, 0xbd, 0x2c, 0x7a,
-	0x41, 0x14, 0xde, 0x3b, 0x5f, 0xea, 0x09, 0x8a, 0xf9, 0x11, 0xf5, 0x63, 0xa3, 0xad, 0x75, 0xe5,
-	0xf9, 0xce, 0x39, 0xf3, 0x7d, 0x33, 0x67, 0xce, 0x68, 0x0e, 0x0d, 0x9e, 0x13, 0xd1, 0x23, 0x2c,
-	0x8d, 0x33, 0x71, 0x44, 0x06, 0xe9, 0xd1, 0xe0, 0x58, 0xfe, 0x39, 0xec, 0x33, 0x2a, 0x28, 0x74,
-	0x4a, 0xdf, 0xa1, 0x34, 0x0e, 0x8e, 0x9f, 0xb7, 0x22, 0x1a, 0x51, 0xe5, 0x3c, 0x92, 0x23, 0x1d,
-	0xe7, 0xfd, 0xa5, 0x02, 0x36, 0xae, 0x30, 0xc3, 0x29, 0x87, 0xc7, 0xa0, 0x4a, 0x06, 0xa9, 0x1f,
-	0x92, 0x8c, 0xa6, 0xed, 0xd5, 0xfd, 0xd5, 0x83, 0x6a, 0xa7, 0x35, 0x2e, 0x5c, 0x67, 0x84, 0xd3,
-	0xe4, 0x33, 0xaf, 0x74, 0x79, 0xc8, 0x26, 0x83, 0xf4, 0xb5, 0x1c, 0xc2, 0x9f, 0x83, 0x2d, 0x92,
-	0xe1, 0x6e, 0x42, 0xfc, 0x80, 0x11, 0x2c, 0x48, 0x7b, 0x6d, 0x7f, 0xf5, 0xc0, 0xee, 0xb4, 0xc7,
-	0x85, 0xdb, 0x32, 0xd3, 0x66, 0xdd, 0x1e, 0xaa, 0x6b, 0xfc, 0x4a, 0x41, 0xf8, 0x33, 0x50, 0x9b,
-	0xf8, 0x71, 0x92, 0xb4, 0x2b, 0x6a, 0xf2, 0xee, 0xb8, 0x70, 0xe1, 0xfc, 0x64, 0x9c, 0x24, 0x1e,
-	0x02, 0x66, 0x2a, 0x4e, 0x12, 0x78, 0x06, 0x00, 0x19, 0x0a, 0x86, 0x7d, 0x12, 0xf7, 0x79, 0xdb,
-	0xda, 0xaf, 0x1c, 0x54, 0x3a, 0xde, 0x5d, 0xe1, 0x56, 0xcf, 0xa5, 0xf5, 0xfc, 0xe2, 0x8a, 0x8f,
-	0x0b, 0x77, 0xdb, 0x90, 0x94, 0x81, 0x1e, 0xaa, 0x2a, 0x70, 0x1e, 0xf7, 0x39, 0xfc, 0x3d, 0xa8,
-	0x07, 0x3d, 0x1c, 0x67, 0x7e, 0x40, 0xb3, 0x6f, 0xe3, 0xa8, 0xbd, 0xbe, 0xbf, 0x7a, 0x50, 0x3b,
-	0xf9, 0xd1, 0xe1, 0x62, 0xde, 0x0e, 0x5f, 0xc9, 0xa8, 0x57, 0x2a, 0xa8, 0xf3, 0xe2, 0x87, 0xc2,
-	0x5d, 0x19, 0x17, 0xee, 0x8e, 0xa6, 0x9e, 0x25, 0xf0, 0x50, 0x2d, 0x98, 0x46, 0xc2, 0x13, 0xf0,
-	0x0c, 0x27, 0x09, 0x7d, 0xeb, 0xe7, 0x99, 0x4c, 0x34, 0x09, 0x04, 0x09, 0x7d, 0x31, 0xe4, 0xed,
-	0x0d, 0xb9, 0x49, 0xb4, 0xa3, 0x9c, 0xdf, 0x4c, 0x7d, 0x37, 0x43, 0xee, 0xfd, 0x7d, 0x1b, 0xd4,
-	0x66, 0xd4, 0x60, 0x0a, 0x9a, 0x3d, 0x9a, 0x12, 0x2e, 0x08, 0x0e, 0xfd, 0x6e, 0x42, 0x83, 0x5b,
-	0x73, 0x2c, 0xaf, 0xff, 0x55, 0xb8, 0x3f, 0x89, 0x62, 0xd1, 0xcb, 0xbb, 0x87, 0x01, 0x4d, 0x8f,
-	0x02, 0xca, 0x53, 0xca, 0xcd, 0x9f, 0x8f, 0x79, 0x78, 0x7b, 0x24, 0x46, 0x7d, 0xc2, 0x0f, 0x2f,
-	0x32, 0x31, 0x2e, 0xdc, 0x5d, 0xbd, 0xd8, 0x05, 0x2a, 0x0f, 0x35, 0x4a, 0x4b, 0x47, 0x1a, 0xe0,
-	0x08, 0x34, 0x42, 0x4c, 0xfd, 0x6f, 0x29, 0xbb, 0x35, 0x6a, 0x6b, 0x4a, 0xed, 0xfa, 0xff, 0x57,
-	0xbb, 0x2b, 0xdc, 0xfa, 0xeb, 0xb3, 0xdf, 0x7c, 0x41, 0xd9, 0xad, 0xe2, 0x1c, 0x17, 0xee, 0x33,
-	0xad, 0x3e, 0xcf, 0xec, 0xa1, 0x7a, 0x88, 0x69, 0x19, 0x06, 0x7f, 0x0b, 0x9c, 0x32, 0x80, 0xe7,
-	0xfd, 0x3e, 0x65, 0xc2, 0x54, 0xc3, 0xc7, 0x77, 0x85, 0xdb, 0x30, 0x94, 0xd7, 0xda, 0x33, 0x2e,
-	0xdc, 0x0f, 0x16, 0x48, 0xcd, 0x1c, 0x0f, 0x35, 0x0c, 0xad, 0x09, 0x85, 0x1c, 0xd4, 0x49, 0xdc,
-	0x3f, 0x3e, 0xfd, 0xc4, 0xec, 0xc8, 0x52, 0x3b, 0xba, 0x7a, 0xd2, 0x8e, 0x6a, 0xe7, 0x17, 0x57,
-	0xc7, 0xa7, 0x9f, 0x4c, 0x36, 0x64, 0xce, 0x7e, 0x96, 0xd6, 0x43, 0x35, 0x0d, 0xf5, 0x6e, 0x2e,
-	0x80, 0x81, 0x7e, 0x0f, 0xf3, 0x9e, 0xaa, 0xac, 0x6a, 0xe7, 0xe0, 0xae, 0x70, 0x81, 0x66, 0xfa,
-	0x0a, 0xf3, 0xde, 0xf4, 0x5c, 0xba, 0xa3, 0x3f, 0xe0, 0x4c, 0xc4, 0x79, 0x3a, 0xe1, 0x02, 0x7a,
-	0xb2, 0x8c, 0x2a, 0xd7, 0x7f, 0x6a, 0xd6, 0xbf, 0xb1, 0xf4, 0xfa, 0x4f, 0x1f, 0x5b, 0xff, 0xe9,
-	0xfc, 0xfa, 0x75, 0x4c, 0x29, 0xfa, 0xd2, 0x88, 0x6e, 0x2e, 0x2d, 0xfa, 0xf2, 0x31, 0xd1, 0x97,
-	0xf3, 0xa2, 0x3a, 0x46, 0x16, 0xfb, 0x42, 0x26, 0xda, 0xf6, 0xf2, 0xc5, 0xfe, 0x20, 0xa9, 0x8d,
-	0xd2, 0xa2, 0xe5, 0xfe, 0x04, 0x5a, 0x01, 0xcd, 0xb8, 0x90, 0xb6, 0x8c, 0xf6, 0x13, 0x62, 0x34,
-	0xab, 0x4a, 0xf3, 0xe2, 0x49, 0x9a, 0x2f, 0xcc, 0xaf, 0xc1, 0x23, 0x7c, 0x1e, 0xda, 0x99, 0x37,
-	0x6b, 0xf5, 0x3e, 0x70, 0xfa, 0x44, 0x10, 0xc6, 0xbb, 0x39, 0x8b, 0x8c, 0x32, 0x50, 0xca, 0xe7,
-	0x4f, 0x52, 0x36, 0xf7, 0x60, 0x91, 0xcb, 0x43, 0xcd, 0xa9, 0x49, 0x2b, 0x7e, 0x07, 0x1a, 0xb1,
-	0x5c, 0x46, 0x37, 0x4f, 0x8c, 0x5e, 0x4d, 0xe9, 0xbd, 0x7a, 0x92, 0x9e, 0xb9, 0xcc, 0xf3, 0x4c,
-	0x1e, 0xda, 0x9a, 0x18, 0xb4, 0x56, 0x0e, 0x60, 0x9a, 0xc7, 0xcc, 0x8f, 0x12, 0x1c, 0xc4, 0x84,
-	0x19, 0xbd, 0xba, 0xd2, 0xfb, 0xf2, 0x49, 0x7a, 0x1f, 0x6a, 0xbd, 0x87, 0x6c, 0x1e, 0x72, 0xa4,
-	0xf1, 0x4b, 0x6d, 0xd3, 0xb2, 0x21, 0xa8, 0x77, 0x09, 0x4b, 0xe2, 0xcc, 0x08, 0x6e, 0x29, 0xc1,
-	0xb3, 0x27, 0x09, 0x9a, 0x3a, 0x9d, 0xe5, 0xf1, 0x50, 0x4d, 0xc3, 0x52, 0x25, 0xa1, 0x59, 0x48,
-	0x27, 0x2a, 0xdb, 0xcb, 0xab, 0xcc, 0xf2, 0x78, 0xa8, 0xa6, 0xa1, 0x56, 0x19, 0x82, 0x1d, 0xcc,
-	0x18, 0x7d, 0xbb, 0x90, 0x43, 0xa8, 0xc4, 0xbe, 0x7a, 0x92, 0xd8, 0x73, 0x2d, 0xf6, 0x08, 0x9d,
-	0x87, 0xb6, 0x95, 0x75, 0x2e, 0x8b, 0x39, 0x80, 0x11, 0xc3, 0xa3, 0x05, 0xe1, 0xd6, 0xf2, 0x87,
-	0xf7, 0x90, 0xcd, 0x43, 0x8e, 0x34, 0xce, 0xc9, 0xfe, 0x11, 0xb4, 0x52, 0xc2, 0x22, 0xe2, 0x67,
-	0x44, 0xf0, 0x7e, 0x12, 0x0b, 0x23, 0xfc, 0x6c, 0xf9, 0xfb, 0xf8, 0x18, 0x9f, 0x87, 0xa0, 0x32,
-	0x7f, 0x6d, 0xac, 0xe5, 0xe5, 0xe0, 0x3d, 0x9c, 0x45, 0x3d, 0x1c, 0x1b, 0xd9, 0xdd, 0xe5, 0x2f,
-	0xc7, 0x3c, 0x93, 0x87, 0xb6, 0x26, 0x86, 0xb2, 0x7e, 0x02, 0x9c, 0x05, 0xf9, 0xa4, 0x7e, 0x3e,
-	0x58, 0xbe, 0x7e, 0x66, 0x79, 0x64, 0xfb, 0xa1, 0xa0, 0x52, 0xb9, 0xb4, 0xec, 0x86, 0xd3, 0xbc,
-	0xb4, 0xec, 0xa6, 0xe3, 0x5c, 0x5a, 0xb6, 0xe3, 0x6c, 0x5f, 0x5a, 0xf6, 0x8e, 0xd3, 0x42, 0x5b,
-	0x23, 0x9a, 0x50, 0x7f, 0xf0, 0xa9, 0x9e, 0x84, 0x6a, 0xe4, 0x2d, 0xe6, 0xe6, 0x37, 0x12, 0x35,
-	0x02, 0x2c, 0x70, 0x32, 0xe2, 0x26, 0x55, 0xc8, 0xd1, 0x09, 0x9c, 0x79, 0xb5, 0x8f, 0xc0, 0xfa,
-	0xb5, 0x90, 0x8d, 0x9b, 0x03, 0x2a, 0xb7, 0x64, 0xa4, 0xbb, 0x11, 0x24, 0x87, 0xb0, 0x05, 0xd6,
-	0x07, 0x38, 0xc9, 0x75, 0x07, 0x58, 0x45, 0x1a, 0x78, 0x57, 0xa0, 0x79, 0xc3, 0x70, 0xc6, 0x71,
-	0x20, 0x62, 0x9a, 0xbd, 0xa1, 0x11, 0x87, 0x10, 0x58, 0xea, 0x55, 0xd4, 0x73, 0xd5, 0x18, 0xfe,
-	0x14, 0x58, 0x09, 0x8d, 0x78, 0x7b, 0x6d, 0xbf, 0x72, 0x50, 0x3b, 0x79, 0xf6, 0xb0, 0x07, 0x7b,
-	0x43, 0x23, 0xa4, 0x42, 0xbc, 0x7f, 0xac, 0x81, 0xca, 0x1b, 0x1a, 0xc1, 0x36, 0xd8, 0xc4, 0x61,
-	0xc8, 0x08, 0xe7, 0x86, 0x69, 0x02, 0xe1, 0x2e, 0xd8, 0x10, 0xb4, 0x1f, 0x07, 0x9a, 0xae, 0x8a,
-	0x0c, 0x92, 0xc2, 0x21, 0x16, 0x58, 0xf5, 0x15, 0x75, 0xa4, 0xc6, 0xf0, 0x04, 0xd4, 0xd5, 0xce,
-	0xfc, 0x2c, 0x4f, 0xbb, 0x84, 0xa9, 0xf6, 0xc0, 0xea, 0x34, 0xef, 0x0b, 0xb7, 0xa6, 0xec, 0x5f,
-	0x2b, 0x33, 0x9a, 0x05, 0xf0, 0x23, 0xb0, 0x29, 0x86, 0xb3, 0x2f, 0xfb, 0xce, 0x7d, 0xe1, 0x36,
-	0xc5, 0x74, 0x9b, 0xf2, 0xe1, 0x46, 0x1b, 0x62, 0xa8, 0x1e, 0xf0, 0x23, 0x60, 0x8b, 0xa1, 0x1f,
-	0x67, 0x21, 0x19, 0xaa, 0xc7, 0xdb, 0xea, 0xb4, 0xee, 0x0b, 0xd7, 0x99, 0x09, 0xbf, 0x90, 0x3e,
-	0xb4, 0x29, 0x86, 0x6a, 0x00, 0x3f, 0x02, 0x40, 0x2f, 0x49, 0x29, 0xe8, 0xa7, 0x77, 0xeb, 0xbe,
-	0x70, 0xab, 0xca, 0xaa, 0xb8, 0xa7, 0x43, 0xe8, 0x81, 0x75, 0xcd, 0x6d, 0x2b, 0xee, 0xfa, 0x7d,
-	0xe1, 0xda, 0x09, 0x8d, 0x34, 0xa7, 0x76, 0xc9, 0x54, 0x31, 0x92, 0xd2, 0x01, 0x09, 0xd5, 0xeb,
-	0x66, 0xa3, 0x09, 0xf4, 0xfe, 0xba, 0x06, 0xec, 0x9b, 0x21, 0x22, 0x3c, 0x4f, 0x04, 0xfc, 0x02,
-	0x38, 0x01, 0xcd, 0x04, 0xc3, 0x81, 0xf0, 0xe7, 0x52, 0xdb, 0x79, 0x31, 0x7d, 0x69, 0x16, 0x23,
-	0x3c, 0xd4, 0x9c, 0x98, 0xce, 0x4c, 0xfe, 0x5b, 0x60, 0xbd, 0x9b, 0x50, 0x9a, 0xaa, 0x4a, 0xa8,
-	0x23, 0x0d, 0x20, 0x52, 0x59, 0x53, 0xa7, 0x5c, 0x51, 0x9d, 0xf6, 0x8f, 0x1f, 0x9e, 0xf2, 0x42,
-	0xa9, 0x74, 0x76, 0x4d, 0xb7, 0xdd, 0xd0, 0xda, 0x66, 0xbe, 0x27, 0x73, 0xab, 0x4a, 0xc9, 0x01,
-	0x15, 0x46, 0x84, 0x3a, 0xb4, 0x3a, 0x92, 0x43, 0xf8, 0x1c, 0xd8, 0x8c, 0x0c, 0x08, 0x13, 0x24,
-	0x54, 0x87, 0x63, 0xa3, 0x12, 0xc3, 0x0f, 0x81, 0x1d, 0x61, 0xee, 0xe7, 0x9c, 0x84, 0xfa, 0x24,
-	0xd0, 0x66, 0x84, 0xf9, 0x37, 0x9c, 0x84, 0x9f, 0x59, 0x7f, 0xfe, 0xde, 0x5d, 0xf1, 0x30, 0xa8,
-	0x9d, 0x05, 0x01, 0xe1, 0xfc, 0x26, 0xef, 0x27, 0xe4, 0xbf, 0x54, 0xd8, 0x09, 0xa8, 0x73, 0x41,
-	0x19, 0x8e, 0x88, 0x7f, 0x4b, 0x46, 0xa6, 0xce, 0x74, 0xd5, 0x18, 0xfb, 0xaf, 0xc8, 0x88, 0xa3,
-	0x59, 0x60, 0x24, 0xbe, 0xb7, 0x40, 0xed, 0x86, 0xe1, 0x80, 0x98, 0x0e, 0x5f, 0xd6, 0xaa, 0x84,
-	0xcc, 0x48, 0x18, 0x24, 0xb5, 0x45, 0x9c, 0x12, 0x9a, 0x0b, 0x73, 0x9f, 0x26, 0x50, 0xce, 0x60,
-	0x84, 0x0c, 0x49, 0xa0, 0xd2, 0x68, 0x21, 0x83, 0xe0, 0x29, 0xd8, 0x0a, 0x63, 0xae, 0x3e, 0x97,
-	0xb8, 0xc0, 0xc1, 0xad, 0xde, 0x7e, 0xc7, 0xb9, 0x2f, 0xdc, 0xba, 0x71, 0x5c, 0x4b, 0x3b, 0x9a,
-	0x43, 0xf0, 0x73, 0xd0, 0x9c, 0x4e, 0x53, 0xab, 0xd5, 0x1f, 0x28, 0x1d, 0x78, 0x5f, 0xb8, 0x8d,
-	0x32, 0x54, 0x79, 0xd0, 0x02, 0x96, 0x27, 0x1d, 0x92, 0x6e, 0x1e, 0xa9, 0xe2, 0xb3, 0x91, 0x06,
-	0xd2, 0x9a, 0xc4, 0x69, 0x2c, 0x54, 0xb1, 0xad, 0x23, 0x0d, 0xe0, 0xe7, 0xa0, 0x4a, 0x07, 0x84,
-	0xb1, 0x38, 0x24, 0x5c, 0xb5, 0x3a, 0xff, 0xeb, 0x5b, 0x0b, 0x4d, 0xe3, 0xe5, 0xe6, 0xcc, 0xa7,
-	0x60, 0x4a, 0x52, 0xca, 0x46, 0xaa, 0x77, 0x31, 0x9b, 0xd3, 0x8e, 0x5f, 0x2b, 0x3b, 0x9a, 0x43,
-	0xb0, 0x03, 0xa0, 0x99, 0xc6, 0x88, 0xc8, 0x59, 0xe6, 0xab, 0xfb, 0x5f, 0x57, 0x73, 0xd5, 0x2d,
-	0xd4, 0x5e, 0xa4, 0x9c, 0xaf, 0xb1, 0xc0, 0xe8, 0x81, 0x05, 0xfe, 0x02, 0x40, 0x7d, 0x26, 0xfe,
-	0x77, 0x9c, 0x96, 0x1f, 0x8b, 0xba, 0xb5, 0x50, 0xfa, 0xda, 0x6b, 0xd6, 0xec, 0x68, 0x74, 0xc9,
-	0xa9, 0xd9, 0xc5, 0xa5, 0x65, 0x5b, 0xce, 0xfa, 0xa5, 0x65, 0x6f, 0x3a, 0x76, 0x99, 0x3f, 0xb3,
-	0x0b, 0xb4, 0x33, 0xc1, 0x33, 0xcb, 0xeb, 0xfc, 0xf2, 0x87, 0xbb, 0xbd, 0xd5, 0x77, 0x77, 0x7b,
-	0xab, 0xff, 0xbe, 0xdb, 0x5b, 0xfd, 0xdb, 0xfb, 0xbd, 0x95, 0x77, 0xef, 0xf7, 0x56, 0xfe, 0xf9,
-	0x7e, 0x6f, 0xe5, 0x77, 0xb3, 0xef, 0x03, 0x19, 0xc8, 0xe7, 0x61, 0xfa, 0xfd, 0x3f, 0x54, 0xff,
-	0x01, 0x50, 0x6f, 0x44, 0x77, 0x43, 0x7d, 0xd9, 0x7f, 0xfa, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe2, 0xe1, 0x18, 0x7a, 0x1f, 0x10, 0x00, 0x00,
+	// 1716 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x6f, 0xe3, 0xb8,
+	0x15, 0x8f, 0x27, 0x4e, 0x22, 0xd3, 0x8e, 0xad, 0x30, 0x9e, 0xac, 0x27, 0x83, 0x8d, 0x52, 0x1d,
+	0x8a, 0x14, 0xd8, 0x4d, 0x36, 0x59, 0x04, 0x1d, 0xec, 0xa2, 0x45, 0xa3, 0x49, 0x66, 0x37, 0xd9,
+	0xe9, 0x36, 0xe0, 0x64, 0x51, 0xa0, 0x40, 0x21, 0xd0, 0x12, 0x47, 0xd6, 0x46, 0x12, 0x5d, 0x92,
+	0xf2, 0xd8, 0x6d, 0x3f, 0x40, 0x81, 0x5e, 0x0a, 0xf4, 0x5e, 0xec, 0x07, 0xe8, 0x07, 0x59, 0xf4,
+	0x34, 0xc7, 0xa2, 0x07, 0xa1, 0xc8, 0xdc, 0x72, 0xf4, 0x27, 0x28, 0xf8, 0xc7, 0xf2, 0x9f, 0x0c,
+	0x8a, 0x49, 0x4e, 0xd6, 0xfb, 0xbd, 0xc7, 0xdf, 0x8f, 0xef, 0xf1, 0x31, 0x24, 0x03, 0xb6, 0x89,
+	0xe8, 0x11, 0x96, 0xc6, 0x99, 0x38, 0x20, 0x83, 0xf4, 0x60, 0x70, 0x28, 0x7f, 0xf6, 0xfb, 0x8c,
+	0x0a, 0x0a, 0xed, 0xd2, 0xb7, 0x2f, 0xc1, 0xc1, 0xe1, 0x76, 0x3b, 0xa2, 0x11, 0x55, 0xce, 0x03,
+	0xf9, 0xa5, 0xe3, 0xdc, 0x7f, 0x56, 0xc1, 0xea, 0x25, 0x66, 0x38, 0xe5, 0xf0, 0x10, 0xd4, 0xc8,
+	0x20, 0xf5, 0x43, 0x92, 0xd1, 0xb4, 0x53, 0xd9, 0xad, 0xec, 0xd5, 0xbc, 0xf6, 0xb8, 0x70, 0xec,
+	0x11, 0x4e, 0x93, 0x2f, 0xdc, 0xd2, 0xe5, 0x22, 0x8b, 0x0c, 0xd2, 0x53, 0xf9, 0x09, 0x7f, 0x01,
+	0xd6, 0x49, 0x86, 0xbb, 0x09, 0xf1, 0x03, 0x46, 0xb0, 0x20, 0x9d, 0x47, 0xbb, 0x95, 0x3d, 0xcb,
+	0xeb, 0x8c, 0x0b, 0xa7, 0x6d, 0x86, 0xcd, 0xba, 0x5d, 0xd4, 0xd0, 0xf6, 0x73, 0x65, 0xc2, 0x9f,
+	0x83, 0xfa, 0xc4, 0x8f, 0x93, 0xa4, 0xb3, 0xac, 0x06, 0x6f, 0x8d, 0x0b, 0x07, 0xce, 0x0f, 0xc6,
+	0x49, 0xe2, 0x22, 0x60, 0x86, 0xe2, 0x24, 0x81, 0x27, 0x00, 0x90, 0xa1, 0x60, 0xd8, 0x27, 0x71,
+	0x9f, 0x77, 0xaa, 0xbb, 0xcb, 0x7b, 0xcb, 0x9e, 0x7b, 0x53, 0x38, 0xb5, 0x33, 0x89, 0x9e, 0x9d,
+	0x5f, 0xf2, 0x71, 0xe1, 0x6c, 0x18, 0x92, 0x32, 0xd0, 0x45, 0x35, 0x65, 0x9c, 0xc5, 0x7d, 0x0e,
+	0x7f, 0x0f, 0x1a, 0x41, 0x0f, 0xc7, 0x99, 0x1f, 0xd0, 0xec, 0x75, 0x1c, 0x75, 0x56, 0x76, 0x2b,
+	0x7b, 0xf5, 0xa3, 0x8f, 0xf7, 0x17, 0xeb, 0xb6, 0xff, 0x5c, 0x46, 0x3d, 0x57, 0x41, 0xde, 0xd3,
+	0x1f, 0x0b, 0x67, 0x69, 0x5c, 0x38, 0x9b, 0x9a, 0x7a, 0x96, 0xc0, 0x45, 0xf5, 0x60, 0x1a, 0x09,
+	0x8f, 0xc0, 0x63, 0x9c, 0x24, 0xf4, 0x8d, 0x9f, 0x67, 0xb2, 0xd0, 0x24, 0x10, 0x24, 0xf4, 0xc5,
+	0x90, 0x77, 0x56, 0x65, 0x92, 0x68, 0x53, 0x39, 0xbf, 0x9b, 0xfa, 0xae, 0x86, 0x1c, 0x5e, 0x01,
+	0xf0, 0x9a, 0x10, 0x5d, 0x65, 0xde, 0x59, 0xdb, 0x5d, 0xde, 0xab, 0x1f, 0x6d, 0xdf, 0x9d, 0xd0,
+	0x0b, 0x42, 0x54, 0xf5, 0xbd, 0x27, 0x66, 0x36, 0x26, 0xd1, 0xe9, 0x58, 0x17, 0xd5, 0x5e, 0x9b,
+	0x20, 0x0e, 0xbf, 0x01, 0xb0, 0x5c, 0x3b, 0x3f, 0x24, 0x41, 0x9c, 0xe2, 0x84, 0x77, 0xac, 0xdd,
+	0xca, 0xde, 0xba, 0xf7, 0xf1, 0xb8, 0x70, 0x9e, 0x2c, 0xac, 0x6f, 0x19, 0xe3, 0x22, 0x7b, 0xb2,
+	0xd0, 0xa7, 0x13, 0xe8, 0xef, 0x15, 0x60, 0x4d, 0xf4, 0x61, 0x1b, 0xac, 0xcc, 0x34, 0x0b, 0xd2,
+	0x06, 0xfc, 0x03, 0x68, 0x05, 0x34, 0x1b, 0x10, 0xc6, 0x63, 0x9a, 0xf9, 0x6c, 0xd2, 0x15, 0x35,
+	0xef, 0x6b, 0x39, 0xdd, 0xff, 0x14, 0xce, 0x4f, 0xa3, 0x58, 0xf4, 0xf2, 0xee, 0x7e, 0x40, 0xd3,
+	0x83, 0x80, 0xf2, 0x94, 0x72, 0xf3, 0xf3, 0x29, 0x0f, 0xaf, 0x0f, 0xc4, 0xa8, 0x4f, 0xf8, 0xfe,
+	0x29, 0x09, 0xc6, 0x85, 0xb3, 0x65, 0xca, 0x3c, 0x4f, 0xe7, 0xa2, 0xe6, 0x14, 0x41, 0x12, 0xf8,
+	0xc7, 0x06, 0xa8, 0xcf, 0x2c, 0x13, 0x4c, 0x41, 0xab, 0x47, 0x53, 0xc2, 0x05, 0xc1, 0xa1, 0xdf,
+	0x4d, 0x68, 0x70, 0x6d, 0xfa, 0xf9, 0xf4, 0x03, 0xe5, 0xcf, 0x33, 0x31, 0x95, 0x5f, 0xa0, 0x72,
+	0x51, 0xb3, 0x44, 0x3c, 0x09, 0xc0, 0x11, 0x68, 0x86, 0x98, 0xfa, 0xaf, 0x29, 0xbb, 0x36, 0x6a,
+	0x3a, 0xe1, 0x57, 0x1f, 0xae, 0x76, 0x53, 0x38, 0x8d, 0xd3, 0x93, 0xdf, 0xbc, 0xa0, 0xec, 0x5a,
+	0x71, 0x8e, 0x0b, 0xe7, 0xb1, 0x56, 0x9f, 0x67, 0x76, 0x51, 0x23, 0xc4, 0xb4, 0x0c, 0x83, 0xbf,
+	0x05, 0x76, 0x19, 0xc0, 0xf3, 0x7e, 0x9f, 0x32, 0x61, 0xb6, 0xd1, 0xa7, 0x37, 0x85, 0xd3, 0x34,
+	0x94, 0xaf, 0xb4, 0x67, 0x5c, 0x38, 0x1f, 0x2d, 0x90, 0x9a, 0x31, 0x2e, 0x6a, 0x1a, 0x5a, 0x13,
+	0x0a, 0x39, 0x68, 0x90, 0xb8, 0x7f, 0x78, 0xfc, 0x99, 0xc9, 0xa8, 0xaa, 0x32, 0xba, 0xbc, 0x57,
+	0x46, 0xf5, 0xb3, 0xf3, 0xcb, 0xc3, 0xe3, 0xcf, 0x26, 0x09, 0x99, 0x4d, 0x33, 0x4b, 0xeb, 0xa2,
+	0xba, 0x36, 0x75, 0x36, 0xe7, 0xc0, 0x98, 0x7e, 0x0f, 0xf3, 0x9e, 0xda, 0x92, 0x35, 0x6f, 0xef,
+	0xa6, 0x70, 0x80, 0x66, 0xfa, 0x1a, 0xf3, 0xde, 0x74, 0x5d, 0xba, 0xa3, 0x3f, 0xe2, 0x4c, 0xc4,
+	0x79, 0x3a, 0xe1, 0x02, 0x7a, 0xb0, 0x8c, 0x2a, 0xe7, 0x7f, 0x6c, 0xe6, 0xbf, 0xfa, 0xe0, 0xf9,
+	0x1f, 0xbf, 0x6f, 0xfe, 0xc7, 0xf3, 0xf3, 0xd7, 0x31, 0xa5, 0xe8, 0x33, 0x23, 0xba, 0xf6, 0x60,
+	0xd1, 0x67, 0xef, 0x13, 0x7d, 0x36, 0x2f, 0xaa, 0x63, 0x64, 0xb3, 0x2f, 0x54, 0xa2, 0x63, 0x3d,
+	0xbc, 0xd9, 0xef, 0x14, 0xb5, 0x59, 0x22, 0x5a, 0xee, 0xcf, 0xa0, 0x1d, 0xd0, 0x8c, 0x0b, 0x89,
+	0x65, 0xb4, 0x9f, 0x10, 0xa3, 0x59, 0x53, 0x9a, 0xe7, 0xf7, 0xd2, 0x7c, 0x5a, 0xee, 0xef, 0x3b,
+	0x7c, 0x2e, 0xda, 0x9c, 0x87, 0xb5, 0x7a, 0x1f, 0xd8, 0x7d, 0x22, 0x08, 0xe3, 0xdd, 0x9c, 0x45,
+	0x46, 0x19, 0x28, 0xe5, 0xb3, 0x7b, 0x29, 0x9b, 0x7d, 0xb0, 0xc8, 0xe5, 0xa2, 0xd6, 0x14, 0xd2,
+	0x8a, 0xdf, 0x83, 0x66, 0x2c, 0xa7, 0xd1, 0xcd, 0x13, 0xa3, 0x57, 0x57, 0x7a, 0xcf, 0xef, 0xa5,
+	0x67, 0x36, 0xf3, 0x3c, 0x93, 0x8b, 0xd6, 0x27, 0x80, 0xd6, 0xca, 0x01, 0x4c, 0xf3, 0x98, 0xf9,
+	0x51, 0x82, 0x83, 0x98, 0x30, 0xa3, 0xd7, 0x50, 0x7a, 0x5f, 0xdd, 0x4b, 0xcf, 0xfc, 0x51, 0xbf,
+	0xcb, 0xe6, 0x22, 0x5b, 0x82, 0x5f, 0x69, 0x4c, 0xcb, 0x86, 0xa0, 0xd1, 0x25, 0x2c, 0x89, 0x33,
+	0x23, 0xb8, 0xae, 0x04, 0x4f, 0xee, 0x25, 0x68, 0xfa, 0x74, 0x96, 0xc7, 0x45, 0x75, 0x6d, 0x96,
+	0x2a, 0x09, 0xcd, 0x42, 0x3a, 0x51, 0xd9, 0x78, 0xb8, 0xca, 0x2c, 0x8f, 0x8b, 0xea, 0xda, 0xd4,
+	0x2a, 0x43, 0xb0, 0x89, 0x19, 0xa3, 0x6f, 0x16, 0x6a, 0x08, 0xf5, 0x09, 0x74, 0x2f, 0xb1, 0x6d,
+	0x2d, 0xf6, 0x1e, 0x3a, 0x17, 0x6d, 0x28, 0x74, 0xae, 0x8a, 0x39, 0x80, 0x11, 0xc3, 0xa3, 0x05,
+	0xe1, 0xf6, 0xc3, 0x17, 0xef, 0x2e, 0x9b, 0x8b, 0x6c, 0x09, 0xce, 0xc9, 0xfe, 0x09, 0xb4, 0x53,
+	0xc2, 0x22, 0xe2, 0x67, 0x44, 0xf0, 0x7e, 0x12, 0x0b, 0x23, 0xfc, 0xf8, 0xe1, 0xfb, 0xf1, 0x7d,
+	0x7c, 0x2e, 0x82, 0x0a, 0xfe, 0xd6, 0xa0, 0xe5, 0xe6, 0xe0, 0x3d, 0x9c, 0x45, 0x3d, 0x1c, 0x1b,
+	0xd9, 0xad, 0x87, 0x6f, 0x8e, 0x79, 0x26, 0x17, 0xad, 0x4f, 0x80, 0xb2, 0x7f, 0x02, 0x9c, 0x05,
+	0xf9, 0xa4, 0x7f, 0x3e, 0x7a, 0x78, 0xff, 0xcc, 0xf2, 0xc8, 0x7b, 0x9b, 0x32, 0x95, 0xca, 0x45,
+	0xd5, 0x6a, 0xda, 0xad, 0x8b, 0xaa, 0xd5, 0xb2, 0xed, 0x8b, 0xaa, 0x65, 0xdb, 0x1b, 0x17, 0x55,
+	0x6b, 0xd3, 0x6e, 0xa3, 0xf5, 0x11, 0x4d, 0xa8, 0x3f, 0xf8, 0x5c, 0x0f, 0x42, 0x75, 0xf2, 0x06,
+	0x73, 0xf3, 0x37, 0x12, 0x35, 0x03, 0x2c, 0x70, 0x32, 0xe2, 0xa6, 0x54, 0xc8, 0xd6, 0x05, 0x9c,
+	0x39, 0xb5, 0x0f, 0xc0, 0xca, 0x2b, 0x21, 0x6f, 0xbc, 0x36, 0x58, 0xbe, 0x26, 0x23, 0x73, 0x61,
+	0x92, 0x9f, 0xf2, 0x12, 0x35, 0xc0, 0x49, 0x6e, 0x2e, 0x49, 0x48, 0x1b, 0xee, 0x25, 0x68, 0x5d,
+	0x31, 0x9c, 0x71, 0x1c, 0x88, 0x98, 0x66, 0x2f, 0x69, 0xc4, 0x21, 0x04, 0x55, 0x75, 0x2a, 0xea,
+	0xb1, 0xea, 0x1b, 0xfe, 0x0c, 0x54, 0x13, 0x1a, 0xf1, 0xce, 0x23, 0x75, 0x57, 0x7c, 0x7c, 0xf7,
+	0xae, 0xf8, 0x92, 0x46, 0x48, 0x85, 0xb8, 0xff, 0x7a, 0x04, 0x96, 0x5f, 0xd2, 0x08, 0x76, 0xc0,
+	0x1a, 0x0e, 0x43, 0x46, 0x38, 0x37, 0x4c, 0x13, 0x13, 0x6e, 0x81, 0x55, 0x41, 0xfb, 0x71, 0xa0,
+	0xe9, 0x6a, 0xc8, 0x58, 0x52, 0x38, 0xc4, 0x02, 0xab, 0x7b, 0x45, 0x03, 0xa9, 0x6f, 0x78, 0x04,
+	0x1a, 0x2a, 0x33, 0x3f, 0xcb, 0xd3, 0x2e, 0x61, 0xea, 0x7a, 0x50, 0xf5, 0x5a, 0xb7, 0x85, 0x53,
+	0x57, 0xf8, 0xb7, 0x0a, 0x46, 0xb3, 0x06, 0xfc, 0x04, 0xac, 0x89, 0xe1, 0xec, 0xc9, 0xbe, 0x79,
+	0x5b, 0x38, 0x2d, 0x31, 0x4d, 0x53, 0x1e, 0xdc, 0x68, 0x55, 0x0c, 0xe5, 0x2f, 0x3c, 0x00, 0x96,
+	0x18, 0xfa, 0x71, 0x16, 0x92, 0xa1, 0x3a, 0xbc, 0xab, 0x5e, 0xfb, 0xb6, 0x70, 0xec, 0x99, 0xf0,
+	0x73, 0xe9, 0x43, 0x6b, 0x62, 0xa8, 0x3e, 0xe0, 0x27, 0x00, 0xe8, 0x29, 0x29, 0x05, 0x7d, 0xf4,
+	0xae, 0xdf, 0x16, 0x4e, 0x4d, 0xa1, 0x8a, 0x7b, 0xfa, 0x09, 0x5d, 0xb0, 0xa2, 0xb9, 0x2d, 0xc5,
+	0xdd, 0xb8, 0x2d, 0x1c, 0x2b, 0xa1, 0x91, 0xe6, 0xd4, 0x2e, 0x59, 0x2a, 0x46, 0x52, 0x3a, 0x20,
+	0xa1, 0x3a, 0xdd, 0x2c, 0x34, 0x31, 0xdd, 0xbf, 0x3e, 0x02, 0xd6, 0xd5, 0x10, 0x11, 0x9e, 0x27,
+	0x02, 0xbe, 0x00, 0x76, 0x40, 0x33, 0xc1, 0x70, 0x20, 0xfc, 0xb9, 0xd2, 0x7a, 0x4f, 0xa7, 0x27,
+	0xcd, 0x62, 0x84, 0x8b, 0x5a, 0x13, 0xe8, 0xc4, 0xd4, 0xbf, 0x0d, 0x56, 0xba, 0x09, 0xa5, 0xa9,
+	0xea, 0x84, 0x06, 0xd2, 0x06, 0x44, 0xaa, 0x6a, 0x6a, 0x95, 0x97, 0xd5, 0x13, 0xe5, 0x27, 0x77,
+	0x57, 0x79, 0xa1, 0x55, 0xbc, 0x2d, 0xf3, 0x30, 0x68, 0x6a, 0x6d, 0x33, 0xde, 0x95, 0xb5, 0x55,
+	0xad, 0x64, 0x83, 0x65, 0x46, 0x84, 0x5a, 0xb4, 0x06, 0x92, 0x9f, 0x70, 0x1b, 0x58, 0x8c, 0x0c,
+	0x08, 0x13, 0x24, 0x54, 0x8b, 0x63, 0xa1, 0xd2, 0x86, 0x4f, 0x80, 0x15, 0x61, 0xee, 0xe7, 0x9c,
+	0x84, 0x7a, 0x25, 0xd0, 0x5a, 0x84, 0xf9, 0x77, 0x9c, 0x84, 0x5f, 0x54, 0xff, 0xf2, 0x83, 0xb3,
+	0xe4, 0x62, 0x50, 0x3f, 0x09, 0x02, 0xc2, 0xf9, 0x55, 0xde, 0x4f, 0xc8, 0xff, 0xe9, 0xb0, 0x23,
+	0xd0, 0xe0, 0x82, 0x32, 0x1c, 0x11, 0xff, 0x9a, 0x8c, 0x4c, 0x9f, 0xe9, 0xae, 0x31, 0xf8, 0x37,
+	0x64, 0xc4, 0xd1, 0xac, 0x61, 0x24, 0x7e, 0xa8, 0x82, 0xfa, 0x15, 0xc3, 0x01, 0x31, 0x37, 0x7c,
+	0xd9, 0xab, 0xd2, 0x64, 0x46, 0xc2, 0x58, 0x52, 0x5b, 0xc4, 0x29, 0xa1, 0xb9, 0x30, 0xfb, 0x69,
+	0x62, 0xca, 0x11, 0x8c, 0x90, 0x21, 0x09, 0x54, 0x19, 0xab, 0xc8, 0x58, 0xf0, 0x18, 0xac, 0x87,
+	0x31, 0x57, 0xef, 0x4c, 0x2e, 0x70, 0x70, 0xad, 0xd3, 0xf7, 0xec, 0xdb, 0xc2, 0x69, 0x18, 0xc7,
+	0x2b, 0x89, 0xa3, 0x39, 0x0b, 0x7e, 0x09, 0x5a, 0xd3, 0x61, 0x6a, 0xb6, 0xfa, 0x65, 0xe7, 0xc1,
+	0xdb, 0xc2, 0x69, 0x96, 0xa1, 0xca, 0x83, 0x16, 0x6c, 0xfd, 0x70, 0xea, 0xe6, 0x91, 0x6a, 0x3e,
+	0x0b, 0x69, 0x43, 0xa2, 0x49, 0x9c, 0xc6, 0x42, 0x35, 0xdb, 0x0a, 0xd2, 0x06, 0xfc, 0x12, 0xd4,
+	0xe8, 0x80, 0x30, 0x16, 0x87, 0x84, 0x77, 0xc0, 0x07, 0x3c, 0x52, 0xd1, 0x34, 0x5e, 0x26, 0x67,
+	0xde, 0xd0, 0x29, 0x49, 0x29, 0x1b, 0x75, 0xea, 0xd3, 0xe4, 0xb4, 0xe3, 0xd7, 0x0a, 0x47, 0x73,
+	0x16, 0xf4, 0x00, 0x34, 0xc3, 0x18, 0x11, 0x39, 0xcb, 0x7c, 0xb5, 0xff, 0x1b, 0x6a, 0xac, 0xda,
+	0x85, 0xda, 0x8b, 0x94, 0xf3, 0x14, 0x0b, 0x8c, 0xee, 0x20, 0xf0, 0x97, 0x00, 0xea, 0x35, 0xf1,
+	0xbf, 0xe7, 0xb4, 0x7c, 0x65, 0xeb, 0xab, 0x85, 0xd2, 0xd7, 0x5e, 0x33, 0x67, 0x5b, 0x5b, 0x17,
+	0x9c, 0x9a, 0x2c, 0x2e, 0xaa, 0x56, 0xd5, 0x5e, 0xb9, 0xa8, 0x5a, 0x6b, 0xb6, 0x55, 0xd6, 0xcf,
+	0x64, 0x81, 0x36, 0x27, 0xf6, 0xcc, 0xf4, 0xbc, 0x5f, 0xfd, 0x78, 0xb3, 0x53, 0x79, 0x7b, 0xb3,
+	0x53, 0xf9, 0xef, 0xcd, 0x4e, 0xe5, 0x6f, 0xef, 0x76, 0x96, 0xde, 0xbe, 0xdb, 0x59, 0xfa, 0xf7,
+	0xbb, 0x9d, 0xa5, 0xdf, 0xcd, 0x9e, 0x0f, 0x64, 0x20, 0x8f, 0x87, 0xe9, 0x3f, 0x4e, 0x86, 0x12,
+	0xd1, 0x67, 0x44, 0x77, 0x55, 0xfd, 0x4b, 0xe4, 0xf3, 0xff, 0x0d, 0x00, 0x9c, 0x28, 0x3e, 0xe7,
+	0x58, 0x11, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -813,6 +893,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EvmDenomDecimals != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.EvmDenomDecimals))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.AllowUnprotectedTxs {
 		i--
 		if m.AllowUnprotectedTxs {
@@ -882,6 +981,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConversionRate.Size()
+		i -= size
+		if _, err := m.ConversionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1567,6 +1706,30 @@ func (m *Params) Size() (n int) {
 	if m.AllowUnprotectedTxs {
 		n += 2
 	}
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.EvmDenomDecimals != 0 {
+		n += 1 + sovEvm(uint64(m.EvmDenomDecimals))
+	}
+	return n
+}
+
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = m.ConversionRate.Size()
+	n += 1 + l + sovEvm(uint64(l))
 	return n
 }
 
@@ -2063,6 +2226,175 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowUnprotectedTxs = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmDenomDecimals", wireType)
+			}
+			m.EvmDenomDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmDenomDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -29,6 +29,19 @@ func (ga GenesisAccount) Validate() error {
 	return ga.Storage.Validate()
 }
 
+// Validate performs a basic validation of a FractionalBalance fields.
+func (fb FractionalBalance) Validate() error {
+	if err := ethermint.ValidateAddress(fb.Address); err != nil {
+		return err
+	}
+
+	if fb.Amount.IsNil() || !fb.Amount.IsPositive() {
+		return fmt.Errorf("fractional balance must be positive: %s", fb.Amount)
+	}
+
+	return nil
+}
+
 // DefaultGenesisState sets default evm genesis state with empty accounts and default params and
 // chain config values.
 func DefaultGenesisState() *GenesisState {
@@ -60,5 +73,24 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	conversionFactor := gs.Params.ConversionFactor()
+	seenBalances := make(map[string]bool)
+	for _, balance := range gs.FractionalBalances {
+		if seenBalances[balance.Address] {
+			return fmt.Errorf("duplicated fractional balance %s", balance.Address)
+		}
+		if err := balance.Validate(); err != nil {
+			return fmt.Errorf("invalid fractional balance %s: %w", balance.Address, err)
+		}
+		if balance.Amount.GTE(conversionFactor) {
+			return fmt.Errorf("fractional balance %s of %s must be lower than the conversion factor %s", balance.Amount, balance.Address, conversionFactor)
+		}
+		seenBalances[balance.Address] = true
+	}
+
+	return nil
 }
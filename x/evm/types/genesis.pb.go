@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -29,6 +30,10 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// fractional_balances defines the fractional part of the account balances
+	// that cannot be held by the bank module, when the evm_denom has less than
+	// 18 decimals.
+	FractionalBalances []FractionalBalance `protobuf:"bytes,3,rep,name=fractional_balances,json=fractionalBalances,proto3" json:"fractional_balances"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +83,62 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetFractionalBalances() []FractionalBalance {
+	if m != nil {
+		return m.FractionalBalances
+	}
+	return nil
+}
+
+// FractionalBalance defines the fractional part of an account balance, in the 18
+// decimals representation used by the EVM, that cannot be held by the bank module.
+type FractionalBalance struct {
+	// address defines an ethereum hex formated address of an account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount defines the fractional balance of the account
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *FractionalBalance) Reset()         { *m = FractionalBalance{} }
+func (m *FractionalBalance) String() string { return proto.CompactTextString(m) }
+func (*FractionalBalance) ProtoMessage()    {}
+func (*FractionalBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bcdec50cc9d156d, []int{1}
+}
+func (m *FractionalBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FractionalBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FractionalBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FractionalBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FractionalBalance.Merge(m, src)
+}
+func (m *FractionalBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *FractionalBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_FractionalBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FractionalBalance proto.InternalMessageInfo
+
+func (m *FractionalBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -94,7 +155,7 @@ func (m *GenesisAccount) Reset()         { *m = GenesisAccount{} }
 func (m *GenesisAccount) String() string { return proto.CompactTextString(m) }
 func (*GenesisAccount) ProtoMessage()    {}
 func (*GenesisAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9bcdec50cc9d156d, []int{1}
+	return fileDescriptor_9bcdec50cc9d156d, []int{2}
 }
 func (m *GenesisAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -146,32 +207,38 @@ func (m *GenesisAccount) GetStorage() Storage {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ethermint.evm.v1.GenesisState")
+	proto.RegisterType((*FractionalBalance)(nil), "ethermint.evm.v1.FractionalBalance")
 	proto.RegisterType((*GenesisAccount)(nil), "ethermint.evm.v1.GenesisAccount")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/genesis.proto", fileDescriptor_9bcdec50cc9d156d) }
 
 var fileDescriptor_9bcdec50cc9d156d = []byte{
-	// 297 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x50, 0xbf, 0x4e, 0x83, 0x40,
-	0x1c, 0xe6, 0x6c, 0x53, 0xec, 0xd5, 0xa8, 0xb9, 0x98, 0x48, 0x18, 0xae, 0xa4, 0x83, 0x61, 0x3a,
-	0xd2, 0x9a, 0x38, 0x2b, 0x8b, 0xab, 0xa1, 0x9b, 0xdb, 0x15, 0x7e, 0xa1, 0x0c, 0x70, 0x84, 0xbb,
-	0x12, 0x5d, 0x1d, 0x9d, 0x7c, 0x0e, 0x9f, 0xa4, 0x63, 0x47, 0x27, 0x35, 0xf0, 0x22, 0x86, 0x83,
-	0xd6, 0x28, 0xdb, 0x07, 0xdf, 0xbf, 0xdf, 0x7d, 0x98, 0x82, 0x5a, 0x43, 0x91, 0x26, 0x99, 0xf2,
-	0xa0, 0x4c, 0xbd, 0x72, 0xee, 0xc5, 0x90, 0x81, 0x4c, 0x24, 0xcb, 0x0b, 0xa1, 0x04, 0x39, 0x3f,
-	0xf0, 0x0c, 0xca, 0x94, 0x95, 0x73, 0xdb, 0xee, 0x39, 0x1a, 0x42, 0xab, 0xed, 0x8b, 0x58, 0xc4,
-	0x42, 0x43, 0xaf, 0x41, 0xed, 0xdf, 0xd9, 0x2b, 0xc2, 0x27, 0xf7, 0x6d, 0xea, 0x52, 0x71, 0x05,
-	0xc4, 0xc7, 0xc7, 0x3c, 0x0c, 0xc5, 0x26, 0x53, 0xd2, 0x42, 0xce, 0xc0, 0x9d, 0x2c, 0x1c, 0xf6,
-	0xbf, 0x87, 0x75, 0x8e, 0xbb, 0x56, 0xe8, 0x0f, 0xb7, 0x9f, 0x53, 0x23, 0x38, 0xf8, 0xc8, 0x0d,
-	0x1e, 0xe5, 0xbc, 0xe0, 0xa9, 0xb4, 0x8e, 0x1c, 0xe4, 0x4e, 0x16, 0x56, 0x3f, 0xe1, 0x41, 0xf3,
-	0x9d, 0xb3, 0x53, 0xcf, 0x5e, 0x10, 0x3e, 0xfd, 0x1b, 0x4d, 0x2c, 0x6c, 0xf2, 0x28, 0x2a, 0x40,
-	0x36, 0xd7, 0x20, 0x77, 0x1c, 0xec, 0x3f, 0x09, 0xc1, 0xc3, 0x50, 0x44, 0xa0, 0x2b, 0xc6, 0x81,
-	0xc6, 0xc4, 0xc7, 0xa6, 0x54, 0xa2, 0xe0, 0x31, 0x58, 0x03, 0x7d, 0xfb, 0x65, 0xbf, 0x59, 0x3f,
-	0xd3, 0x3f, 0x6b, 0x8a, 0xdf, 0xbf, 0xa6, 0xe6, 0xb2, 0xd5, 0x07, 0x7b, 0xa3, 0x7f, 0xbb, 0xad,
-	0x28, 0xda, 0x55, 0x14, 0x7d, 0x57, 0x14, 0xbd, 0xd5, 0xd4, 0xd8, 0xd5, 0xd4, 0xf8, 0xa8, 0xa9,
-	0xf1, 0x78, 0x15, 0x27, 0x6a, 0xbd, 0x59, 0xb1, 0x50, 0xa4, 0xcd, 0xae, 0x42, 0x7a, 0xbf, 0x73,
-	0x3f, 0xe9, 0xc1, 0xd5, 0x73, 0x0e, 0x72, 0x35, 0xd2, 0xd3, 0x5e, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xe3, 0x61, 0xf0, 0x9f, 0xc0, 0x01, 0x00, 0x00,
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4d, 0x4f, 0xfa, 0x30,
+	0x18, 0x5f, 0xff, 0x10, 0xf8, 0x53, 0x8c, 0x2f, 0xd5, 0xc4, 0x85, 0xc3, 0x20, 0x98, 0x18, 0x2e,
+	0x76, 0x01, 0x13, 0xcf, 0xba, 0x03, 0xc6, 0x9b, 0x19, 0x37, 0x2e, 0xa6, 0x6c, 0x65, 0x2c, 0xb2,
+	0x95, 0xac, 0x65, 0xd1, 0xab, 0x9f, 0xc0, 0xcf, 0xe1, 0x27, 0xe1, 0xc8, 0xd1, 0x78, 0x40, 0x03,
+	0x89, 0x9f, 0xc3, 0xb4, 0x2b, 0xa8, 0x2c, 0xf1, 0xb4, 0x67, 0xfd, 0xbd, 0x3c, 0xfd, 0x3d, 0x7d,
+	0xa0, 0x45, 0xc5, 0x88, 0x26, 0x51, 0x18, 0x0b, 0x9b, 0xa6, 0x91, 0x9d, 0xb6, 0xed, 0x80, 0xc6,
+	0x94, 0x87, 0x1c, 0x4f, 0x12, 0x26, 0x18, 0xda, 0xdf, 0xe0, 0x98, 0xa6, 0x11, 0x4e, 0xdb, 0xb5,
+	0x5a, 0x4e, 0x21, 0x01, 0xc5, 0xae, 0x1d, 0x05, 0x2c, 0x60, 0xaa, 0xb4, 0x65, 0x95, 0x9d, 0x36,
+	0x3f, 0x01, 0xdc, 0xb9, 0xce, 0x5c, 0x7b, 0x82, 0x08, 0x8a, 0x1c, 0xf8, 0x9f, 0x78, 0x1e, 0x9b,
+	0xc6, 0x82, 0x9b, 0xa0, 0x51, 0x68, 0x55, 0x3b, 0x0d, 0xbc, 0xdd, 0x07, 0x6b, 0xc5, 0x55, 0x46,
+	0x74, 0x8a, 0xb3, 0x45, 0xdd, 0x70, 0x37, 0x3a, 0x74, 0x01, 0x4b, 0x13, 0x92, 0x90, 0x88, 0x9b,
+	0xff, 0x1a, 0xa0, 0x55, 0xed, 0x98, 0x79, 0x87, 0x5b, 0x85, 0x6b, 0xa5, 0x66, 0xa3, 0x3e, 0x3c,
+	0x1c, 0x26, 0xc4, 0x13, 0x21, 0x8b, 0xc9, 0xf8, 0x6e, 0x40, 0xc6, 0x24, 0xf6, 0x28, 0x37, 0x0b,
+	0xea, 0x1a, 0x27, 0x79, 0x93, 0xee, 0x86, 0xec, 0x64, 0x5c, 0xed, 0x87, 0x86, 0xdb, 0x00, 0x6f,
+	0x4e, 0xe1, 0x41, 0x8e, 0x8e, 0x4c, 0x58, 0x26, 0xbe, 0x9f, 0x50, 0x2e, 0xb3, 0x82, 0x56, 0xc5,
+	0x5d, 0xff, 0xa2, 0x2e, 0x2c, 0x91, 0x48, 0xa6, 0x51, 0x11, 0x2a, 0x0e, 0x96, 0xc6, 0x6f, 0x8b,
+	0xfa, 0x69, 0x10, 0x8a, 0xd1, 0x74, 0x80, 0x3d, 0x16, 0xd9, 0x1e, 0xe3, 0x11, 0xe3, 0xfa, 0x73,
+	0xc6, 0xfd, 0x7b, 0x5b, 0x3c, 0x4e, 0x28, 0xc7, 0x37, 0xb1, 0x70, 0xb5, 0xba, 0xf9, 0x04, 0xe0,
+	0xee, 0xef, 0x69, 0xfd, 0xd1, 0x14, 0xc1, 0xa2, 0xc7, 0x7c, 0x9a, 0xb5, 0x74, 0x55, 0x8d, 0x1c,
+	0x58, 0xe6, 0x82, 0x25, 0x24, 0xa0, 0x7a, 0x0e, 0xc7, 0xf9, 0x39, 0xa8, 0x97, 0x73, 0xf6, 0xe4,
+	0x15, 0x5f, 0xde, 0xeb, 0xe5, 0x5e, 0xc6, 0x77, 0xd7, 0x42, 0xe7, 0x72, 0xb6, 0xb4, 0xc0, 0x7c,
+	0x69, 0x81, 0x8f, 0xa5, 0x05, 0x9e, 0x57, 0x96, 0x31, 0x5f, 0x59, 0xc6, 0xeb, 0xca, 0x32, 0xfa,
+	0x3f, 0xe3, 0xd0, 0x54, 0xa6, 0xf9, 0xde, 0xa0, 0x07, 0x79, 0x92, 0x45, 0x1a, 0x94, 0xd4, 0xb6,
+	0x9c, 0x7f, 0x0d, 0x00, 0x1e, 0xf6, 0x5c, 0xba, 0x93, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -194,6 +261,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FractionalBalances) > 0 {
+		for iNdEx := len(m.FractionalBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FractionalBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -221,6 +302,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FractionalBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FractionalBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FractionalBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -297,6 +418,27 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FractionalBalances) > 0 {
+		for _, e := range m.FractionalBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FractionalBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -425,6 +567,156 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FractionalBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FractionalBalances = append(m.FractionalBalances, FractionalBalance{})
+			if err := m.FractionalBalances[len(m.FractionalBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FractionalBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FractionalBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FractionalBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"encoding/json"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
@@ -82,6 +84,12 @@ func (suite *GenesisTestSuite) TestValidateGenesisAccount() {
 	}
 }
 
+func sixDecimalsParams() Params {
+	params := DefaultParams()
+	params.EvmDenomDecimals = 6
+	return params
+}
+
 func (suite *GenesisTestSuite) TestValidateGenesis() {
 	testCases := []struct {
 		name     string
@@ -211,6 +219,57 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid fractional balances",
+			genState: &GenesisState{
+				Params: sixDecimalsParams(),
+				FractionalBalances: []FractionalBalance{
+					{Address: suite.address, Amount: sdkmath.NewInt(999_999_999_999)},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "fractional balance greater than the conversion factor",
+			genState: &GenesisState{
+				Params: sixDecimalsParams(),
+				FractionalBalances: []FractionalBalance{
+					{Address: suite.address, Amount: sdkmath.NewInt(1_000_000_000_000)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "fractional balance with 18 decimals",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				FractionalBalances: []FractionalBalance{
+					{Address: suite.address, Amount: sdkmath.NewInt(1)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "zero fractional balance",
+			genState: &GenesisState{
+				Params: sixDecimalsParams(),
+				FractionalBalances: []FractionalBalance{
+					{Address: suite.address, Amount: sdkmath.ZeroInt()},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicated fractional balance",
+			genState: &GenesisState{
+				Params: sixDecimalsParams(),
+				FractionalBalances: []FractionalBalance{
+					{Address: suite.address, Amount: sdkmath.NewInt(1)},
+					{Address: suite.address, Amount: sdkmath.NewInt(2)},
+				},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -223,3 +282,22 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		}
 	}
 }
+
+func (suite *GenesisTestSuite) TestValidateGenesisWithoutEVMDenomDecimals() {
+	bz, err := json.Marshal(DefaultGenesisState())
+	suite.Require().NoError(err)
+	var raw map[string]json.RawMessage
+	suite.Require().NoError(json.Unmarshal(bz, &raw))
+	var params map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(raw["params"], &params))
+	delete(params, "evm_denom_decimals")
+	raw["params"], err = json.Marshal(params)
+	suite.Require().NoError(err)
+	bz, err = json.Marshal(raw)
+	suite.Require().NoError(err)
+
+	var genState GenesisState
+	suite.Require().NoError(json.Unmarshal(bz, &genState))
+	suite.Require().Zero(genState.Params.EvmDenomDecimals)
+	suite.Require().ErrorContains(genState.Validate(), "EVM denom decimals cannot be 0")
+}
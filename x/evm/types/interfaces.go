@@ -49,6 +49,7 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 }
 
 // StakingKeeper returns the historical headers kept in store.
@@ -57,11 +58,25 @@ type StakingKeeper interface {
 	GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (validator stakingtypes.Validator, found bool)
 }
 
+// DistributionKeeper defines the expected interface needed to fund the community pool.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// FeeDenomOracle defines the expected interface of the price source used to
+// convert the fees of Ethereum transactions paid with a fee denomination that has
+// no fixed conversion rate.
+type FeeDenomOracle interface {
+	// GetConversionRate returns the amount of denom worth one unit of the EVM denom.
+	GetConversionRate(ctx sdk.Context, denom string) (sdk.Dec, error)
+}
+
 // FeeMarketKeeper
 type FeeMarketKeeper interface {
 	GetBaseFee(ctx sdk.Context) *big.Int
 	GetParams(ctx sdk.Context) feemarkettypes.Params
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
+	AddTransientTxReward(ctx sdk.Context, tip *big.Int, gasUsed uint64)
 }
 
 // Event Hooks
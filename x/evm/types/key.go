@@ -41,6 +41,8 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	prefixFractionalBalance
+	prefixFractionalRemainder
 )
 
 // prefix bytes for the EVM transient store
@@ -49,6 +51,7 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientFeeDenom
 )
 
 // KVStore key prefixes
@@ -56,14 +59,18 @@ var (
 	KeyPrefixCode    = []byte{prefixCode}
 	KeyPrefixStorage = []byte{prefixStorage}
 	KeyPrefixParams  = []byte{prefixParams}
+
+	KeyPrefixFractionalBalance   = []byte{prefixFractionalBalance}
+	KeyPrefixFractionalRemainder = []byte{prefixFractionalRemainder}
 )
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom    = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex  = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize  = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed  = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientFeeDenom = []byte{prefixTransientFeeDenom}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
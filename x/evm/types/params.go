@@ -21,6 +21,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/params"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/evmos/ethermint/types"
@@ -35,6 +36,8 @@ var (
 	DefaultEnableCreate = true
 	// DefaultEnableCall enables contract calls (i.e true)
 	DefaultEnableCall = true
+	// DefaultEVMDenomDecimals is 18, i.e the same precision as the EVM
+	DefaultEVMDenomDecimals = uint32(18)
 )
 
 // AvailableExtraEIPs define the list of all EIPs that can be enabled by the
@@ -53,6 +56,7 @@ func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bo
 		EnableCall:          enableCall,
 		ExtraEIPs:           extraEIPs,
 		ChainConfig:         config,
+		EvmDenomDecimals:    DefaultEVMDenomDecimals,
 	}
 }
 
@@ -66,6 +70,7 @@ func DefaultParams() Params {
 		ChainConfig:         DefaultChainConfig(),
 		ExtraEIPs:           nil,
 		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
+		EvmDenomDecimals:    DefaultEVMDenomDecimals,
 	}
 }
 
@@ -91,6 +96,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateFeeDenoms(p.FeeDenoms, p.EvmDenom); err != nil {
+		return err
+	}
+
+	if err := validateEVMDenomDecimals(p.EvmDenomDecimals); err != nil {
+		return err
+	}
+
 	return validateChainConfig(p.ChainConfig)
 }
 
@@ -103,6 +116,25 @@ func (p Params) EIPs() []int {
 	return eips
 }
 
+// ConversionFactor returns the factor between the 18 decimals amounts used by the EVM
+// and the evm denom amounts held by the bank module.
+func (p Params) ConversionFactor() sdkmath.Int {
+	return sdkmath.NewIntFromBigInt(
+		new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(DefaultEVMDenomDecimals-p.EvmDenomDecimals)), nil),
+	)
+}
+
+// GetFeeDenom returns the whitelisted fee denomination for the given denom. It
+// returns false if the denom cannot be used to pay for the gas of Ethereum transactions.
+func (p Params) GetFeeDenom(denom string) (FeeDenom, bool) {
+	for _, feeDenom := range p.FeeDenoms {
+		if feeDenom.Denom == denom {
+			return feeDenom, true
+		}
+	}
+	return FeeDenom{}, false
+}
+
 func validateEVMDenom(i interface{}) error {
 	denom, ok := i.(string)
 	if !ok {
@@ -135,6 +167,49 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+func validateEVMDenomDecimals(i interface{}) error {
+	decimals, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter EVM denom decimals type: %T", i)
+	}
+
+	// an unset value would otherwise scale the balances by 10^18
+	if decimals == 0 {
+		return fmt.Errorf("EVM denom decimals cannot be 0")
+	}
+
+	if decimals > DefaultEVMDenomDecimals {
+		return fmt.Errorf("EVM denom decimals cannot be greater than %d: %d", DefaultEVMDenomDecimals, decimals)
+	}
+
+	return nil
+}
+
+func validateFeeDenoms(feeDenoms []FeeDenom, evmDenom string) error {
+	seen := make(map[string]bool, len(feeDenoms))
+
+	for _, feeDenom := range feeDenoms {
+		if err := sdk.ValidateDenom(feeDenom.Denom); err != nil {
+			return fmt.Errorf("invalid fee denom: %w", err)
+		}
+
+		if feeDenom.Denom == evmDenom {
+			return fmt.Errorf("fee denom %s cannot be the EVM denom", feeDenom.Denom)
+		}
+
+		if seen[feeDenom.Denom] {
+			return fmt.Errorf("duplicate fee denom %s", feeDenom.Denom)
+		}
+		seen[feeDenom.Denom] = true
+
+		if feeDenom.ConversionRate.IsNil() || feeDenom.ConversionRate.IsNegative() {
+			return fmt.Errorf("fee denom %s conversion rate cannot be nil or negative", feeDenom.Denom)
+		}
+	}
+
+	return nil
+}
+
 func validateChainConfig(i interface{}) error {
 	cfg, ok := i.(ChainConfig)
 	if !ok {
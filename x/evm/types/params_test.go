@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/stretchr/testify/require"
@@ -41,6 +43,75 @@ func TestParamsValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid fee denoms",
+			Params{
+				EvmDenom:         "stake",
+				ChainConfig:      DefaultChainConfig(),
+				EvmDenomDecimals: DefaultEVMDenomDecimals,
+				FeeDenoms: []FeeDenom{
+					{Denom: "uusdc", ConversionRate: sdk.NewDecWithPrec(2, 12)},
+					{Denom: "uatom", ConversionRate: sdk.ZeroDec()},
+				},
+			},
+			false,
+		},
+		{
+			"invalid fee denom equal to evm denom",
+			Params{
+				EvmDenom:    "stake",
+				ChainConfig: DefaultChainConfig(),
+				FeeDenoms:   []FeeDenom{{Denom: "stake", ConversionRate: sdk.OneDec()}},
+			},
+			true,
+		},
+		{
+			"invalid duplicate fee denom",
+			Params{
+				EvmDenom:    "stake",
+				ChainConfig: DefaultChainConfig(),
+				FeeDenoms: []FeeDenom{
+					{Denom: "uusdc", ConversionRate: sdk.OneDec()},
+					{Denom: "uusdc", ConversionRate: sdk.OneDec()},
+				},
+			},
+			true,
+		},
+		{
+			"valid evm denom decimals",
+			Params{
+				EvmDenom:         "stake",
+				ChainConfig:      DefaultChainConfig(),
+				EvmDenomDecimals: 6,
+			},
+			false,
+		},
+		{
+			"invalid evm denom decimals",
+			Params{
+				EvmDenom:         "stake",
+				ChainConfig:      DefaultChainConfig(),
+				EvmDenomDecimals: 19,
+			},
+			true,
+		},
+		{
+			"invalid unset evm denom decimals",
+			Params{
+				EvmDenom:    "stake",
+				ChainConfig: DefaultChainConfig(),
+			},
+			true,
+		},
+		{
+			"invalid negative fee denom conversion rate",
+			Params{
+				EvmDenom:    "stake",
+				ChainConfig: DefaultChainConfig(),
+				FeeDenoms:   []FeeDenom{{Denom: "uusdc", ConversionRate: sdk.NewDec(-1)}},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -62,6 +133,26 @@ func TestParamsEIPs(t *testing.T) {
 	require.Equal(t, []int([]int{2929, 1884, 1344}), actual)
 }
 
+func TestParamsGetFeeDenom(t *testing.T) {
+	params := DefaultParams()
+	params.FeeDenoms = []FeeDenom{{Denom: "uusdc", ConversionRate: sdk.OneDec()}}
+
+	feeDenom, found := params.GetFeeDenom("uusdc")
+	require.True(t, found)
+	require.Equal(t, "uusdc", feeDenom.Denom)
+
+	_, found = params.GetFeeDenom(params.EvmDenom)
+	require.False(t, found)
+}
+
+func TestParamsConversionFactor(t *testing.T) {
+	params := DefaultParams()
+	require.Equal(t, sdkmath.OneInt(), params.ConversionFactor())
+
+	params.EvmDenomDecimals = 6
+	require.Equal(t, sdkmath.NewInt(1_000_000_000_000), params.ConversionFactor())
+}
+
 func TestParamsValidatePriv(t *testing.T) {
 	require.Error(t, validateEVMDenom(false))
 	require.NoError(t, validateEVMDenom("inj"))
@@ -69,6 +160,9 @@ func TestParamsValidatePriv(t *testing.T) {
 	require.NoError(t, validateBool(true))
 	require.Error(t, validateEIPs(""))
 	require.NoError(t, validateEIPs([]int64{1884}))
+	require.Error(t, validateEVMDenomDecimals(int64(6)))
+	require.NoError(t, validateEVMDenomDecimals(uint32(6)))
+	require.Error(t, validateEVMDenomDecimals(uint32(0)))
 }
 
 func TestValidateChainConfig(t *testing.T) {
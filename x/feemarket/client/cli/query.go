@@ -16,6 +16,9 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -38,6 +41,7 @@ func GetQueryCmd() *cobra.Command {
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
 		GetParamsCmd(),
+		GetFeeHistoryCmd(),
 	)
 	return cmd
 }
@@ -129,3 +133,48 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetFeeHistoryCmd queries the on-chain fee history
+func GetFeeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-history BLOCK_COUNT [LAST_BLOCK]",
+		Short: "Get the fee history of the most recent blocks",
+		Long: `Get the base fee, gas used and effective gas tips of the most recent blocks stored in the on-chain fee history.
+If the last block is not provided, it will use the latest height.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blockCount, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid block count %s: %w", args[0], err)
+			}
+
+			var lastBlock int64
+			if len(args) == 2 {
+				lastBlock, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid last block %s: %w", args[1], err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeHistory(cmd.Context(), &types.QueryFeeHistoryRequest{
+				BlockCount: blockCount,
+				LastBlock:  lastBlock,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -49,7 +49,7 @@ func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	})
 }
 
-// EndBlock update block gas wanted.
+// EndBlock update block gas wanted and block gas used.
 // The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) {
@@ -65,10 +65,17 @@ func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) {
 	// gasWanted = max(gasWanted * MinGasMultiplier, gasUsed)
 	// this will be keep BaseFee protected from un-penalized manipulation
 	// more info here https://github.com/evmos/ethermint/pull/1105#discussion_r888798925
-	minGasMultiplier := k.GetParams(ctx).MinGasMultiplier
+	params := k.GetParams(ctx)
+	minGasMultiplier := params.MinGasMultiplier
 	limitedGasWanted := sdk.NewDec(int64(gasWanted)).Mul(minGasMultiplier)
 	gasWanted = sdk.MaxDec(limitedGasWanted, sdk.NewDec(int64(gasUsed))).TruncateInt().Uint64()
 	k.SetBlockGasWanted(ctx, gasWanted)
+	k.SetBlockGasUsed(ctx, gasUsed)
+
+	if params.FeeHistorySize > 0 {
+		k.SetFeeHistoryEntry(ctx, k.newFeeHistoryEntry(ctx, gasUsed))
+	}
+	k.PruneFeeHistory(ctx, ctx.BlockHeight(), params.FeeHistorySize)
 
 	defer func() {
 		telemetry.SetGauge(float32(gasWanted), "feemarket", "block_gas")
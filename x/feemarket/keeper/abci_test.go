@@ -13,12 +13,14 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 		NoBaseFee    bool
 		malleate     func()
 		expGasWanted uint64
+		expGasUsed   uint64
 	}{
 		{
 			"baseFee nil",
 			true,
 			func() {},
 			uint64(0),
+			uint64(0),
 		},
 		{
 			"pass",
@@ -29,6 +31,19 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 				suite.app.FeeMarketKeeper.SetTransientBlockGasWanted(suite.ctx, 5000000)
 			},
 			uint64(2500000),
+			uint64(0),
+		},
+		{
+			"pass - gas used",
+			false,
+			func() {
+				meter := sdk.NewGasMeter(uint64(1000000000))
+				meter.ConsumeGas(3000000, "test")
+				suite.ctx = suite.ctx.WithBlockGasMeter(meter)
+				suite.app.FeeMarketKeeper.SetTransientBlockGasWanted(suite.ctx, 5000000)
+			},
+			uint64(3000000),
+			uint64(3000000),
 		},
 	}
 	for _, tc := range testCases {
@@ -42,6 +57,8 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 			suite.app.FeeMarketKeeper.EndBlock(suite.ctx, types.RequestEndBlock{Height: 1})
 			gasWanted := suite.app.FeeMarketKeeper.GetBlockGasWanted(suite.ctx)
 			suite.Require().Equal(tc.expGasWanted, gasWanted, tc.name)
+			gasUsed := suite.app.FeeMarketKeeper.GetBlockGasUsed(suite.ctx)
+			suite.Require().Equal(tc.expGasUsed, gasUsed, tc.name)
 		})
 	}
 }
@@ -19,6 +19,7 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/x/feemarket/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
@@ -42,7 +43,7 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	// defined in the parameters (DefaultBaseFee if it hasn't been changed by
 	// governance).
 	if ctx.BlockHeight() == params.EnableHeight {
-		return params.BaseFee.BigInt()
+		return boundBaseFee(params, params.BaseFee.BigInt())
 	}
 
 	// get the block gas used and the base fee values for the parent block.
@@ -54,7 +55,14 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 		return nil
 	}
 
-	parentGasUsed := k.GetBlockGasWanted(ctx)
+	// The parent gas is either the gas used by the parent block or its gas
+	// wanted, bounded by the MinGasMultiplier during EndBlock.
+	var parentGasUsed uint64
+	if params.BaseFeeFromGasUsed {
+		parentGasUsed = k.GetBlockGasUsed(ctx)
+	} else {
+		parentGasUsed = k.GetBlockGasWanted(ctx)
+	}
 
 	gasLimit := new(big.Int).SetUint64(math.MaxUint64)
 
@@ -76,7 +84,7 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	// If the parent gasUsed is the same as the target, the baseFee remains
 	// unchanged.
 	if parentGasUsed == parentGasTarget {
-		return new(big.Int).Set(parentBaseFee)
+		return boundBaseFee(params, new(big.Int).Set(parentBaseFee))
 	}
 
 	if parentGasUsed > parentGasTarget {
@@ -90,7 +98,7 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 			common.Big1,
 		)
 
-		return x.Add(parentBaseFee, baseFeeDelta)
+		return boundBaseFee(params, x.Add(parentBaseFee, baseFeeDelta))
 	}
 
 	// Otherwise if the parent block used less gas than its target, the baseFee
@@ -100,8 +108,20 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	y := x.Div(x, parentGasTargetBig)
 	baseFeeDelta := x.Div(y, baseFeeChangeDenominator)
 
-	// Set global min gas price as lower bound of the base fee, transactions below
-	// the min gas price don't even reach the mempool.
-	minGasPrice := params.MinGasPrice.TruncateInt().BigInt()
-	return math.BigMax(x.Sub(parentBaseFee, baseFeeDelta), minGasPrice)
+	return boundBaseFee(params, x.Sub(parentBaseFee, baseFeeDelta))
+}
+
+// boundBaseFee restricts the base fee to the range defined by the parameters. The
+// global min gas price is part of the lower bound, as transactions below it don't
+// even reach the mempool.
+func boundBaseFee(params types.Params, baseFee *big.Int) *big.Int {
+	if floor := params.BaseFeeFloor(); floor != nil {
+		baseFee = math.BigMax(baseFee, floor)
+	}
+
+	if ceiling := params.BaseFeeCeiling(); ceiling != nil {
+		baseFee = math.BigMin(baseFee, ceiling)
+	}
+
+	return baseFee
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -33,6 +34,14 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 			sdk.ZeroDec(),
 			suite.app.FeeMarketKeeper.GetParams(suite.ctx).BaseFee.BigInt(),
 		},
+		{
+			"with BaseFee - initial EIP-1559 block, with higher min gas price",
+			false,
+			0,
+			0,
+			sdk.NewDec(1500000000),
+			big.NewInt(1500000000),
+		},
 		{
 			"with BaseFee - parent block wanted the same gas as its target (ElasticityMultiplier = 2)",
 			false,
@@ -47,7 +56,7 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 			1,
 			50,
 			sdk.NewDec(1500000000),
-			suite.app.FeeMarketKeeper.GetParams(suite.ctx).BaseFee.BigInt(),
+			big.NewInt(1500000000),
 		},
 		{
 			"with BaseFee - parent block wanted more gas than its target (ElasticityMultiplier = 2)",
@@ -63,7 +72,7 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 			1,
 			100,
 			sdk.NewDec(1500000000),
-			big.NewInt(1125000000),
+			big.NewInt(1500000000),
 		},
 		{
 			"with BaseFee - Parent gas wanted smaller than parent gas target (ElasticityMultiplier = 2)",
@@ -114,3 +123,98 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCalculateBaseFeeGasUsedAndBounds() {
+	testCases := []struct {
+		name                 string
+		baseFeeFromGasUsed   bool
+		parentBlockGasWanted uint64
+		parentBlockGasUsed   uint64
+		minBaseFee           sdkmath.Int
+		maxBaseFee           sdkmath.Int
+		expFee               *big.Int
+	}{
+		{
+			"gas wanted - parent block wanted more gas than its target",
+			false,
+			100,
+			0,
+			sdkmath.ZeroInt(),
+			sdkmath.ZeroInt(),
+			big.NewInt(1125000000),
+		},
+		{
+			"gas used - parent block used less gas than its target",
+			true,
+			100,
+			25,
+			sdkmath.ZeroInt(),
+			sdkmath.ZeroInt(),
+			big.NewInt(937500000),
+		},
+		{
+			"gas used - parent block used more gas than its target",
+			true,
+			0,
+			100,
+			sdkmath.ZeroInt(),
+			sdkmath.ZeroInt(),
+			big.NewInt(1125000000),
+		},
+		{
+			"gas used - empty parent block, with min base fee",
+			true,
+			100,
+			0,
+			sdkmath.NewInt(950000000),
+			sdkmath.ZeroInt(),
+			big.NewInt(950000000),
+		},
+		{
+			"gas wanted - parent block wanted the same gas as its target, with higher min base fee",
+			false,
+			50,
+			0,
+			sdkmath.NewInt(1500000000),
+			sdkmath.ZeroInt(),
+			big.NewInt(1500000000),
+		},
+		{
+			"gas wanted - parent block wanted more gas than its target, with max base fee",
+			false,
+			100,
+			0,
+			sdkmath.ZeroInt(),
+			sdkmath.NewInt(1100000000),
+			big.NewInt(1100000000),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.BaseFeeFromGasUsed = tc.baseFeeFromGasUsed
+			params.MinBaseFee = tc.minBaseFee
+			params.MaxBaseFee = tc.maxBaseFee
+			suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+
+			suite.ctx = suite.ctx.WithBlockHeight(1)
+
+			// Set parent block gas
+			suite.app.FeeMarketKeeper.SetBlockGasWanted(suite.ctx, tc.parentBlockGasWanted)
+			suite.app.FeeMarketKeeper.SetBlockGasUsed(suite.ctx, tc.parentBlockGasUsed)
+
+			// Set next block target/gasLimit through Consensus Param MaxGas
+			blockParams := abci.BlockParams{
+				MaxGas:   100,
+				MaxBytes: 10,
+			}
+			consParams := abci.ConsensusParams{Block: &blockParams}
+			suite.ctx = suite.ctx.WithConsensusParams(&consParams)
+
+			fee := suite.app.FeeMarketKeeper.CalculateBaseFee(suite.ctx)
+			suite.Require().Equal(tc.expFee, fee, tc.name)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/feemarket/types"
+)
+
+// ----------------------------------------------------------------------------
+// Fee History
+// Bounded history of the per-block fee market data, used by the JSON-RPC
+// eth_feeHistory and eth_maxPriorityFeePerGas endpoints.
+// ----------------------------------------------------------------------------
+
+// AddTransientTxReward adds the gas used by an Ethereum transaction that paid the given
+// effective gas tip to the transient store. Transactions paying the same tip are
+// aggregated, and the entries are sorted by ascending tip as the tip is used as key.
+func (k Keeper) AddTransientTxReward(ctx sdk.Context, tip *big.Int, gasUsed uint64) {
+	if tip == nil || tip.Sign() < 0 {
+		tip = common.Big0
+	}
+
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientTxReward)
+	key := common.BigToHash(tip).Bytes()
+
+	if bz := store.Get(key); len(bz) > 0 {
+		gasUsed += sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set(key, sdk.Uint64ToBigEndian(gasUsed))
+}
+
+// GetTransientTxRewards returns the effective gas tips paid by the Ethereum transactions
+// of the current block, sorted by ascending tip.
+func (k Keeper) GetTransientTxRewards(ctx sdk.Context) []types.TxReward {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientTxReward)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var rewards []types.TxReward
+	for ; iterator.Valid(); iterator.Next() {
+		rewards = append(rewards, types.TxReward{
+			Tip:     sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(iterator.Key())),
+			GasUsed: sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+
+	return rewards
+}
+
+// newFeeHistoryEntry returns the fee history entry of the current block.
+func (k Keeper) newFeeHistoryEntry(ctx sdk.Context, gasUsed uint64) types.FeeHistoryEntry {
+	baseFee := sdkmath.ZeroInt()
+	if fee := k.GetBaseFee(ctx); fee != nil && k.GetBaseFeeEnabled(ctx) {
+		baseFee = sdkmath.NewIntFromBigInt(fee)
+	}
+
+	// NOTE: a MaxGas equal to -1 means that block gas is unlimited, use the same
+	// max uint32 value as the JSON-RPC block gas limit in this case.
+	gasLimit := uint64(math.MaxUint32)
+	if consParams := ctx.ConsensusParams(); consParams != nil && consParams.Block != nil && consParams.Block.MaxGas > -1 {
+		gasLimit = uint64(consParams.Block.MaxGas)
+	}
+
+	return types.FeeHistoryEntry{
+		Height:   ctx.BlockHeight(),
+		BaseFee:  baseFee,
+		GasUsed:  gasUsed,
+		GasLimit: gasLimit,
+		Rewards:  k.GetTransientTxRewards(ctx),
+	}
+}
+
+// SetFeeHistoryEntry stores the fee history entry of a block.
+func (k Keeper) SetFeeHistoryEntry(ctx sdk.Context, entry types.FeeHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&entry)
+	store.Set(types.FeeHistoryKey(entry.Height), bz)
+}
+
+// GetFeeHistoryEntry returns the fee history entry of the block at the given height.
+func (k Keeper) GetFeeHistoryEntry(ctx sdk.Context, height int64) (types.FeeHistoryEntry, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeeHistoryKey(height))
+	if len(bz) == 0 {
+		return types.FeeHistoryEntry{}, false
+	}
+
+	var entry types.FeeHistoryEntry
+	k.cdc.MustUnmarshal(bz, &entry)
+	return entry, true
+}
+
+// GetFeeHistory returns the stored fee history entries of at most blockCount blocks
+// up to and including lastBlock, sorted by ascending height.
+func (k Keeper) GetFeeHistory(ctx sdk.Context, lastBlock int64, blockCount uint64) []types.FeeHistoryEntry {
+	if blockCount == 0 || lastBlock < 0 {
+		return nil
+	}
+
+	start := lastBlock + 1 - int64(blockCount)
+	if start < 0 || start > lastBlock {
+		start = 0
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.FeeHistoryKey(start), types.FeeHistoryKey(lastBlock+1))
+	defer iterator.Close()
+
+	var entries []types.FeeHistoryEntry
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.FeeHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// PruneFeeHistory deletes the fee history entries that are older than the last size
+// blocks, up to and including the given height.
+func (k Keeper) PruneFeeHistory(ctx sdk.Context, height int64, size uint32) {
+	end := height - int64(size) + 1
+	if end <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.FeeHistoryKey(0), types.FeeHistoryKey(end))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/evmos/ethermint/x/feemarket/types"
+)
+
+func (suite *KeeperTestSuite) TestTransientTxRewards() {
+	suite.SetupTest()
+
+	suite.app.FeeMarketKeeper.AddTransientTxReward(suite.ctx, big.NewInt(300), 21000)
+	suite.app.FeeMarketKeeper.AddTransientTxReward(suite.ctx, big.NewInt(2), 50000)
+	suite.app.FeeMarketKeeper.AddTransientTxReward(suite.ctx, big.NewInt(300), 1000)
+	suite.app.FeeMarketKeeper.AddTransientTxReward(suite.ctx, big.NewInt(-1), 1000)
+	suite.app.FeeMarketKeeper.AddTransientTxReward(suite.ctx, nil, 1000)
+
+	expRewards := []types.TxReward{
+		{Tip: sdkmath.ZeroInt(), GasUsed: 2000},
+		{Tip: sdkmath.NewInt(2), GasUsed: 50000},
+		{Tip: sdkmath.NewInt(300), GasUsed: 22000},
+	}
+
+	rewards := suite.app.FeeMarketKeeper.GetTransientTxRewards(suite.ctx)
+	suite.Require().Len(rewards, len(expRewards))
+	for i, reward := range rewards {
+		suite.Require().True(expRewards[i].Tip.Equal(reward.Tip))
+		suite.Require().Equal(expRewards[i].GasUsed, reward.GasUsed)
+	}
+}
+
+func (suite *KeeperTestSuite) TestFeeHistoryEndBlock() {
+	testCases := []struct {
+		name       string
+		size       uint32
+		blocks     int64
+		expHeights []int64
+	}{
+		{
+			"disabled",
+			0,
+			5,
+			nil,
+		},
+		{
+			"less blocks than the history size",
+			10,
+			5,
+			[]int64{1, 2, 3, 4, 5},
+		},
+		{
+			"prune the oldest blocks",
+			3,
+			5,
+			[]int64{3, 4, 5},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.FeeHistorySize = tc.size
+			suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+
+			for height := int64(1); height <= tc.blocks; height++ {
+				ctx := suite.ctx.WithBlockHeight(height).WithBlockGasMeter(sdk.NewGasMeter(1000000))
+				ctx.BlockGasMeter().ConsumeGas(21000, "test")
+				suite.app.FeeMarketKeeper.AddTransientTxReward(ctx, big.NewInt(height), 21000)
+				suite.app.FeeMarketKeeper.EndBlock(ctx, abci.RequestEndBlock{Height: height})
+			}
+
+			entries := suite.app.FeeMarketKeeper.GetFeeHistory(suite.ctx, tc.blocks, uint64(tc.blocks))
+			suite.Require().Len(entries, len(tc.expHeights))
+			for i, entry := range entries {
+				suite.Require().Equal(tc.expHeights[i], entry.Height)
+				suite.Require().Equal(uint64(21000), entry.GasUsed)
+				suite.Require().NotEmpty(entry.Rewards)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetFeeHistory() {
+	suite.SetupTest()
+
+	for height := int64(1); height <= 5; height++ {
+		suite.app.FeeMarketKeeper.SetFeeHistoryEntry(suite.ctx, types.FeeHistoryEntry{
+			Height:  height,
+			BaseFee: sdkmath.NewInt(height),
+		})
+	}
+
+	testCases := []struct {
+		name       string
+		lastBlock  int64
+		blockCount uint64
+		expHeights []int64
+	}{
+		{"zero block count", 5, 0, nil},
+		{"latest blocks", 5, 2, []int64{4, 5}},
+		{"block count higher than the stored history", 3, 10, []int64{1, 2, 3}},
+		{"last block not stored yet", 6, 2, []int64{5}},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			entries := suite.app.FeeMarketKeeper.GetFeeHistory(suite.ctx, tc.lastBlock, tc.blockCount)
+
+			var heights []int64
+			for _, entry := range entries {
+				heights = append(heights, entry.Height)
+			}
+			suite.Require().Equal(tc.expHeights, heights)
+		})
+	}
+}
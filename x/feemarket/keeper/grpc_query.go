@@ -20,6 +20,8 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/evmos/ethermint/x/feemarket/types"
 )
@@ -60,3 +62,28 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: int64(gas),
 	}, nil
 }
+
+// FeeHistory implements the Query/FeeHistory gRPC method
+func (k Keeper) FeeHistory(c context.Context, req *types.QueryFeeHistoryRequest) (*types.QueryFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	lastBlock := req.LastBlock
+	if lastBlock <= 0 {
+		lastBlock = ctx.BlockHeight()
+	}
+
+	// only the entries of the last FeeHistorySize blocks are kept, the extra one allows
+	// querying up to the block after the newest one for its base fee
+	blockCount := req.BlockCount
+	if maxBlockCount := uint64(k.GetParams(ctx).FeeHistorySize) + 1; blockCount > maxBlockCount {
+		blockCount = maxBlockCount
+	}
+
+	return &types.QueryFeeHistoryResponse{
+		Entries: k.GetFeeHistory(ctx, lastBlock, blockCount),
+	}, nil
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"math"
+
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethparams "github.com/ethereum/go-ethereum/params"
@@ -99,3 +101,31 @@ func (suite *KeeperTestSuite) TestQueryBlockGas() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryFeeHistory() {
+	entry := types.FeeHistoryEntry{
+		Height:   suite.ctx.BlockHeight(),
+		BaseFee:  sdkmath.NewInt(ethparams.InitialBaseFee),
+		GasUsed:  21000,
+		GasLimit: 100000,
+		Rewards:  []types.TxReward{{Tip: sdkmath.OneInt(), GasUsed: 21000}},
+	}
+	suite.app.FeeMarketKeeper.SetFeeHistoryEntry(suite.ctx, entry)
+
+	res, err := suite.queryClient.FeeHistory(suite.ctx.Context(), &types.QueryFeeHistoryRequest{BlockCount: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.FeeHistoryEntry{entry}, res.Entries)
+
+	// the block count is bounded by the fee history size
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.FeeHistorySize = 1
+	suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+	older, newer := entry, entry
+	older.Height, newer.Height = 10, 12
+	suite.app.FeeMarketKeeper.SetFeeHistoryEntry(suite.ctx, older)
+	suite.app.FeeMarketKeeper.SetFeeHistoryEntry(suite.ctx, newer)
+
+	res, err = suite.queryClient.FeeHistory(suite.ctx.Context(), &types.QueryFeeHistoryRequest{BlockCount: math.MaxUint64, LastBlock: 12})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.FeeHistoryEntry{newer}, res.Entries)
+}
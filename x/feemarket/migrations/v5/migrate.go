@@ -0,0 +1,46 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/x/feemarket/types"
+)
+
+// MigrateStore migrates the x/feemarket module state from the consensus version 4 to
+// version 5. Specifically, it sets the default values of the base fee bounds, the
+// gas used accounting flag, the fee history size and the fee distribution settings
+// that were added to the module parameters.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	var (
+		store  = ctx.KVStore(storeKey)
+		params types.Params
+	)
+
+	paramsBz := store.Get(types.ParamsKey)
+	cdc.MustUnmarshal(paramsBz, &params)
+
+	params.BaseFeeFromGasUsed = types.DefaultBaseFeeFromGasUsed
+	params.MinBaseFee = types.DefaultMinBaseFee
+	params.MaxBaseFee = types.DefaultMaxBaseFee
+	params.FeeHistorySize = types.DefaultFeeHistorySize
+	params.BaseFeeDestination = types.DefaultBaseFeeDestination
+	params.TipToProposer = types.DefaultTipToProposer
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/app"
+	"github.com/evmos/ethermint/encoding"
+	v5 "github.com/evmos/ethermint/x/feemarket/migrations/v5"
+	"github.com/evmos/ethermint/x/feemarket/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	// store the params in the v4 format, without the fields added in v5
+	defaultParams := types.DefaultParams()
+	v4Params := types.Params{
+		NoBaseFee:                defaultParams.NoBaseFee,
+		BaseFeeChangeDenominator: defaultParams.BaseFeeChangeDenominator,
+		ElasticityMultiplier:     defaultParams.ElasticityMultiplier,
+		EnableHeight:             defaultParams.EnableHeight,
+		BaseFee:                  defaultParams.BaseFee,
+		MinGasPrice:              sdk.NewDec(10),
+		MinGasMultiplier:         defaultParams.MinGasMultiplier,
+	}
+	kvStore.Set(types.ParamsKey, cdc.MustMarshal(&v4Params))
+
+	require.NoError(t, v5.MigrateStore(ctx, storeKey, cdc))
+
+	paramsBz := kvStore.Get(types.ParamsKey)
+	var params types.Params
+	cdc.MustUnmarshal(paramsBz, &params)
+
+	// existing params are preserved
+	require.Equal(t, v4Params.BaseFee, params.BaseFee)
+	require.Equal(t, v4Params.MinGasPrice, params.MinGasPrice)
+	require.Equal(t, v4Params.MinGasMultiplier, params.MinGasMultiplier)
+
+	// new params are set to their default values
+	require.Equal(t, types.DefaultBaseFeeFromGasUsed, params.BaseFeeFromGasUsed)
+	require.Equal(t, types.DefaultMinBaseFee, params.MinBaseFee)
+	require.Equal(t, types.DefaultMaxBaseFee, params.MaxBaseFee)
+	require.Equal(t, types.DefaultFeeHistorySize, params.FeeHistorySize)
+	require.Equal(t, types.DefaultBaseFeeDestination, params.BaseFeeDestination)
+	require.Equal(t, types.DefaultTipToProposer, params.TipToProposer)
+	require.NoError(t, params.Validate())
+}
@@ -0,0 +1,59 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"math/big"
+)
+
+// GasUsedRatio returns the ratio between the gas used and the gas limit of the
+// block. It returns 0 if the block gas limit is 0.
+func (e FeeHistoryEntry) GasUsedRatio() float64 {
+	if e.GasLimit == 0 {
+		return 0
+	}
+	return float64(e.GasUsed) / float64(e.GasLimit)
+}
+
+// RewardPercentiles returns the effective gas tips at the given percentiles of
+// the gas used by the block, as defined by the eth_feeHistory specification.
+// The percentiles must be sorted in ascending order. All the returned rewards
+// are zero if the block doesn't contain Ethereum transactions.
+func (e FeeHistoryEntry) RewardPercentiles(percentiles []float64) []*big.Int {
+	rewards := make([]*big.Int, len(percentiles))
+	for i := range rewards {
+		rewards[i] = big.NewInt(0)
+	}
+
+	// return an all zero row if there are no transactions to gather data from
+	if len(e.Rewards) == 0 {
+		return rewards
+	}
+
+	var txIndex int
+	sumGasUsed := e.Rewards[0].GasUsed
+
+	for i, p := range percentiles {
+		thresholdGasUsed := uint64(float64(e.GasUsed) * p / 100)
+		for sumGasUsed < thresholdGasUsed && txIndex < len(e.Rewards)-1 {
+			txIndex++
+			sumGasUsed += e.Rewards[txIndex].GasUsed
+		}
+		rewards[i] = e.Rewards[txIndex].Tip.BigInt()
+	}
+
+	return rewards
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeHistoryEntryGasUsedRatio(t *testing.T) {
+	require.Equal(t, float64(0), FeeHistoryEntry{GasUsed: 100}.GasUsedRatio())
+	require.Equal(t, 0.25, FeeHistoryEntry{GasUsed: 100, GasLimit: 400}.GasUsedRatio())
+}
+
+func TestFeeHistoryEntryRewardPercentiles(t *testing.T) {
+	testCases := []struct {
+		name        string
+		entry       FeeHistoryEntry
+		percentiles []float64
+		expRewards  []*big.Int
+	}{
+		{
+			"no transactions",
+			FeeHistoryEntry{GasUsed: 0},
+			[]float64{25, 50},
+			[]*big.Int{big.NewInt(0), big.NewInt(0)},
+		},
+		{
+			"single transaction",
+			FeeHistoryEntry{
+				GasUsed: 21000,
+				Rewards: []TxReward{{Tip: sdkmath.NewInt(10), GasUsed: 21000}},
+			},
+			[]float64{0, 50, 100},
+			[]*big.Int{big.NewInt(10), big.NewInt(10), big.NewInt(10)},
+		},
+		{
+			"multiple transactions",
+			FeeHistoryEntry{
+				GasUsed: 100,
+				Rewards: []TxReward{
+					{Tip: sdkmath.NewInt(1), GasUsed: 10},
+					{Tip: sdkmath.NewInt(2), GasUsed: 40},
+					{Tip: sdkmath.NewInt(3), GasUsed: 50},
+				},
+			},
+			[]float64{10, 25, 50, 75, 100},
+			[]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(2), big.NewInt(3), big.NewInt(3)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expRewards, tc.entry.RewardPercentiles(tc.percentiles))
+		})
+	}
+}
@@ -24,6 +24,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BaseFeeDestination defines where the base fee portion of the fees paid by
+// Ethereum transactions is sent.
+type BaseFeeDestination int32
+
+const (
+	// BASE_FEE_DESTINATION_FEE_COLLECTOR leaves the base fee in the fee collector,
+	// to be distributed by x/distribution
+	BaseFeeDestinationFeeCollector BaseFeeDestination = 0
+	// BASE_FEE_DESTINATION_BURN burns the base fee
+	BaseFeeDestinationBurn BaseFeeDestination = 1
+	// BASE_FEE_DESTINATION_COMMUNITY_POOL sends the base fee to the community pool
+	BaseFeeDestinationCommunityPool BaseFeeDestination = 2
+)
+
+var BaseFeeDestination_name = map[int32]string{
+	0: "BASE_FEE_DESTINATION_FEE_COLLECTOR",
+	1: "BASE_FEE_DESTINATION_BURN",
+	2: "BASE_FEE_DESTINATION_COMMUNITY_POOL",
+}
+
+var BaseFeeDestination_value = map[string]int32{
+	"BASE_FEE_DESTINATION_FEE_COLLECTOR":  0,
+	"BASE_FEE_DESTINATION_BURN":           1,
+	"BASE_FEE_DESTINATION_COMMUNITY_POOL": 2,
+}
+
+func (x BaseFeeDestination) String() string {
+	return proto.EnumName(BaseFeeDestination_name, int32(x))
+}
+
+func (BaseFeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{0}
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -43,6 +77,25 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_multiplier"`
+	// base_fee_from_gas_used computes the EIP-1559 base fee from the gas used
+	// by the parent block instead of the (multiplier-bounded) gas wanted
+	BaseFeeFromGasUsed bool `protobuf:"varint,9,opt,name=base_fee_from_gas_used,json=baseFeeFromGasUsed,proto3" json:"base_fee_from_gas_used,omitempty"`
+	// min_base_fee defines a lower bound for the base fee. The effective floor is
+	// the maximum of min_base_fee and min_gas_price.
+	MinBaseFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_base_fee"`
+	// max_base_fee defines an upper bound for the base fee. A zero value means
+	// the base fee is unbounded.
+	MaxBaseFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_base_fee"`
+	// fee_history_size defines the number of blocks kept in the on-chain fee
+	// history. A zero value disables the fee history.
+	FeeHistorySize uint32 `protobuf:"varint,12,opt,name=fee_history_size,json=feeHistorySize,proto3" json:"fee_history_size,omitempty"`
+	// base_fee_destination defines where the base fee portion of the fees paid by
+	// Ethereum transactions is sent
+	BaseFeeDestination BaseFeeDestination `protobuf:"varint,13,opt,name=base_fee_destination,json=baseFeeDestination,proto3,enum=ethermint.feemarket.v1.BaseFeeDestination" json:"base_fee_destination,omitempty"`
+	// tip_to_proposer credits the priority tip portion of the fees paid by
+	// Ethereum transactions to the operator account of the block proposer,
+	// instead of the fee collector
+	TipToProposer bool `protobuf:"varint,14,opt,name=tip_to_proposer,json=tipToProposer,proto3" json:"tip_to_proposer,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -106,8 +159,165 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetBaseFeeFromGasUsed() bool {
+	if m != nil {
+		return m.BaseFeeFromGasUsed
+	}
+	return false
+}
+
+func (m *Params) GetFeeHistorySize() uint32 {
+	if m != nil {
+		return m.FeeHistorySize
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeDestination() BaseFeeDestination {
+	if m != nil {
+		return m.BaseFeeDestination
+	}
+	return BaseFeeDestinationFeeCollector
+}
+
+func (m *Params) GetTipToProposer() bool {
+	if m != nil {
+		return m.TipToProposer
+	}
+	return false
+}
+
+// FeeHistoryEntry defines the fee market data of a single block, stored in the
+// on-chain fee history.
+type FeeHistoryEntry struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee of the block. Zero if the base fee is disabled.
+	BaseFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_fee"`
+	// gas_used is the total gas used by the block
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_limit is the maximum gas of the block
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// rewards defines the effective gas tips paid by the Ethereum transactions of
+	// the block, sorted by ascending tip.
+	Rewards []TxReward `protobuf:"bytes,5,rep,name=rewards,proto3" json:"rewards"`
+}
+
+func (m *FeeHistoryEntry) Reset()         { *m = FeeHistoryEntry{} }
+func (m *FeeHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*FeeHistoryEntry) ProtoMessage()    {}
+func (*FeeHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+func (m *FeeHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeHistoryEntry.Merge(m, src)
+}
+func (m *FeeHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeHistoryEntry proto.InternalMessageInfo
+
+func (m *FeeHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FeeHistoryEntry) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *FeeHistoryEntry) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *FeeHistoryEntry) GetRewards() []TxReward {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// TxReward defines the gas used by the transactions of a block that paid a
+// given effective gas tip.
+type TxReward struct {
+	// tip is the effective gas tip paid per unit of gas
+	Tip github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=tip,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tip"`
+	// gas_used is the total gas used by the transactions that paid the tip
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *TxReward) Reset()         { *m = TxReward{} }
+func (m *TxReward) String() string { return proto.CompactTextString(m) }
+func (*TxReward) ProtoMessage()    {}
+func (*TxReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{2}
+}
+func (m *TxReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxReward.Merge(m, src)
+}
+func (m *TxReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxReward proto.InternalMessageInfo
+
+func (m *TxReward) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("ethermint.feemarket.v1.BaseFeeDestination", BaseFeeDestination_name, BaseFeeDestination_value)
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*FeeHistoryEntry)(nil), "ethermint.feemarket.v1.FeeHistoryEntry")
+	proto.RegisterType((*TxReward)(nil), "ethermint.feemarket.v1.TxReward")
 }
 
 func init() {
@@ -115,32 +325,56 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xc1, 0x8a, 0xdb, 0x30,
-	0x14, 0xb4, 0x9a, 0xdd, 0xac, 0x57, 0xdb, 0x40, 0x10, 0xdb, 0x62, 0x5a, 0xf0, 0x9a, 0x16, 0x16,
-	0x1f, 0x5a, 0x9b, 0x65, 0xcf, 0xbd, 0xa4, 0x21, 0x6d, 0x0a, 0x85, 0xe0, 0x63, 0x29, 0x08, 0xd9,
-	0x79, 0xb1, 0x45, 0x2c, 0xc9, 0x58, 0x4a, 0x68, 0xfe, 0xa2, 0x9f, 0x95, 0x63, 0x4e, 0xa5, 0xf4,
-	0x10, 0x4a, 0xf2, 0x23, 0x25, 0x76, 0x62, 0xe7, 0xda, 0x3d, 0x49, 0x7a, 0x33, 0x9a, 0x79, 0xd2,
-	0x1b, 0x7c, 0x0f, 0x26, 0x83, 0x52, 0x70, 0x69, 0xc2, 0x19, 0x80, 0x60, 0xe5, 0x1c, 0x4c, 0xb8,
-	0x7c, 0x68, 0x0f, 0x41, 0x51, 0x2a, 0xa3, 0xc8, 0xcb, 0x86, 0x17, 0xb4, 0xd0, 0xf2, 0xe1, 0xd5,
-	0x6d, 0xaa, 0x52, 0x55, 0x51, 0xc2, 0xc3, 0xae, 0x66, 0xbf, 0xf9, 0xd5, 0xc1, 0xdd, 0x09, 0x2b,
-	0x99, 0xd0, 0xc4, 0xc5, 0x37, 0x52, 0xd1, 0x98, 0x69, 0xa0, 0x33, 0x00, 0x07, 0x79, 0xc8, 0xb7,
-	0xa3, 0x6b, 0xa9, 0x06, 0x4c, 0xc3, 0x08, 0x80, 0x7c, 0xc0, 0xaf, 0x4f, 0x20, 0x4d, 0x32, 0x26,
-	0x53, 0xa0, 0x53, 0x90, 0x4a, 0x70, 0xc9, 0x8c, 0x2a, 0x9d, 0x67, 0x1e, 0xf2, 0x7b, 0x91, 0x13,
-	0xd7, 0xec, 0x8f, 0x15, 0x61, 0xd8, 0xe2, 0xe4, 0x11, 0xbf, 0x80, 0x9c, 0x69, 0xc3, 0x13, 0x6e,
-	0x56, 0x54, 0x2c, 0x72, 0xc3, 0x8b, 0x9c, 0x43, 0xe9, 0x74, 0xaa, 0x8b, 0xb7, 0x2d, 0xf8, 0xb5,
-	0xc1, 0xc8, 0x5b, 0xdc, 0x03, 0xc9, 0xe2, 0x1c, 0x68, 0x06, 0x3c, 0xcd, 0x8c, 0x73, 0xe9, 0x21,
-	0xbf, 0x13, 0x3d, 0xaf, 0x8b, 0x9f, 0xab, 0x1a, 0x19, 0x63, 0xbb, 0xe9, 0xba, 0xeb, 0x21, 0xff,
-	0x7a, 0x10, 0xac, 0xb7, 0x77, 0xd6, 0x9f, 0xed, 0xdd, 0x7d, 0xca, 0x4d, 0xb6, 0x88, 0x83, 0x44,
-	0x89, 0x30, 0x51, 0x5a, 0x28, 0x7d, 0x5c, 0xde, 0xeb, 0xe9, 0x3c, 0x34, 0xab, 0x02, 0x74, 0x30,
-	0x96, 0x26, 0xba, 0x3a, 0x76, 0x4d, 0x22, 0xdc, 0x13, 0x5c, 0xd2, 0x94, 0x69, 0x5a, 0x94, 0x3c,
-	0x01, 0xe7, 0xea, 0xbf, 0xf5, 0x86, 0x90, 0x44, 0x37, 0x82, 0xcb, 0x4f, 0x4c, 0x4f, 0x0e, 0x12,
-	0xe4, 0x3b, 0x26, 0x27, 0xcd, 0xb3, 0x57, 0xdb, 0x4f, 0x12, 0xee, 0xd7, 0xc2, 0xed, 0x0f, 0x7d,
-	0xb9, 0xb0, 0x2f, 0xfa, 0x97, 0x51, 0x9f, 0x4b, 0x6e, 0x38, 0xcb, 0x9b, 0xf1, 0x0d, 0x46, 0xeb,
-	0x9d, 0x8b, 0x36, 0x3b, 0x17, 0xfd, 0xdd, 0xb9, 0xe8, 0xe7, 0xde, 0xb5, 0x36, 0x7b, 0xd7, 0xfa,
-	0xbd, 0x77, 0xad, 0x6f, 0xef, 0xce, 0xbc, 0x60, 0x79, 0xb0, 0x6a, 0x93, 0xf5, 0xe3, 0x2c, 0x5b,
-	0x95, 0x6b, 0xdc, 0xad, 0x72, 0xf2, 0xf8, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xa5, 0xce, 0xeb, 0x97,
-	0x7f, 0x02, 0x00, 0x00,
+	// 774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0x22, 0x47,
+	0x10, 0x66, 0x00, 0xf3, 0xd3, 0x18, 0x2f, 0x6a, 0x39, 0x68, 0x96, 0x95, 0xc6, 0x23, 0x2c, 0x59,
+	0x68, 0x95, 0x80, 0xd6, 0x7b, 0xca, 0x21, 0xd2, 0x2e, 0x7f, 0xbb, 0xac, 0x30, 0xa0, 0x31, 0x3e,
+	0x24, 0x8a, 0x34, 0x6a, 0xa0, 0x19, 0x5a, 0x9e, 0xe9, 0x1e, 0x75, 0x37, 0x0e, 0xf8, 0x09, 0x22,
+	0x9f, 0xf2, 0x02, 0x3e, 0xe5, 0x65, 0x7c, 0xf4, 0x31, 0xca, 0xc1, 0x8a, 0xec, 0x7b, 0xf2, 0x0a,
+	0x51, 0x0f, 0x30, 0x60, 0xe1, 0x1c, 0xc2, 0x9e, 0xa0, 0xeb, 0xab, 0xfa, 0xa6, 0xea, 0xab, 0x6f,
+	0x7a, 0xc0, 0x09, 0x96, 0x13, 0xcc, 0x3d, 0x42, 0x65, 0x65, 0x8c, 0xb1, 0x87, 0xf8, 0x25, 0x96,
+	0x95, 0xab, 0x77, 0xeb, 0x43, 0xd9, 0xe7, 0x4c, 0x32, 0x98, 0x0f, 0xf3, 0xca, 0x6b, 0xe8, 0xea,
+	0x5d, 0xe1, 0xd0, 0x61, 0x0e, 0x0b, 0x52, 0x2a, 0xea, 0xdf, 0x22, 0xbb, 0xf8, 0x90, 0x00, 0x89,
+	0x1e, 0xe2, 0xc8, 0x13, 0xd0, 0x00, 0x19, 0xca, 0xec, 0x01, 0x12, 0xd8, 0x1e, 0x63, 0xac, 0x6b,
+	0xa6, 0x56, 0x4a, 0x59, 0x69, 0xca, 0xaa, 0x48, 0xe0, 0x26, 0xc6, 0xf0, 0x07, 0xf0, 0x66, 0x05,
+	0xda, 0xc3, 0x09, 0xa2, 0x0e, 0xb6, 0x47, 0x98, 0x32, 0x8f, 0x50, 0x24, 0x19, 0xd7, 0xa3, 0xa6,
+	0x56, 0xca, 0x5a, 0xfa, 0x60, 0x91, 0x5d, 0x0b, 0x12, 0xea, 0x6b, 0x1c, 0xbe, 0x07, 0xdf, 0x60,
+	0x17, 0x09, 0x49, 0x86, 0x44, 0xce, 0x6d, 0x6f, 0xea, 0x4a, 0xe2, 0xbb, 0x04, 0x73, 0x3d, 0x16,
+	0x14, 0x1e, 0xae, 0xc1, 0xb3, 0x10, 0x83, 0xc7, 0x20, 0x8b, 0x29, 0x1a, 0xb8, 0xd8, 0x9e, 0x60,
+	0xe2, 0x4c, 0xa4, 0xbe, 0x67, 0x6a, 0xa5, 0x98, 0xb5, 0xbf, 0x08, 0x7e, 0x0e, 0x62, 0xb0, 0x05,
+	0x52, 0x61, 0xd7, 0x09, 0x53, 0x2b, 0xa5, 0xab, 0xe5, 0xbb, 0x87, 0xa3, 0xc8, 0x9f, 0x0f, 0x47,
+	0x27, 0x0e, 0x91, 0x93, 0xe9, 0xa0, 0x3c, 0x64, 0x5e, 0x65, 0xc8, 0x84, 0xc7, 0xc4, 0xf2, 0xe7,
+	0x3b, 0x31, 0xba, 0xac, 0xc8, 0xb9, 0x8f, 0x45, 0xb9, 0x45, 0xa5, 0x95, 0x5c, 0x76, 0x0d, 0x2d,
+	0x90, 0xf5, 0x08, 0xb5, 0x1d, 0x24, 0x6c, 0x9f, 0x93, 0x21, 0xd6, 0x93, 0xff, 0x9b, 0xaf, 0x8e,
+	0x87, 0x56, 0xc6, 0x23, 0xf4, 0x13, 0x12, 0x3d, 0x45, 0x01, 0x7f, 0x06, 0x70, 0xc5, 0xb9, 0x31,
+	0x75, 0x6a, 0x27, 0xe2, 0xdc, 0x82, 0x78, 0x43, 0xa1, 0x53, 0x90, 0x0f, 0xb7, 0x32, 0xe6, 0xcc,
+	0x0b, 0x9e, 0x33, 0x15, 0x78, 0xa4, 0xa7, 0x83, 0x05, 0xc2, 0xe5, 0x68, 0x4d, 0xce, 0xbc, 0x4f,
+	0x48, 0x5c, 0x08, 0x3c, 0x82, 0x3d, 0xb0, 0xaf, 0x3a, 0x0a, 0x45, 0x03, 0x3b, 0x89, 0x06, 0x3c,
+	0x42, 0x57, 0xde, 0x50, 0x8c, 0x68, 0xb6, 0x66, 0xcc, 0xec, 0xc8, 0x88, 0x66, 0x2b, 0xc6, 0x12,
+	0xc8, 0xa9, 0x91, 0x26, 0x44, 0x48, 0xc6, 0xe7, 0xb6, 0x20, 0xd7, 0x58, 0xdf, 0x0f, 0x9c, 0x72,
+	0x30, 0xc6, 0xf8, 0xf3, 0x22, 0x7c, 0x4e, 0xae, 0x95, 0xbe, 0x87, 0xa1, 0x02, 0x23, 0x2c, 0xa4,
+	0xf2, 0x1b, 0x61, 0x54, 0xcf, 0x9a, 0x5a, 0xe9, 0xe0, 0xf4, 0x6d, 0xf9, 0xe5, 0xf7, 0xa1, 0xbc,
+	0x7c, 0x50, 0x7d, 0x5d, 0x11, 0x6a, 0xb5, 0x11, 0x83, 0x27, 0xe0, 0x95, 0x24, 0xbe, 0x2d, 0x99,
+	0xed, 0x73, 0xe6, 0x33, 0x81, 0xb9, 0x7e, 0x10, 0x08, 0x9b, 0x95, 0xc4, 0xef, 0xb3, 0xde, 0x32,
+	0xf8, 0x25, 0x9e, 0x8a, 0xe7, 0xf6, 0xac, 0x1c, 0xa1, 0x44, 0x12, 0xe4, 0x86, 0x4a, 0x14, 0xff,
+	0xd6, 0xc0, 0xab, 0x66, 0xd8, 0x70, 0x83, 0x4a, 0x3e, 0x87, 0x79, 0x90, 0x58, 0xda, 0x59, 0x0b,
+	0xec, 0x9c, 0x98, 0x6c, 0x1b, 0x39, 0xfa, 0x75, 0x46, 0x7e, 0x0d, 0x52, 0xa1, 0x11, 0xd4, 0x0b,
+	0x16, 0xb7, 0x92, 0xce, 0x72, 0xfb, 0x6f, 0x40, 0x5a, 0x41, 0x2e, 0xf1, 0x88, 0xd4, 0xe3, 0x01,
+	0xa6, 0x72, 0xdb, 0xea, 0x0c, 0x3f, 0x80, 0x24, 0xc7, 0xbf, 0x20, 0x3e, 0x12, 0xfa, 0x9e, 0x19,
+	0x2b, 0x65, 0x4e, 0xcd, 0xff, 0xd2, 0xaf, 0x3f, 0xb3, 0x82, 0xc4, 0x6a, 0x5c, 0xf5, 0x68, 0xad,
+	0xca, 0x8a, 0x0e, 0x48, 0xad, 0x20, 0xf8, 0x01, 0xc4, 0x24, 0xf1, 0x75, 0x6d, 0xa7, 0x59, 0x54,
+	0xe9, 0xb3, 0x39, 0xa2, 0xcf, 0xe6, 0x78, 0xfb, 0x8f, 0x06, 0xe0, 0xf6, 0x12, 0xe1, 0x17, 0x50,
+	0xac, 0x7e, 0x3c, 0x6f, 0xd8, 0xcd, 0x46, 0xc3, 0xae, 0x37, 0xce, 0xfb, 0xad, 0xce, 0xc7, 0x7e,
+	0xab, 0xdb, 0x09, 0xce, 0xb5, 0x6e, 0xbb, 0xdd, 0xa8, 0xf5, 0xbb, 0x56, 0x2e, 0x52, 0x28, 0xde,
+	0xdc, 0x9a, 0xc6, 0x76, 0xbd, 0xba, 0xbb, 0x98, 0xeb, 0xe2, 0xa1, 0xba, 0xb3, 0xbe, 0x07, 0xaf,
+	0x5f, 0xe4, 0xaa, 0x5e, 0x58, 0x9d, 0x9c, 0x56, 0x28, 0xdc, 0xdc, 0x9a, 0xf9, 0x6d, 0x8a, 0xea,
+	0x94, 0x53, 0xd8, 0x06, 0xc7, 0x2f, 0x96, 0xd6, 0xba, 0x67, 0x67, 0x17, 0x9d, 0x56, 0xff, 0x47,
+	0xbb, 0xd7, 0xed, 0xb6, 0x73, 0xd1, 0xc2, 0xf1, 0xcd, 0xad, 0x79, 0xb4, 0x4d, 0x52, 0x63, 0x9e,
+	0x37, 0xa5, 0x44, 0xce, 0x7b, 0x8c, 0xb9, 0x85, 0xf8, 0xaf, 0xbf, 0x1b, 0x91, 0x6a, 0xf3, 0xee,
+	0xd1, 0xd0, 0xee, 0x1f, 0x0d, 0xed, 0xaf, 0x47, 0x43, 0xfb, 0xed, 0xc9, 0x88, 0xdc, 0x3f, 0x19,
+	0x91, 0x3f, 0x9e, 0x8c, 0xc8, 0x4f, 0xdf, 0x6e, 0x68, 0x8a, 0xaf, 0x94, 0xa4, 0xeb, 0xaf, 0xc5,
+	0x6c, 0xe3, 0x7b, 0x11, 0xa8, 0x3b, 0x48, 0x04, 0x77, 0xff, 0xfb, 0x7f, 0x07, 0x00, 0x26, 0xdb,
+	0xa1, 0x07, 0x53, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -163,6 +397,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TipToProposer {
+		i--
+		if m.TipToProposer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.BaseFeeDestination != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeDestination))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.FeeHistorySize != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.FeeHistorySize))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.BaseFeeFromGasUsed {
+		i--
+		if m.BaseFeeFromGasUsed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -221,6 +505,106 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Tip.Size()
+		i -= size
+		if _, err := m.Tip.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeemarket(v)
 	base := offset
@@ -256,6 +640,62 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BaseFeeFromGasUsed {
+		n += 2
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.FeeHistorySize != 0 {
+		n += 1 + sovFeemarket(uint64(m.FeeHistorySize))
+	}
+	if m.BaseFeeDestination != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeDestination))
+	}
+	if m.TipToProposer {
+		n += 2
+	}
+	return n
+}
+
+func (m *FeeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasLimit))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tip.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
 	return n
 }
 
@@ -473,6 +913,430 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeFromGasUsed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BaseFeeFromGasUsed = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeHistorySize", wireType)
+			}
+			m.FeeHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeHistorySize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeDestination", wireType)
+			}
+			m.BaseFeeDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeDestination |= BaseFeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipToProposer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TipToProposer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, TxReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -61,3 +62,26 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		}
 	}
 }
+
+func (suite *GenesisTestSuite) TestValidateGenesisWithoutBaseFeeBounds() {
+	// genesis files exported before the base fee bounds were added don't have them
+	bz, err := json.Marshal(DefaultGenesisState())
+	suite.Require().NoError(err)
+	var raw map[string]json.RawMessage
+	suite.Require().NoError(json.Unmarshal(bz, &raw))
+	var params map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(raw["params"], &params))
+	delete(params, "min_base_fee")
+	delete(params, "max_base_fee")
+	raw["params"], err = json.Marshal(params)
+	suite.Require().NoError(err)
+	bz, err = json.Marshal(raw)
+	suite.Require().NoError(err)
+
+	var genState GenesisState
+	suite.Require().NoError(json.Unmarshal(bz, &genState))
+	suite.Require().True(genState.Params.MinBaseFee.IsNil())
+	suite.Require().NoError(genState.Validate())
+	suite.Require().Nil(genState.Params.BaseFeeFloor())
+	suite.Require().Nil(genState.Params.BaseFeeCeiling())
+}
@@ -15,6 +15,10 @@
 // along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName string name of module
 	ModuleName = "feemarket"
@@ -35,18 +39,29 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBlockGasUsed
+	prefixFeeHistory
 )
 
 const (
 	prefixTransientBlockGasUsed = iota + 1
+	prefixTransientTxReward
 )
 
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockGasUsed   = []byte{prefixBlockGasUsed}
+	KeyPrefixFeeHistory     = []byte{prefixFeeHistory}
 )
 
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
+	KeyPrefixTransientTxReward       = []byte{prefixTransientTxReward}
 )
+
+// FeeHistoryKey returns the key of the fee history entry of the given block height
+func FeeHistoryKey(height int64) []byte {
+	return append(KeyPrefixFeeHistory, sdk.Uint64ToBigEndian(uint64(height))...)
+}
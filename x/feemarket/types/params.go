@@ -17,10 +17,12 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -33,6 +35,21 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultBaseFeeFromGasUsed is false (i.e base fee is computed from gas wanted)
+	DefaultBaseFeeFromGasUsed = false
+	// DefaultMinBaseFee is 0 (i.e disabled)
+	DefaultMinBaseFee = sdkmath.ZeroInt()
+	// DefaultMaxBaseFee is 0 (i.e unbounded)
+	DefaultMaxBaseFee = sdkmath.ZeroInt()
+	// DefaultFeeHistorySize is 100 blocks
+	DefaultFeeHistorySize = uint32(100)
+	// MaxFeeHistorySize is 4096 blocks (i.e a few times the 1024 blocks of fee
+	// history served by go-ethereum), which bounds the entries kept in the store
+	MaxFeeHistorySize = uint32(4096)
+	// DefaultBaseFeeDestination leaves the base fee in the fee collector
+	DefaultBaseFeeDestination = BaseFeeDestinationFeeCollector
+	// DefaultTipToProposer is false (i.e tips are left in the fee collector)
+	DefaultTipToProposer = false
 )
 
 // Parameter keys
@@ -45,6 +62,12 @@ var (
 	ParamStoreKeyEnableHeight             = []byte("EnableHeight")
 	ParamStoreKeyMinGasPrice              = []byte("MinGasPrice")
 	ParamStoreKeyMinGasMultiplier         = []byte("MinGasMultiplier")
+	ParamStoreKeyBaseFeeFromGasUsed       = []byte("BaseFeeFromGasUsed")
+	ParamStoreKeyMinBaseFee               = []byte("MinBaseFee")
+	ParamStoreKeyMaxBaseFee               = []byte("MaxBaseFee")
+	ParamStoreKeyFeeHistorySize           = []byte("FeeHistorySize")
+	ParamStoreKeyBaseFeeDestination       = []byte("BaseFeeDestination")
+	ParamStoreKeyTipToProposer            = []byte("TipToProposer")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -62,6 +85,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableHeight, &p.EnableHeight, validateEnableHeight),
 		paramtypes.NewParamSetPair(ParamStoreKeyMinGasPrice, &p.MinGasPrice, validateMinGasPrice),
 		paramtypes.NewParamSetPair(ParamStoreKeyMinGasMultiplier, &p.MinGasMultiplier, validateMinGasPrice),
+		paramtypes.NewParamSetPair(ParamStoreKeyBaseFeeFromGasUsed, &p.BaseFeeFromGasUsed, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyMinBaseFee, &p.MinBaseFee, validateBaseFeeBound),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxBaseFee, &p.MaxBaseFee, validateBaseFeeBound),
+		paramtypes.NewParamSetPair(ParamStoreKeyFeeHistorySize, &p.FeeHistorySize, validateFeeHistorySize),
+		paramtypes.NewParamSetPair(ParamStoreKeyBaseFeeDestination, &p.BaseFeeDestination, validateBaseFeeDestination),
+		paramtypes.NewParamSetPair(ParamStoreKeyTipToProposer, &p.TipToProposer, validateBool),
 	}
 }
 
@@ -74,6 +103,12 @@ func NewParams(
 	enableHeight int64,
 	minGasPrice sdk.Dec,
 	minGasPriceMultiplier sdk.Dec,
+	baseFeeFromGasUsed bool,
+	minBaseFee,
+	maxBaseFee uint64,
+	feeHistorySize uint32,
+	baseFeeDestination BaseFeeDestination,
+	tipToProposer bool,
 ) Params {
 	return Params{
 		NoBaseFee:                noBaseFee,
@@ -83,6 +118,12 @@ func NewParams(
 		EnableHeight:             enableHeight,
 		MinGasPrice:              minGasPrice,
 		MinGasMultiplier:         minGasPriceMultiplier,
+		BaseFeeFromGasUsed:       baseFeeFromGasUsed,
+		MinBaseFee:               sdkmath.NewIntFromUint64(minBaseFee),
+		MaxBaseFee:               sdkmath.NewIntFromUint64(maxBaseFee),
+		FeeHistorySize:           feeHistorySize,
+		BaseFeeDestination:       baseFeeDestination,
+		TipToProposer:            tipToProposer,
 	}
 }
 
@@ -96,6 +137,12 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		BaseFeeFromGasUsed:       DefaultBaseFeeFromGasUsed,
+		MinBaseFee:               DefaultMinBaseFee,
+		MaxBaseFee:               DefaultMaxBaseFee,
+		FeeHistorySize:           DefaultFeeHistorySize,
+		BaseFeeDestination:       DefaultBaseFeeDestination,
+		TipToProposer:            DefaultTipToProposer,
 	}
 }
 
@@ -117,7 +164,33 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateMinGasPrice(p.MinGasPrice)
+	if err := validateMinGasPrice(p.MinGasPrice); err != nil {
+		return err
+	}
+
+	if err := validateFeeHistorySize(p.FeeHistorySize); err != nil {
+		return err
+	}
+
+	if err := validateBaseFeeBound(p.MinBaseFee); err != nil {
+		return fmt.Errorf("invalid min base fee: %w", err)
+	}
+
+	if err := validateBaseFeeBound(p.MaxBaseFee); err != nil {
+		return fmt.Errorf("invalid max base fee: %w", err)
+	}
+
+	if p.BaseFeeCeiling() != nil {
+		if !p.MinBaseFee.IsNil() && p.MinBaseFee.GT(p.MaxBaseFee) {
+			return fmt.Errorf("min base fee %s cannot be greater than max base fee %s", p.MinBaseFee, p.MaxBaseFee)
+		}
+
+		if p.MinGasPrice.GT(sdk.NewDecFromInt(p.MaxBaseFee)) {
+			return fmt.Errorf("min gas price %s cannot be greater than max base fee %s", p.MinGasPrice, p.MaxBaseFee)
+		}
+	}
+
+	return validateBaseFeeDestination(p.BaseFeeDestination)
 }
 
 func validateBool(i interface{}) error {
@@ -132,6 +205,32 @@ func (p *Params) IsBaseFeeEnabled(height int64) bool {
 	return !p.NoBaseFee && height >= p.EnableHeight
 }
 
+// BaseFeeFloor returns the lower bound of the base fee, which is the maximum of
+// the min base fee and the min gas price. It returns nil if the base fee has no
+// lower bound.
+func (p Params) BaseFeeFloor() *big.Int {
+	floor := new(big.Int)
+	if !p.MinBaseFee.IsNil() {
+		floor = math.BigMax(floor, p.MinBaseFee.BigInt())
+	}
+	if !p.MinGasPrice.IsNil() {
+		floor = math.BigMax(floor, p.MinGasPrice.TruncateInt().BigInt())
+	}
+	if floor.Sign() <= 0 {
+		return nil
+	}
+	return floor
+}
+
+// BaseFeeCeiling returns the upper bound of the base fee. It returns nil if the
+// base fee is unbounded.
+func (p Params) BaseFeeCeiling() *big.Int {
+	if p.MaxBaseFee.IsNil() || !p.MaxBaseFee.IsPositive() {
+		return nil
+	}
+	return p.MaxBaseFee.BigInt()
+}
+
 func validateMinGasPrice(i interface{}) error {
 	v, ok := i.(sdk.Dec)
 
@@ -184,6 +283,21 @@ func validateBaseFee(i interface{}) error {
 	return nil
 }
 
+// validateBaseFeeBound validates the min and max base fees, which unlike the
+// base fee can be left unset and are then treated as zero.
+func validateBaseFeeBound(i interface{}) error {
+	value, ok := i.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if value.IsNil() {
+		return nil
+	}
+
+	return validateBaseFee(value)
+}
+
 func validateEnableHeight(i interface{}) error {
 	value, ok := i.(int64)
 	if !ok {
@@ -217,3 +331,35 @@ func validateMinGasMultiplier(i interface{}) error {
 	}
 	return nil
 }
+
+func validateBaseFeeDestination(i interface{}) error {
+	value, ok := i.(BaseFeeDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := BaseFeeDestination_name[int32(value)]; !ok {
+		return fmt.Errorf("invalid base fee destination: %d", value)
+	}
+
+	return nil
+}
+
+// DistributesFees returns true if the fees paid by Ethereum transactions are
+// not entirely left in the fee collector.
+func (p Params) DistributesFees() bool {
+	return p.BaseFeeDestination != BaseFeeDestinationFeeCollector || p.TipToProposer
+}
+
+func validateFeeHistorySize(i interface{}) error {
+	value, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if value > MaxFeeHistorySize {
+		return fmt.Errorf("fee history size cannot be greater than %d: %d", MaxFeeHistorySize, value)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -26,7 +27,7 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{"default", DefaultParams(), false},
 		{
 			"valid",
-			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), DefaultMinGasMultiplier),
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), DefaultMinGasMultiplier, false, 0, 0, 0, DefaultBaseFeeDestination, false),
 			false,
 		},
 		{
@@ -36,27 +37,71 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		},
 		{
 			"base fee change denominator is 0 ",
-			NewParams(true, 0, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), DefaultMinGasMultiplier),
+			NewParams(true, 0, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), DefaultMinGasMultiplier, false, 0, 0, 0, DefaultBaseFeeDestination, false),
 			true,
 		},
 		{
 			"invalid: min gas price negative",
-			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecFromInt(sdkmath.NewInt(-1)), DefaultMinGasMultiplier),
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecFromInt(sdkmath.NewInt(-1)), DefaultMinGasMultiplier, false, 0, 0, 0, DefaultBaseFeeDestination, false),
 			true,
 		},
 		{
 			"valid: min gas multiplier zero",
-			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), DefaultMinGasPrice, sdk.ZeroDec()),
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), DefaultMinGasPrice, sdk.ZeroDec(), false, 0, 0, 0, DefaultBaseFeeDestination, false),
 			false,
 		},
 		{
 			"invalid: min gas multiplier is negative",
-			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), DefaultMinGasPrice, sdk.NewDecWithPrec(-5, 1)),
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), DefaultMinGasPrice, sdk.NewDecWithPrec(-5, 1), false, 0, 0, 0, DefaultBaseFeeDestination, false),
+			true,
+		},
+		{
+			"valid: base fee bounds",
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), DefaultMinGasMultiplier, true, 1000, 3000000000, 0, DefaultBaseFeeDestination, false),
+			false,
+		},
+		{
+			"valid: max base fee zero",
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), DefaultMinGasMultiplier, true, 1000, 0, 0, DefaultBaseFeeDestination, false),
+			false,
+		},
+		{
+			"valid: unset base fee bounds",
+			func() Params {
+				p := DefaultParams()
+				p.MinBaseFee, p.MaxBaseFee = sdkmath.Int{}, sdkmath.Int{}
+				return p
+			}(),
+			false,
+		},
+		{
+			"invalid: min base fee bigger than max base fee",
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), DefaultMinGasMultiplier, true, 3000, 1000, 0, DefaultBaseFeeDestination, false),
+			true,
+		},
+		{
+			"invalid: min gas price bigger than max base fee",
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDec(2000), DefaultMinGasMultiplier, true, 0, 1000, 0, DefaultBaseFeeDestination, false),
+			true,
+		},
+		{
+			"valid: burn base fee and tip to proposer",
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), DefaultMinGasMultiplier, false, 0, 0, 0, BaseFeeDestinationBurn, true),
+			false,
+		},
+		{
+			"invalid: unknown base fee destination",
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), DefaultMinGasMultiplier, false, 0, 0, 0, BaseFeeDestination(3), false),
+			true,
+		},
+		{
+			"invalid: fee history size bigger than the max",
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), DefaultMinGasMultiplier, false, 0, 0, MaxFeeHistorySize+1, DefaultBaseFeeDestination, false),
 			true,
 		},
 		{
 			"invalid: min gas multiplier bigger than 1",
-			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), sdk.NewDec(2)),
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), sdk.NewDec(2), false, 0, 0, 0, DefaultBaseFeeDestination, false),
 			true,
 		},
 	}
@@ -84,6 +129,17 @@ func (suite *ParamsTestSuite) TestParamsValidatePriv() {
 	suite.Require().Error(validateBaseFee(int64(2000000000)))
 	suite.Require().Error(validateBaseFee(sdkmath.NewInt(-2000000000)))
 	suite.Require().NoError(validateBaseFee(sdkmath.NewInt(2000000000)))
+	suite.Require().Error(validateBaseFeeBound(""))
+	suite.Require().Error(validateBaseFeeBound(sdkmath.NewInt(-1000)))
+	suite.Require().NoError(validateBaseFeeBound(sdkmath.NewInt(1000)))
+	suite.Require().NoError(validateBaseFeeBound(sdkmath.Int{}))
+	suite.Require().Error(validateFeeHistorySize(int64(100)))
+	suite.Require().NoError(validateFeeHistorySize(uint32(100)))
+	suite.Require().NoError(validateFeeHistorySize(MaxFeeHistorySize))
+	suite.Require().Error(validateFeeHistorySize(MaxFeeHistorySize + 1))
+	suite.Require().Error(validateBaseFeeDestination(int32(1)))
+	suite.Require().Error(validateBaseFeeDestination(BaseFeeDestination(-1)))
+	suite.Require().NoError(validateBaseFeeDestination(BaseFeeDestinationCommunityPool))
 	suite.Require().Error(validateEnableHeight(""))
 	suite.Require().Error(validateEnableHeight(int64(-544435345345435345)))
 	suite.Require().NoError(validateEnableHeight(int64(544435345345435345)))
@@ -119,3 +175,27 @@ func (suite *ParamsTestSuite) TestParamsValidateMinGasPrice() {
 		}
 	}
 }
+
+func (suite *ParamsTestSuite) TestBaseFeeBounds() {
+	testCases := []struct {
+		name        string
+		minBaseFee  sdkmath.Int
+		maxBaseFee  sdkmath.Int
+		minGasPrice sdk.Dec
+		expFloor    *big.Int
+		expCeiling  *big.Int
+	}{
+		{"unbounded", sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdk.ZeroDec(), nil, nil},
+		{"unset bounds", sdkmath.Int{}, sdkmath.Int{}, sdk.ZeroDec(), nil, nil},
+		{"min base fee", sdkmath.NewInt(100), sdkmath.NewInt(1000), sdk.NewDec(10), big.NewInt(100), big.NewInt(1000)},
+		{"min gas price", sdkmath.NewInt(100), sdkmath.ZeroInt(), sdk.NewDecWithPrec(2005, 1), big.NewInt(200), nil},
+		{"min gas price with unset min base fee", sdkmath.Int{}, sdkmath.Int{}, sdk.NewDec(10), big.NewInt(10), nil},
+	}
+
+	for _, tc := range testCases {
+		p := DefaultParams()
+		p.MinBaseFee, p.MaxBaseFee, p.MinGasPrice = tc.minBaseFee, tc.maxBaseFee, tc.minGasPrice
+		suite.Require().Equal(tc.expFloor, p.BaseFeeFloor(), tc.name)
+		suite.Require().Equal(tc.expCeiling, p.BaseFeeCeiling(), tc.name)
+	}
+}
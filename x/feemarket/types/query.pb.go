@@ -274,6 +274,110 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryFeeHistoryRequest defines the request type for querying the on-chain
+// fee history.
+type QueryFeeHistoryRequest struct {
+	// block_count is the maximum number of blocks to return
+	BlockCount uint64 `protobuf:"varint,1,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	// last_block is the height of the newest block to return. The latest block
+	// height is used if it's zero.
+	LastBlock int64 `protobuf:"varint,2,opt,name=last_block,json=lastBlock,proto3" json:"last_block,omitempty"`
+}
+
+func (m *QueryFeeHistoryRequest) Reset()         { *m = QueryFeeHistoryRequest{} }
+func (m *QueryFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{6}
+}
+func (m *QueryFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryRequest) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+func (m *QueryFeeHistoryRequest) GetLastBlock() int64 {
+	if m != nil {
+		return m.LastBlock
+	}
+	return 0
+}
+
+// QueryFeeHistoryResponse returns the fee history entries of the requested
+// blocks.
+type QueryFeeHistoryResponse struct {
+	// entries of the fee history, sorted by ascending height
+	Entries []FeeHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *QueryFeeHistoryResponse) Reset()         { *m = QueryFeeHistoryResponse{} }
+func (m *QueryFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{7}
+}
+func (m *QueryFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryResponse) GetEntries() []FeeHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
@@ -281,6 +385,8 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryFeeHistoryRequest)(nil), "ethermint.feemarket.v1.QueryFeeHistoryRequest")
+	proto.RegisterType((*QueryFeeHistoryResponse)(nil), "ethermint.feemarket.v1.QueryFeeHistoryResponse")
 }
 
 func init() {
@@ -288,35 +394,42 @@ func init() {
 }
 
 var fileDescriptor_71a07c1ffd85fde2 = []byte{
-	// 443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xf5, 0x12, 0x48, 0xcb, 0x72, 0x41, 0x4b, 0x5a, 0x21, 0x0b, 0x6d, 0x83, 0x91, 0xa2, 0xb6,
-	0xb4, 0x5e, 0xb5, 0x5c, 0x39, 0x59, 0xa2, 0x88, 0x1b, 0x98, 0x1b, 0x12, 0xaa, 0xd6, 0x61, 0xea,
-	0x5a, 0xa9, 0xbd, 0xae, 0x77, 0x13, 0xd1, 0x2b, 0x37, 0x2e, 0x08, 0xc1, 0xd7, 0xf0, 0x07, 0x39,
-	0x46, 0xe2, 0x82, 0x38, 0x44, 0x28, 0xe1, 0x43, 0x90, 0x77, 0xd7, 0x49, 0x0c, 0x18, 0x72, 0xca,
-	0x6a, 0xf2, 0xe6, 0xbd, 0x37, 0x6f, 0xc6, 0xd8, 0x03, 0x75, 0x0e, 0x45, 0x9a, 0x64, 0x8a, 0x9d,
-	0x01, 0xa4, 0xbc, 0x18, 0x80, 0x62, 0xa3, 0x23, 0x76, 0x39, 0x84, 0xe2, 0xca, 0xcf, 0x0b, 0xa1,
-	0x04, 0xd9, 0x5e, 0x60, 0xfc, 0x05, 0xc6, 0x1f, 0x1d, 0xb9, 0x9d, 0x58, 0xc4, 0x42, 0x43, 0x58,
-	0xf9, 0x32, 0x68, 0xb7, 0xd7, 0xc0, 0xb8, 0x6c, 0x35, 0xb8, 0x7b, 0xb1, 0x10, 0xf1, 0x05, 0x30,
-	0x9e, 0x27, 0x8c, 0x67, 0x99, 0x50, 0x5c, 0x25, 0x22, 0x93, 0xe6, 0x5f, 0xaf, 0x83, 0xc9, 0x8b,
-	0xd2, 0xc2, 0x73, 0x5e, 0xf0, 0x54, 0x86, 0x70, 0x39, 0x04, 0xa9, 0xbc, 0x97, 0xf8, 0x4e, 0xad,
-	0x2a, 0x73, 0x91, 0x49, 0x20, 0x8f, 0x71, 0x3b, 0xd7, 0x95, 0xbb, 0xa8, 0x8b, 0x76, 0x6f, 0x1d,
-	0x53, 0xff, 0xef, 0x8e, 0x7d, 0xd3, 0x17, 0x5c, 0x1f, 0x4f, 0x77, 0x9c, 0xd0, 0xf6, 0x78, 0x5b,
-	0x96, 0x34, 0xe0, 0x12, 0x4e, 0x00, 0x2a, 0xad, 0xd7, 0xb8, 0x53, 0x2f, 0x5b, 0xb1, 0x27, 0x78,
-	0x33, 0xe2, 0x12, 0x4e, 0xcf, 0x00, 0xb4, 0xdc, 0xcd, 0x60, 0xff, 0xfb, 0x74, 0xa7, 0x17, 0x27,
-	0xea, 0x7c, 0x18, 0xf9, 0x7d, 0x91, 0xb2, 0xbe, 0x90, 0xa9, 0x90, 0xf6, 0xe7, 0x50, 0xbe, 0x19,
-	0x30, 0x75, 0x95, 0x83, 0xf4, 0x9f, 0x65, 0x2a, 0xdc, 0x88, 0x0c, 0x9d, 0xb7, 0x5d, 0xd1, 0x5f,
-	0x88, 0xfe, 0xe0, 0x29, 0x5f, 0x8c, 0xb8, 0x87, 0xb7, 0x7e, 0xab, 0x5b, 0xdd, 0xdb, 0xb8, 0x15,
-	0x73, 0x33, 0x61, 0x2b, 0x2c, 0x9f, 0xc7, 0x5f, 0x5a, 0xf8, 0x86, 0xc6, 0x92, 0xf7, 0x08, 0xb7,
-	0xcd, 0x6c, 0x64, 0xbf, 0x69, 0xf6, 0x3f, 0xe3, 0x74, 0x1f, 0xae, 0x85, 0x35, 0xfa, 0x5e, 0xef,
-	0xdd, 0xd7, 0x9f, 0x9f, 0xaf, 0x75, 0x09, 0x65, 0x0d, 0x0b, 0x36, 0x71, 0x92, 0x0f, 0x08, 0x6f,
-	0xd8, 0xcc, 0xc8, 0xbf, 0x05, 0xea, 0x81, 0xbb, 0x07, 0xeb, 0x81, 0xad, 0x9d, 0x5d, 0x6d, 0xc7,
-	0x23, 0xdd, 0x26, 0x3b, 0xd5, 0x92, 0xc8, 0x27, 0x84, 0x37, 0xab, 0x34, 0xc9, 0x7f, 0x44, 0xea,
-	0xcb, 0x70, 0x0f, 0xd7, 0x44, 0x5b, 0x4f, 0x7b, 0xda, 0xd3, 0x03, 0x72, 0xbf, 0xd1, 0x53, 0xd9,
-	0x71, 0x1a, 0x73, 0x19, 0x9c, 0x8c, 0x67, 0x14, 0x4d, 0x66, 0x14, 0xfd, 0x98, 0x51, 0xf4, 0x71,
-	0x4e, 0x9d, 0xc9, 0x9c, 0x3a, 0xdf, 0xe6, 0xd4, 0x79, 0x75, 0xb0, 0x72, 0x49, 0x30, 0x2a, 0x0f,
-	0x69, 0x49, 0xf6, 0x76, 0x85, 0x4e, 0xdf, 0x54, 0xd4, 0xd6, 0x9f, 0xcb, 0xa3, 0x5f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x61, 0x3c, 0x39, 0xd8, 0xc8, 0x03, 0x00, 0x00,
+	// 551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6f, 0x12, 0x4f,
+	0x18, 0x67, 0x0b, 0x7f, 0x68, 0x1f, 0x2e, 0xff, 0x8c, 0x14, 0x9b, 0x8d, 0x2e, 0xb8, 0x8d, 0x48,
+	0xdf, 0x76, 0x52, 0xbc, 0x7a, 0xc2, 0x94, 0xea, 0x4d, 0xf1, 0x62, 0x4c, 0x0c, 0x99, 0xc5, 0xa7,
+	0xcb, 0x06, 0xd8, 0xa1, 0x3b, 0x03, 0x91, 0xab, 0x37, 0x2f, 0xc6, 0xe8, 0xcd, 0x4f, 0xd4, 0x63,
+	0x13, 0x13, 0x63, 0x3c, 0x34, 0x06, 0xfc, 0x20, 0x66, 0x67, 0x07, 0x28, 0xe2, 0x56, 0x4e, 0x6c,
+	0x1e, 0x7e, 0x6f, 0x33, 0xf3, 0x9b, 0x01, 0x1b, 0x65, 0x07, 0xc3, 0xbe, 0x1f, 0x48, 0x7a, 0x86,
+	0xd8, 0x67, 0x61, 0x17, 0x25, 0x1d, 0x1d, 0xd3, 0xf3, 0x21, 0x86, 0x63, 0x67, 0x10, 0x72, 0xc9,
+	0x49, 0x71, 0x8e, 0x71, 0xe6, 0x18, 0x67, 0x74, 0x6c, 0x16, 0x3c, 0xee, 0x71, 0x05, 0xa1, 0xd1,
+	0x57, 0x8c, 0x36, 0x2b, 0x09, 0x8a, 0x0b, 0x6a, 0x8c, 0xbb, 0xe3, 0x71, 0xee, 0xf5, 0x90, 0xb2,
+	0x81, 0x4f, 0x59, 0x10, 0x70, 0xc9, 0xa4, 0xcf, 0x03, 0x11, 0xff, 0x6b, 0x17, 0x80, 0x3c, 0x8f,
+	0x22, 0x3c, 0x63, 0x21, 0xeb, 0x8b, 0x26, 0x9e, 0x0f, 0x51, 0x48, 0xfb, 0x05, 0xdc, 0x5a, 0x9a,
+	0x8a, 0x01, 0x0f, 0x04, 0x92, 0x47, 0x90, 0x1d, 0xa8, 0xc9, 0x8e, 0x51, 0x36, 0xaa, 0xf9, 0x9a,
+	0xe5, 0xfc, 0x3d, 0xb1, 0x13, 0xf3, 0xea, 0x99, 0x8b, 0xab, 0x52, 0xaa, 0xa9, 0x39, 0xf6, 0xb6,
+	0x16, 0xad, 0x33, 0x81, 0x0d, 0xc4, 0x99, 0xd7, 0x6b, 0x28, 0x2c, 0x8f, 0xb5, 0xd9, 0x09, 0x6c,
+	0xba, 0x4c, 0x60, 0xeb, 0x0c, 0x51, 0xd9, 0x6d, 0xd5, 0xf7, 0x7f, 0x5c, 0x95, 0x2a, 0x9e, 0x2f,
+	0x3b, 0x43, 0xd7, 0x69, 0xf3, 0x3e, 0x6d, 0x73, 0xd1, 0xe7, 0x42, 0xff, 0x1c, 0x89, 0x37, 0x5d,
+	0x2a, 0xc7, 0x03, 0x14, 0xce, 0xd3, 0x40, 0x36, 0x73, 0x6e, 0x2c, 0x67, 0x17, 0x67, 0xf2, 0x3d,
+	0xde, 0xee, 0x9e, 0xb2, 0xf9, 0x12, 0xf7, 0x60, 0xfb, 0x8f, 0xb9, 0xf6, 0xfd, 0x1f, 0xd2, 0x1e,
+	0x8b, 0x57, 0x98, 0x6e, 0x46, 0x9f, 0xf6, 0x4b, 0x28, 0x2a, 0x68, 0x03, 0xf1, 0x89, 0x2f, 0x24,
+	0x0f, 0xc7, 0x5a, 0x84, 0x94, 0x20, 0xef, 0x46, 0xfc, 0x56, 0x9b, 0x0f, 0x03, 0xa9, 0x38, 0x99,
+	0x26, 0xa8, 0xd1, 0xe3, 0x68, 0x42, 0xee, 0x02, 0xf4, 0x98, 0x90, 0x2d, 0x35, 0xda, 0xd9, 0x50,
+	0x9a, 0x5b, 0xd1, 0x44, 0xd9, 0xda, 0x2e, 0xdc, 0x5e, 0x51, 0xd6, 0x31, 0x4e, 0x21, 0x87, 0x81,
+	0x0c, 0x7d, 0x8c, 0xa2, 0xa4, 0xab, 0xf9, 0xda, 0x83, 0xa4, 0xcd, 0x5e, 0x90, 0x4f, 0x02, 0x19,
+	0x8e, 0xf5, 0xae, 0xcf, 0xd8, 0xb5, 0x6f, 0x19, 0xf8, 0x4f, 0x99, 0x90, 0xf7, 0x06, 0x64, 0xe3,
+	0x93, 0x21, 0xfb, 0x49, 0x62, 0xab, 0x65, 0x30, 0x0f, 0xd6, 0xc2, 0xc6, 0xb1, 0xed, 0xca, 0xbb,
+	0xaf, 0xbf, 0x3e, 0x6f, 0x94, 0x89, 0x45, 0x13, 0xea, 0x19, 0x97, 0x81, 0x7c, 0x30, 0x20, 0xa7,
+	0x4f, 0x9c, 0xdc, 0x6c, 0xb0, 0x5c, 0x17, 0xf3, 0x70, 0x3d, 0xb0, 0x8e, 0x53, 0x55, 0x71, 0x6c,
+	0x52, 0x4e, 0x8a, 0x33, 0xab, 0x18, 0xf9, 0x64, 0xc0, 0xe6, 0xac, 0x0b, 0xe4, 0x1f, 0x26, 0xcb,
+	0x55, 0x32, 0x8f, 0xd6, 0x44, 0xeb, 0x4c, 0x7b, 0x2a, 0xd3, 0x2e, 0xb9, 0x97, 0x98, 0x49, 0x55,
+	0xca, 0x63, 0x82, 0x7c, 0x31, 0x00, 0x16, 0xc7, 0x4b, 0x9c, 0x1b, 0x8d, 0x56, 0xea, 0x69, 0xd2,
+	0xb5, 0xf1, 0x3a, 0xda, 0x81, 0x8a, 0x76, 0x9f, 0xec, 0xd2, 0xe4, 0xc7, 0xa5, 0xd5, 0x89, 0x49,
+	0xf5, 0xc6, 0xc5, 0xc4, 0x32, 0x2e, 0x27, 0x96, 0xf1, 0x73, 0x62, 0x19, 0x1f, 0xa7, 0x56, 0xea,
+	0x72, 0x6a, 0xa5, 0xbe, 0x4f, 0xad, 0xd4, 0xab, 0xc3, 0x6b, 0x97, 0x14, 0x47, 0xd1, 0x1d, 0x5d,
+	0xc8, 0xbd, 0xbd, 0x26, 0xa8, 0xae, 0xab, 0x9b, 0x55, 0x2f, 0xd1, 0xc3, 0xdf, 0x03, 0x00, 0x66,
+	0xe2, 0xc4, 0xdc, 0x23, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -337,6 +450,9 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// FeeHistory queries the fee market data of the most recent blocks stored in
+	// the on-chain fee history
+	FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -374,6 +490,15 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error) {
+	out := new(QueryFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/FeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -382,6 +507,9 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// FeeHistory queries the fee market data of the most recent blocks stored in
+	// the on-chain fee history
+	FeeHistory(context.Context, *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -397,6 +525,9 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) FeeHistory(ctx context.Context, req *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -456,6 +587,24 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/FeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeHistory(ctx, req.(*QueryFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -472,6 +621,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "FeeHistory",
+			Handler:    _Query_FeeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -642,6 +795,76 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -716,6 +939,36 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockCount != 0 {
+		n += 1 + sovQuery(uint64(m.BlockCount))
+	}
+	if m.LastBlock != 0 {
+		n += 1 + sovQuery(uint64(m.LastBlock))
+	}
+	return n
+}
+
+func (m *QueryFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1110,6 +1363,178 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
+			}
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlock", wireType)
+			}
+			m.LastBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, FeeHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0